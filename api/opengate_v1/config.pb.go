@@ -10,6 +10,7 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,29 +23,30 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// AuthenticationException defines paths/methods excepted from authentication rules
-type AuthenticationException struct {
+// ClaimRequirement is a predicate on a claim of the token
+type ClaimRequirement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Methods       []string               `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`         // Claim name, nested claims are joined by dots, e.g. org.tier
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // exists, equals (default), not_equals or contains
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthenticationException) Reset() {
-	*x = AuthenticationException{}
+func (x *ClaimRequirement) Reset() {
+	*x = ClaimRequirement{}
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthenticationException) String() string {
+func (x *ClaimRequirement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthenticationException) ProtoMessage() {}
+func (*ClaimRequirement) ProtoMessage() {}
 
-func (x *AuthenticationException) ProtoReflect() protoreflect.Message {
+func (x *ClaimRequirement) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -56,50 +58,56 @@ func (x *AuthenticationException) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthenticationException.ProtoReflect.Descriptor instead.
-func (*AuthenticationException) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClaimRequirement.ProtoReflect.Descriptor instead.
+func (*ClaimRequirement) Descriptor() ([]byte, []int) {
 	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *AuthenticationException) GetPath() string {
+func (x *ClaimRequirement) GetName() string {
 	if x != nil {
-		return x.Path
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AuthenticationException) GetMethods() []string {
+func (x *ClaimRequirement) GetOperator() string {
 	if x != nil {
-		return x.Methods
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ClaimRequirement) GetValues() []string {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-// Authentication defines authentication settings for a route
-type Authentication struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Required bool                   `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
-	// if required is true, excepted paths/methods do not require authentication
-	// if required is false, excepted paths/methods require authentication
-	Except        []*AuthenticationException `protobuf:"bytes,2,rep,name=except,proto3" json:"except,omitempty"`
+// Requirements are what an authenticated client must have, it's denied with a 403 otherwise
+type Requirements struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []string               `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`   // All of them are required
+	Profiles      []int64                `protobuf:"varint,2,rep,packed,name=profiles,proto3" json:"profiles,omitempty"` // Profile IDs, one of them is required
+	Claims        []*ClaimRequirement    `protobuf:"bytes,3,rep,name=claims,proto3" json:"claims,omitempty"`             // All of them must hold
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Authentication) Reset() {
-	*x = Authentication{}
+func (x *Requirements) Reset() {
+	*x = Requirements{}
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Authentication) String() string {
+func (x *Requirements) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Authentication) ProtoMessage() {}
+func (*Requirements) ProtoMessage() {}
 
-func (x *Authentication) ProtoReflect() protoreflect.Message {
+func (x *Requirements) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -111,56 +119,56 @@ func (x *Authentication) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Authentication.ProtoReflect.Descriptor instead.
-func (*Authentication) Descriptor() ([]byte, []int) {
+// Deprecated: Use Requirements.ProtoReflect.Descriptor instead.
+func (*Requirements) Descriptor() ([]byte, []int) {
 	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Authentication) GetRequired() bool {
+func (x *Requirements) GetPermissions() []string {
 	if x != nil {
-		return x.Required
+		return x.Permissions
 	}
-	return false
+	return nil
 }
 
-func (x *Authentication) GetExcept() []*AuthenticationException {
+func (x *Requirements) GetProfiles() []int64 {
 	if x != nil {
-		return x.Except
+		return x.Profiles
 	}
 	return nil
 }
 
-// Config represents a service route configuration
-type Config struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PathPrefix     string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
-	TargetUrl      string                 `protobuf:"bytes,4,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`
-	StripPrefix    bool                   `protobuf:"varint,5,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
-	Authentication *Authentication        `protobuf:"bytes,6,opt,name=authentication,proto3" json:"authentication,omitempty"`
-	Middleware     []string               `protobuf:"bytes,7,rep,name=middleware,proto3" json:"middleware,omitempty"`
-	Timeout        int64                  `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty"`                       // Timeout in nanoseconds
-	CreatedAt      int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // Unix timestamp
-	UpdatedAt      int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Requirements) GetClaims() []*ClaimRequirement {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+// AuthenticationException defines paths/methods excepted from authentication rules
+type AuthenticationException struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Methods       []string               `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	Require       *Requirements          `protobuf:"bytes,3,opt,name=require,proto3" json:"require,omitempty"` // Always authenticates the paths/methods, with these requirements instead of the route's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticationException) Reset() {
+	*x = AuthenticationException{}
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Config) String() string {
+func (x *AuthenticationException) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*AuthenticationException) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
+func (x *AuthenticationException) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -172,109 +180,60 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthenticationException.ProtoReflect.Descriptor instead.
+func (*AuthenticationException) Descriptor() ([]byte, []int) {
 	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Config) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Config) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Config) GetPathPrefix() string {
-	if x != nil {
-		return x.PathPrefix
-	}
-	return ""
-}
-
-func (x *Config) GetTargetUrl() string {
+func (x *AuthenticationException) GetPath() string {
 	if x != nil {
-		return x.TargetUrl
+		return x.Path
 	}
 	return ""
 }
 
-func (x *Config) GetStripPrefix() bool {
-	if x != nil {
-		return x.StripPrefix
-	}
-	return false
-}
-
-func (x *Config) GetAuthentication() *Authentication {
+func (x *AuthenticationException) GetMethods() []string {
 	if x != nil {
-		return x.Authentication
+		return x.Methods
 	}
 	return nil
 }
 
-func (x *Config) GetMiddleware() []string {
+func (x *AuthenticationException) GetRequire() *Requirements {
 	if x != nil {
-		return x.Middleware
+		return x.Require
 	}
 	return nil
 }
 
-func (x *Config) GetTimeout() int64 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-func (x *Config) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *Config) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-// CreateConfigRequest is the request to create a new config
-type CreateConfigRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PathPrefix     string                 `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
-	TargetUrl      string                 `protobuf:"bytes,3,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`
-	StripPrefix    bool                   `protobuf:"varint,4,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
-	Authentication *Authentication        `protobuf:"bytes,5,opt,name=authentication,proto3" json:"authentication,omitempty"`
-	Middleware     []string               `protobuf:"bytes,6,rep,name=middleware,proto3" json:"middleware,omitempty"`
-	Timeout        int64                  `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"` // Timeout in nanoseconds, default 30s if not provided
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Authentication defines authentication settings for a route
+type Authentication struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Required bool                   `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	// if required is true, excepted paths/methods do not require authentication
+	// if required is false, excepted paths/methods require authentication
+	Except        []*AuthenticationException `protobuf:"bytes,2,rep,name=except,proto3" json:"except,omitempty"`
+	Strategies    []string                   `protobuf:"bytes,3,rep,name=strategies,proto3" json:"strategies,omitempty"` // Auth strategies tried in order, the gateway default when empty
+	Mode          string                     `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`             // any (default) or all of the strategies must authenticate
+	Require       *Requirements              `protobuf:"bytes,5,opt,name=require,proto3" json:"require,omitempty"`       // What authenticated clients must have
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateConfigRequest) Reset() {
-	*x = CreateConfigRequest{}
+func (x *Authentication) Reset() {
+	*x = Authentication{}
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateConfigRequest) String() string {
+func (x *Authentication) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConfigRequest) ProtoMessage() {}
+func (*Authentication) ProtoMessage() {}
 
-func (x *CreateConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *Authentication) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,83 +245,69 @@ func (x *CreateConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConfigRequest.ProtoReflect.Descriptor instead.
-func (*CreateConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Authentication.ProtoReflect.Descriptor instead.
+func (*Authentication) Descriptor() ([]byte, []int) {
 	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateConfigRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateConfigRequest) GetPathPrefix() string {
-	if x != nil {
-		return x.PathPrefix
-	}
-	return ""
-}
-
-func (x *CreateConfigRequest) GetTargetUrl() string {
+func (x *Authentication) GetRequired() bool {
 	if x != nil {
-		return x.TargetUrl
+		return x.Required
 	}
-	return ""
+	return false
 }
 
-func (x *CreateConfigRequest) GetStripPrefix() bool {
+func (x *Authentication) GetExcept() []*AuthenticationException {
 	if x != nil {
-		return x.StripPrefix
+		return x.Except
 	}
-	return false
+	return nil
 }
 
-func (x *CreateConfigRequest) GetAuthentication() *Authentication {
+func (x *Authentication) GetStrategies() []string {
 	if x != nil {
-		return x.Authentication
+		return x.Strategies
 	}
 	return nil
 }
 
-func (x *CreateConfigRequest) GetMiddleware() []string {
+func (x *Authentication) GetMode() string {
 	if x != nil {
-		return x.Middleware
+		return x.Mode
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateConfigRequest) GetTimeout() int64 {
+func (x *Authentication) GetRequire() *Requirements {
 	if x != nil {
-		return x.Timeout
+		return x.Require
 	}
-	return 0
+	return nil
 }
 
-// CreateConfigResponse is the response after creating a config
-type CreateConfigResponse struct {
+// Target is an upstream instance requests can be forwarded to
+type Target struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *Config                `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Weight        int32                  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"` // Relative weight, defaults to 1
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateConfigResponse) Reset() {
-	*x = CreateConfigResponse{}
+func (x *Target) Reset() {
+	*x = Target{}
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateConfigResponse) String() string {
+func (x *Target) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConfigResponse) ProtoMessage() {}
+func (*Target) ProtoMessage() {}
 
-func (x *CreateConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *Target) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -374,47 +319,49 @@ func (x *CreateConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConfigResponse.ProtoReflect.Descriptor instead.
-func (*CreateConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
 	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateConfigResponse) GetConfig() *Config {
+func (x *Target) GetUrl() string {
 	if x != nil {
-		return x.Config
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateConfigResponse) GetMessage() string {
+func (x *Target) GetWeight() int32 {
 	if x != nil {
-		return x.Message
+		return x.Weight
 	}
-	return ""
+	return 0
 }
 
-// GetConfigRequest is the request to get a config by ID
-type GetConfigRequest struct {
+// RouteVersion is a version of a route's backend receiving a weighted share of its requests
+type RouteVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        int32                  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"` // Share of the requests relative to the other versions, 0 drains the version
+	Targets       []*Target              `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetConfigRequest) Reset() {
-	*x = GetConfigRequest{}
+func (x *RouteVersion) Reset() {
+	*x = RouteVersion{}
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConfigRequest) String() string {
+func (x *RouteVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigRequest) ProtoMessage() {}
+func (*RouteVersion) ProtoMessage() {}
 
-func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *RouteVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -426,41 +373,56 @@ func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteVersion.ProtoReflect.Descriptor instead.
+func (*RouteVersion) Descriptor() ([]byte, []int) {
 	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetConfigRequest) GetId() int64 {
+func (x *RouteVersion) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RouteVersion) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
 	}
 	return 0
 }
 
-// GetConfigResponse is the response containing the requested config
-type GetConfigResponse struct {
+func (x *RouteVersion) GetTargets() []*Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+// TrafficSplit splits the requests of a route between weighted versions of its backend
+type TrafficSplit struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *Config                `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Versions      []*RouteVersion        `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	StickyOn      string                 `protobuf:"bytes,2,opt,name=sticky_on,json=stickyOn,proto3" json:"sticky_on,omitempty"`    // header, cookie or client_ip pinning a client to a version, random when empty
+	StickyKey     string                 `protobuf:"bytes,3,opt,name=sticky_key,json=stickyKey,proto3" json:"sticky_key,omitempty"` // Header or cookie name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetConfigResponse) Reset() {
-	*x = GetConfigResponse{}
+func (x *TrafficSplit) Reset() {
+	*x = TrafficSplit{}
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConfigResponse) String() string {
+func (x *TrafficSplit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigResponse) ProtoMessage() {}
+func (*TrafficSplit) ProtoMessage() {}
 
-func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *TrafficSplit) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,49 +434,57 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrafficSplit.ProtoReflect.Descriptor instead.
+func (*TrafficSplit) Descriptor() ([]byte, []int) {
 	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetConfigResponse) GetConfig() *Config {
+func (x *TrafficSplit) GetVersions() []*RouteVersion {
 	if x != nil {
-		return x.Config
+		return x.Versions
 	}
 	return nil
 }
 
-func (x *GetConfigResponse) GetMessage() string {
+func (x *TrafficSplit) GetStickyOn() string {
 	if x != nil {
-		return x.Message
+		return x.StickyOn
 	}
 	return ""
 }
 
-// ListConfigsRequest is the request to list configs with pagination
-type ListConfigsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"` // Optional search term for name
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *TrafficSplit) GetStickyKey() string {
+	if x != nil {
+		return x.StickyKey
+	}
+	return ""
+}
 
-func (x *ListConfigsRequest) Reset() {
-	*x = ListConfigsRequest{}
+// LoadBalancer defines how requests are spread across a route's targets
+type LoadBalancer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// round_robin (default), weighted_round_robin, least_connections, random_two_choices or consistent_hash
+	Policy        string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	HashOn        string `protobuf:"bytes,2,opt,name=hash_on,json=hashOn,proto3" json:"hash_on,omitempty"`    // consistent_hash only: header, cookie or client_ip
+	HashKey       string `protobuf:"bytes,3,opt,name=hash_key,json=hashKey,proto3" json:"hash_key,omitempty"` // Header or cookie name to hash on
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadBalancer) Reset() {
+	*x = LoadBalancer{}
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListConfigsRequest) String() string {
+func (x *LoadBalancer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConfigsRequest) ProtoMessage() {}
+func (*LoadBalancer) ProtoMessage() {}
 
-func (x *ListConfigsRequest) ProtoReflect() protoreflect.Message {
+func (x *LoadBalancer) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -526,56 +496,59 @@ func (x *ListConfigsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConfigsRequest.ProtoReflect.Descriptor instead.
-func (*ListConfigsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoadBalancer.ProtoReflect.Descriptor instead.
+func (*LoadBalancer) Descriptor() ([]byte, []int) {
 	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListConfigsRequest) GetLimit() int32 {
+func (x *LoadBalancer) GetPolicy() string {
 	if x != nil {
-		return x.Limit
+		return x.Policy
 	}
-	return 0
+	return ""
 }
 
-func (x *ListConfigsRequest) GetOffset() int32 {
+func (x *LoadBalancer) GetHashOn() string {
 	if x != nil {
-		return x.Offset
+		return x.HashOn
 	}
-	return 0
+	return ""
 }
 
-func (x *ListConfigsRequest) GetSearch() string {
+func (x *LoadBalancer) GetHashKey() string {
 	if x != nil {
-		return x.Search
+		return x.HashKey
 	}
 	return ""
 }
 
-// ListConfigsResponse is the response containing a list of configs
-type ListConfigsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Configs       []*Config              `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Total count for pagination
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// HealthCheck defines the active health check probing each target of a route
+type HealthCheck struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Path               string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                                                        // HTTP path probed with GET on every target
+	Interval           int64                  `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`                                               // Interval in nanoseconds, default 10s
+	Timeout            int64                  `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                 // Timeout in nanoseconds, default 2s
+	HealthyThreshold   int32                  `protobuf:"varint,4,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`       // Consecutive successes to mark a target healthy, default 2
+	UnhealthyThreshold int32                  `protobuf:"varint,5,opt,name=unhealthy_threshold,json=unhealthyThreshold,proto3" json:"unhealthy_threshold,omitempty"` // Consecutive failures to mark a target unhealthy, default 3
+	ExpectedStatus     int32                  `protobuf:"varint,6,opt,name=expected_status,json=expectedStatus,proto3" json:"expected_status,omitempty"`             // Expected response status, any 2xx if not set
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListConfigsResponse) Reset() {
-	*x = ListConfigsResponse{}
+func (x *HealthCheck) Reset() {
+	*x = HealthCheck{}
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListConfigsResponse) String() string {
+func (x *HealthCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConfigsResponse) ProtoMessage() {}
+func (*HealthCheck) ProtoMessage() {}
 
-func (x *ListConfigsResponse) ProtoReflect() protoreflect.Message {
+func (x *HealthCheck) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_opengate_v1_config_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -587,54 +560,334 @@ func (x *ListConfigsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConfigsResponse.ProtoReflect.Descriptor instead.
-func (*ListConfigsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthCheck.ProtoReflect.Descriptor instead.
+func (*HealthCheck) Descriptor() ([]byte, []int) {
 	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListConfigsResponse) GetConfigs() []*Config {
+func (x *HealthCheck) GetPath() string {
 	if x != nil {
-		return x.Configs
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetHealthyThreshold() int32 {
+	if x != nil {
+		return x.HealthyThreshold
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetUnhealthyThreshold() int32 {
+	if x != nil {
+		return x.UnhealthyThreshold
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetExpectedStatus() int32 {
+	if x != nil {
+		return x.ExpectedStatus
+	}
+	return 0
+}
+
+// CircuitBreaker defines the passive outlier detection of the targets of a route
+type CircuitBreaker struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ConsecutiveFailures int32                  `protobuf:"varint,1,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"` // Consecutive failures that open the breaker, 0 disables
+	ErrorRateThreshold  int32                  `protobuf:"varint,2,opt,name=error_rate_threshold,json=errorRateThreshold,proto3" json:"error_rate_threshold,omitempty"`  // Failure percentage within the window that opens the breaker, 0 disables
+	MinRequests         int32                  `protobuf:"varint,3,opt,name=min_requests,json=minRequests,proto3" json:"min_requests,omitempty"`                         // Requests within the window before the error rate is evaluated, default 20
+	Window              int64                  `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`                                                      // Error rate window in nanoseconds, default 10s
+	EjectionDuration    int64                  `protobuf:"varint,5,opt,name=ejection_duration,json=ejectionDuration,proto3" json:"ejection_duration,omitempty"`          // How long the breaker stays open in nanoseconds, default 30s
+	HalfOpenRequests    int32                  `protobuf:"varint,6,opt,name=half_open_requests,json=halfOpenRequests,proto3" json:"half_open_requests,omitempty"`        // Successful probe requests needed to close again, default 1
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CircuitBreaker) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetErrorRateThreshold() int32 {
+	if x != nil {
+		return x.ErrorRateThreshold
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetMinRequests() int32 {
+	if x != nil {
+		return x.MinRequests
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetEjectionDuration() int64 {
+	if x != nil {
+		return x.EjectionDuration
+	}
+	return 0
+}
+
+func (x *CircuitBreaker) GetHalfOpenRequests() int32 {
+	if x != nil {
+		return x.HalfOpenRequests
+	}
+	return 0
+}
+
+// RetryPolicy defines when failed upstream attempts of a route are retried
+type RetryPolicy struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Attempts int32                  `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"` // Total attempts including the first one, default 2
+	// connect-failure, reset, timeout, 5xx, gateway-error or status codes such as "503"
+	RetryOn           []string `protobuf:"bytes,2,rep,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
+	PerTryTimeout     int64    `protobuf:"varint,3,opt,name=per_try_timeout,json=perTryTimeout,proto3" json:"per_try_timeout,omitempty"`          // How long each attempt may wait for the response headers in nanoseconds
+	BackoffBase       int64    `protobuf:"varint,4,opt,name=backoff_base,json=backoffBase,proto3" json:"backoff_base,omitempty"`                  // Backoff before the first retry in nanoseconds, default 25ms
+	BackoffMax        int64    `protobuf:"varint,5,opt,name=backoff_max,json=backoffMax,proto3" json:"backoff_max,omitempty"`                     // Upper bound of the backoff in nanoseconds, default 250ms
+	Budget            int32    `protobuf:"varint,6,opt,name=budget,proto3" json:"budget,omitempty"`                                               // Retries allowed as a percentage of the route's requests, default 20
+	MaxBodyBytes      int64    `protobuf:"varint,7,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`             // Larger request bodies are not retried, default 64KB
+	IdempotentMethods []string `protobuf:"bytes,8,rep,name=idempotent_methods,json=idempotentMethods,proto3" json:"idempotent_methods,omitempty"` // Methods such as POST to retry besides the idempotent ones
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RetryPolicy) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryOn() []string {
+	if x != nil {
+		return x.RetryOn
 	}
 	return nil
 }
 
-func (x *ListConfigsResponse) GetTotal() int32 {
+func (x *RetryPolicy) GetPerTryTimeout() int64 {
 	if x != nil {
-		return x.Total
+		return x.PerTryTimeout
 	}
 	return 0
 }
 
-func (x *ListConfigsResponse) GetMessage() string {
+func (x *RetryPolicy) GetBackoffBase() int64 {
 	if x != nil {
-		return x.Message
+		return x.BackoffBase
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffMax() int64 {
+	if x != nil {
+		return x.BackoffMax
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBudget() int32 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxBodyBytes() int64 {
+	if x != nil {
+		return x.MaxBodyBytes
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetIdempotentMethods() []string {
+	if x != nil {
+		return x.IdempotentMethods
+	}
+	return nil
+}
+
+// HeaderMatch matches a request header by exact value, regex or presence
+type HeaderMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Exact         string                 `protobuf:"bytes,2,opt,name=exact,proto3" json:"exact,omitempty"`
+	Regex         string                 `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"` // Matched against the whole value
+	Present       bool                   `protobuf:"varint,4,opt,name=present,proto3" json:"present,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeaderMatch) Reset() {
+	*x = HeaderMatch{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeaderMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderMatch) ProtoMessage() {}
+
+func (x *HeaderMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderMatch.ProtoReflect.Descriptor instead.
+func (*HeaderMatch) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HeaderMatch) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-// GetRoutesRequest is the request to get all routes for routing purposes
-type GetRoutesRequest struct {
+func (x *HeaderMatch) GetExact() string {
+	if x != nil {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *HeaderMatch) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *HeaderMatch) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+// QueryParamMatch matches a query parameter by exact value or presence
+type QueryParamMatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Exact         string                 `protobuf:"bytes,2,opt,name=exact,proto3" json:"exact,omitempty"`
+	Present       bool                   `protobuf:"varint,3,opt,name=present,proto3" json:"present,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoutesRequest) Reset() {
-	*x = GetRoutesRequest{}
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[9]
+func (x *QueryParamMatch) Reset() {
+	*x = QueryParamMatch{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoutesRequest) String() string {
+func (x *QueryParamMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoutesRequest) ProtoMessage() {}
+func (*QueryParamMatch) ProtoMessage() {}
 
-func (x *GetRoutesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[9]
+func (x *QueryParamMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,41 +898,1731 @@ func (x *GetRoutesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoutesRequest.ProtoReflect.Descriptor instead.
-func (*GetRoutesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryParamMatch.ProtoReflect.Descriptor instead.
+func (*QueryParamMatch) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{12}
 }
 
-// Route represents a simplified route for the routing manager
-type Route struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PathPrefix     string                 `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
-	TargetUrl      string                 `protobuf:"bytes,3,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`
-	StripPrefix    bool                   `protobuf:"varint,4,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
-	Authentication *Authentication        `protobuf:"bytes,5,opt,name=authentication,proto3" json:"authentication,omitempty"`
-	Middleware     []string               `protobuf:"bytes,6,rep,name=middleware,proto3" json:"middleware,omitempty"`
-	Timeout        int64                  `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	UpdatedAt      int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *QueryParamMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueryParamMatch) GetExact() string {
+	if x != nil {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *QueryParamMatch) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+// RouteMatch holds the conditions a request must meet besides the path prefix
+type RouteMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Methods       []string               `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"` // Any method when empty
+	Headers       []*HeaderMatch         `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	QueryParams   []*QueryParamMatch     `protobuf:"bytes,3,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteMatch) Reset() {
+	*x = RouteMatch{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteMatch) ProtoMessage() {}
+
+func (x *RouteMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteMatch.ProtoReflect.Descriptor instead.
+func (*RouteMatch) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RouteMatch) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *RouteMatch) GetHeaders() []*HeaderMatch {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RouteMatch) GetQueryParams() []*QueryParamMatch {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+// Rewrite defines how the path and host sent upstream are rewritten
+type Rewrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`           // Replaces the matched part of the path, may use {params}
+	Regex         string                 `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`             // Rewrites the path matching it with replacement
+	Replacement   string                 `protobuf:"bytes,3,opt,name=replacement,proto3" json:"replacement,omitempty"` // May use the capture groups as $1 or ${name}
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`               // Replaces the whole path, may use {params}
+	Host          string                 `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`               // Host header sent upstream, may use {params}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rewrite) Reset() {
+	*x = Rewrite{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rewrite) ProtoMessage() {}
+
+func (x *Rewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rewrite.ProtoReflect.Descriptor instead.
+func (*Rewrite) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Rewrite) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Rewrite) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *Rewrite) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *Rewrite) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Rewrite) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+// Mirror shadows a sample of the requests of a route to a secondary upstream
+type Mirror struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetUrl     string                 `protobuf:"bytes,1,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`
+	Percentage    float64                `protobuf:"fixed64,2,opt,name=percentage,proto3" json:"percentage,omitempty"`                          // Share of the requests mirrored, default 100
+	MaxBodyBytes  int64                  `protobuf:"varint,3,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"` // Requests with larger bodies are not mirrored, default 64KB
+	Timeout       int64                  `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`                                 // Timeout of the mirrored requests in nanoseconds, default 5s
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mirror) Reset() {
+	*x = Mirror{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mirror) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mirror) ProtoMessage() {}
+
+func (x *Mirror) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mirror.ProtoReflect.Descriptor instead.
+func (*Mirror) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Mirror) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *Mirror) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *Mirror) GetMaxBodyBytes() int64 {
+	if x != nil {
+		return x.MaxBodyBytes
+	}
+	return 0
+}
+
+func (x *Mirror) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// ClaimHeader sends a claim of the client upstream as a header
+type ClaimHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Claim         string                 `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"` // Nested claims are joined by dots
+	Header        string                 `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimHeader) Reset() {
+	*x = ClaimHeader{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimHeader) ProtoMessage() {}
+
+func (x *ClaimHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimHeader.ProtoReflect.Descriptor instead.
+func (*ClaimHeader) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClaimHeader) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *ClaimHeader) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+// Identity tells how the identity of a route's clients is sent upstream
+type Identity struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ClaimHeaders       []*ClaimHeader         `protobuf:"bytes,1,rep,name=claim_headers,json=claimHeaders,proto3" json:"claim_headers,omitempty"`
+	OmitDefaultHeaders bool                   `protobuf:"varint,2,opt,name=omit_default_headers,json=omitDefaultHeaders,proto3" json:"omit_default_headers,omitempty"` // Don't send the user id, uuid, profile ids and permissions headers
+	ForwardToken       bool                   `protobuf:"varint,3,opt,name=forward_token,json=forwardToken,proto3" json:"forward_token,omitempty"`                     // Send the client's Authorization header instead of stripping it
+	StaticHeaders      []*KeyValue            `protobuf:"bytes,4,rep,name=static_headers,json=staticHeaders,proto3" json:"static_headers,omitempty"`                   // Headers set on every request of the route
+	InternalToken      bool                   `protobuf:"varint,5,opt,name=internal_token,json=internalToken,proto3" json:"internal_token,omitempty"`                  // Send a JWT minted by the gateway holding the verified claims
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Identity) GetClaimHeaders() []*ClaimHeader {
+	if x != nil {
+		return x.ClaimHeaders
+	}
+	return nil
+}
+
+func (x *Identity) GetOmitDefaultHeaders() bool {
+	if x != nil {
+		return x.OmitDefaultHeaders
+	}
+	return false
+}
+
+func (x *Identity) GetForwardToken() bool {
+	if x != nil {
+		return x.ForwardToken
+	}
+	return false
+}
+
+func (x *Identity) GetStaticHeaders() []*KeyValue {
+	if x != nil {
+		return x.StaticHeaders
+	}
+	return nil
+}
+
+func (x *Identity) GetInternalToken() bool {
+	if x != nil {
+		return x.InternalToken
+	}
+	return false
+}
+
+// RateLimit limits the requests the clients of a route send, each client being counted by its key
+type RateLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"` // token_bucket (default) or sliding_window
+	Requests      int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`  // Requests allowed per window
+	Window        int64                  `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`      // Window in nanoseconds, default 1s
+	Burst         int64                  `protobuf:"varint,4,opt,name=burst,proto3" json:"burst,omitempty"`        // token_bucket only, requests allowed at once, default requests
+	Key           string                 `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`             // client_ip (default), user_id, api_key or header
+	Header        string                 `protobuf:"bytes,6,opt,name=header,proto3" json:"header,omitempty"`       // Header the key is read from with the header key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RateLimit) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *RateLimit) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *RateLimit) GetWindow() int64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *RateLimit) GetBurst() int64 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *RateLimit) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RateLimit) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+// Config represents a service route configuration
+type Config struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PathPrefix       string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	TargetUrl        string                 `protobuf:"bytes,4,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`
+	StripPrefix      bool                   `protobuf:"varint,5,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
+	Authentication   *Authentication        `protobuf:"bytes,6,opt,name=authentication,proto3" json:"authentication,omitempty"`
+	Middleware       []string               `protobuf:"bytes,7,rep,name=middleware,proto3" json:"middleware,omitempty"`
+	Timeout          int64                  `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty"`                       // Timeout in nanoseconds
+	CreatedAt        int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // Unix timestamp
+	UpdatedAt        int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unix timestamp
+	Targets          []*Target              `protobuf:"bytes,11,rep,name=targets,proto3" json:"targets,omitempty"`                       // Takes precedence over target_url when set
+	LoadBalancer     *LoadBalancer          `protobuf:"bytes,12,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
+	HealthCheck      *HealthCheck           `protobuf:"bytes,13,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	CircuitBreaker   *CircuitBreaker        `protobuf:"bytes,14,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	RetryPolicy      *RetryPolicy           `protobuf:"bytes,15,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	MiddlewareConfig *structpb.Struct       `protobuf:"bytes,16,opt,name=middleware_config,json=middlewareConfig,proto3" json:"middleware_config,omitempty"` // Config of the middlewares by name
+	Hosts            []string               `protobuf:"bytes,17,rep,name=hosts,proto3" json:"hosts,omitempty"`                                               // Exact or wildcard (*.example.com) hosts, any host when empty
+	Match            *RouteMatch            `protobuf:"bytes,18,opt,name=match,proto3" json:"match,omitempty"`
+	Priority         int32                  `protobuf:"varint,19,opt,name=priority,proto3" json:"priority,omitempty"` // Higher priority routes of the same prefix are tried first
+	Rewrite          *Rewrite               `protobuf:"bytes,20,opt,name=rewrite,proto3" json:"rewrite,omitempty"`    // Can't be combined with strip_prefix
+	Split            *TrafficSplit          `protobuf:"bytes,21,opt,name=split,proto3" json:"split,omitempty"`        // Takes precedence over target_url and targets
+	Mirror           *Mirror                `protobuf:"bytes,22,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	Identity         *Identity              `protobuf:"bytes,23,opt,name=identity,proto3" json:"identity,omitempty"`
+	RateLimit        *RateLimit             `protobuf:"bytes,24,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Config) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Config) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *Config) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *Config) GetStripPrefix() bool {
+	if x != nil {
+		return x.StripPrefix
+	}
+	return false
+}
+
+func (x *Config) GetAuthentication() *Authentication {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *Config) GetMiddleware() []string {
+	if x != nil {
+		return x.Middleware
+	}
+	return nil
+}
+
+func (x *Config) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *Config) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Config) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Config) GetTargets() []*Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *Config) GetLoadBalancer() *LoadBalancer {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
+}
+
+func (x *Config) GetHealthCheck() *HealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *Config) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+func (x *Config) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Config) GetMiddlewareConfig() *structpb.Struct {
+	if x != nil {
+		return x.MiddlewareConfig
+	}
+	return nil
+}
+
+func (x *Config) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *Config) GetMatch() *RouteMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *Config) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Config) GetRewrite() *Rewrite {
+	if x != nil {
+		return x.Rewrite
+	}
+	return nil
+}
+
+func (x *Config) GetSplit() *TrafficSplit {
+	if x != nil {
+		return x.Split
+	}
+	return nil
+}
+
+func (x *Config) GetMirror() *Mirror {
+	if x != nil {
+		return x.Mirror
+	}
+	return nil
+}
+
+func (x *Config) GetIdentity() *Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *Config) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+// CreateConfigRequest is the request to create a new config
+type CreateConfigRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PathPrefix       string                 `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	TargetUrl        string                 `protobuf:"bytes,3,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"` // Required unless targets or split are provided
+	StripPrefix      bool                   `protobuf:"varint,4,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
+	Authentication   *Authentication        `protobuf:"bytes,5,opt,name=authentication,proto3" json:"authentication,omitempty"`
+	Middleware       []string               `protobuf:"bytes,6,rep,name=middleware,proto3" json:"middleware,omitempty"`
+	Timeout          int64                  `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"` // Timeout in nanoseconds, default 30s if not provided
+	Targets          []*Target              `protobuf:"bytes,8,rep,name=targets,proto3" json:"targets,omitempty"`
+	LoadBalancer     *LoadBalancer          `protobuf:"bytes,9,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
+	HealthCheck      *HealthCheck           `protobuf:"bytes,10,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	CircuitBreaker   *CircuitBreaker        `protobuf:"bytes,11,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	RetryPolicy      *RetryPolicy           `protobuf:"bytes,12,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	MiddlewareConfig *structpb.Struct       `protobuf:"bytes,13,opt,name=middleware_config,json=middlewareConfig,proto3" json:"middleware_config,omitempty"` // Config of the middlewares by name
+	Hosts            []string               `protobuf:"bytes,14,rep,name=hosts,proto3" json:"hosts,omitempty"`                                               // Exact or wildcard (*.example.com) hosts, any host when empty
+	Match            *RouteMatch            `protobuf:"bytes,15,opt,name=match,proto3" json:"match,omitempty"`
+	Priority         int32                  `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"` // Higher priority routes of the same prefix are tried first
+	Rewrite          *Rewrite               `protobuf:"bytes,17,opt,name=rewrite,proto3" json:"rewrite,omitempty"`    // Can't be combined with strip_prefix
+	Split            *TrafficSplit          `protobuf:"bytes,18,opt,name=split,proto3" json:"split,omitempty"`        // Takes precedence over target_url and targets
+	Mirror           *Mirror                `protobuf:"bytes,19,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	Identity         *Identity              `protobuf:"bytes,20,opt,name=identity,proto3" json:"identity,omitempty"`
+	RateLimit        *RateLimit             `protobuf:"bytes,21,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateConfigRequest) Reset() {
+	*x = CreateConfigRequest{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConfigRequest) ProtoMessage() {}
+
+func (x *CreateConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateConfigRequest) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *CreateConfigRequest) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *CreateConfigRequest) GetStripPrefix() bool {
+	if x != nil {
+		return x.StripPrefix
+	}
+	return false
+}
+
+func (x *CreateConfigRequest) GetAuthentication() *Authentication {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetMiddleware() []string {
+	if x != nil {
+		return x.Middleware
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *CreateConfigRequest) GetTargets() []*Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetLoadBalancer() *LoadBalancer {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetHealthCheck() *HealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetMiddlewareConfig() *structpb.Struct {
+	if x != nil {
+		return x.MiddlewareConfig
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetMatch() *RouteMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateConfigRequest) GetRewrite() *Rewrite {
+	if x != nil {
+		return x.Rewrite
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetSplit() *TrafficSplit {
+	if x != nil {
+		return x.Split
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetMirror() *Mirror {
+	if x != nil {
+		return x.Mirror
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetIdentity() *Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *CreateConfigRequest) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+// CreateConfigResponse is the response after creating a config
+type CreateConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *Config                `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateConfigResponse) Reset() {
+	*x = CreateConfigResponse{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConfigResponse) ProtoMessage() {}
+
+func (x *CreateConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConfigResponse.ProtoReflect.Descriptor instead.
+func (*CreateConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateConfigResponse) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetConfigRequest is the request to get a config by ID
+type GetConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetConfigRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetConfigResponse is the response containing the requested config
+type GetConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *Config                `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigResponse) ProtoMessage() {}
+
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetConfigResponse) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ListConfigsRequest is the request to list configs with pagination
+type ListConfigsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"` // Optional search term for name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigsRequest) Reset() {
+	*x = ListConfigsRequest{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigsRequest) ProtoMessage() {}
+
+func (x *ListConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListConfigsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListConfigsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListConfigsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+// ListConfigsResponse is the response containing a list of configs
+type ListConfigsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*Config              `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Total count for pagination
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigsResponse) Reset() {
+	*x = ListConfigsResponse{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigsResponse) ProtoMessage() {}
+
+func (x *ListConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListConfigsResponse) GetConfigs() []*Config {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+func (x *ListConfigsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListConfigsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetRoutesRequest is the request to get all routes for routing purposes
+type GetRoutesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutesRequest) Reset() {
+	*x = GetRoutesRequest{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutesRequest) ProtoMessage() {}
+
+func (x *GetRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoutesRequest.ProtoReflect.Descriptor instead.
+func (*GetRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{26}
+}
+
+// Route represents a simplified route for the routing manager
+type Route struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PathPrefix       string                 `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	TargetUrl        string                 `protobuf:"bytes,3,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`
+	StripPrefix      bool                   `protobuf:"varint,4,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
+	Authentication   *Authentication        `protobuf:"bytes,5,opt,name=authentication,proto3" json:"authentication,omitempty"`
+	Middleware       []string               `protobuf:"bytes,6,rep,name=middleware,proto3" json:"middleware,omitempty"`
+	Timeout          int64                  `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	UpdatedAt        int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Targets          []*Target              `protobuf:"bytes,9,rep,name=targets,proto3" json:"targets,omitempty"`
+	LoadBalancer     *LoadBalancer          `protobuf:"bytes,10,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
+	HealthCheck      *HealthCheck           `protobuf:"bytes,11,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	CircuitBreaker   *CircuitBreaker        `protobuf:"bytes,12,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	RetryPolicy      *RetryPolicy           `protobuf:"bytes,13,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	MiddlewareConfig *structpb.Struct       `protobuf:"bytes,14,opt,name=middleware_config,json=middlewareConfig,proto3" json:"middleware_config,omitempty"` // Config of the middlewares by name
+	Hosts            []string               `protobuf:"bytes,15,rep,name=hosts,proto3" json:"hosts,omitempty"`                                               // Exact or wildcard (*.example.com) hosts, any host when empty
+	Match            *RouteMatch            `protobuf:"bytes,16,opt,name=match,proto3" json:"match,omitempty"`
+	Priority         int32                  `protobuf:"varint,17,opt,name=priority,proto3" json:"priority,omitempty"` // Higher priority routes of the same prefix are tried first
+	Rewrite          *Rewrite               `protobuf:"bytes,18,opt,name=rewrite,proto3" json:"rewrite,omitempty"`    // Can't be combined with strip_prefix
+	Split            *TrafficSplit          `protobuf:"bytes,19,opt,name=split,proto3" json:"split,omitempty"`        // Takes precedence over target_url and targets
+	Mirror           *Mirror                `protobuf:"bytes,20,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	Identity         *Identity              `protobuf:"bytes,21,opt,name=identity,proto3" json:"identity,omitempty"`
+	RateLimit        *RateLimit             `protobuf:"bytes,22,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Route) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Route) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *Route) GetTargetUrl() string {
+	if x != nil {
+		return x.TargetUrl
+	}
+	return ""
+}
+
+func (x *Route) GetStripPrefix() bool {
+	if x != nil {
+		return x.StripPrefix
+	}
+	return false
+}
+
+func (x *Route) GetAuthentication() *Authentication {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *Route) GetMiddleware() []string {
+	if x != nil {
+		return x.Middleware
+	}
+	return nil
+}
+
+func (x *Route) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *Route) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Route) GetTargets() []*Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *Route) GetLoadBalancer() *LoadBalancer {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
+}
+
+func (x *Route) GetHealthCheck() *HealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *Route) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+func (x *Route) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Route) GetMiddlewareConfig() *structpb.Struct {
+	if x != nil {
+		return x.MiddlewareConfig
+	}
+	return nil
+}
+
+func (x *Route) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *Route) GetMatch() *RouteMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *Route) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Route) GetRewrite() *Rewrite {
+	if x != nil {
+		return x.Rewrite
+	}
+	return nil
+}
+
+func (x *Route) GetSplit() *TrafficSplit {
+	if x != nil {
+		return x.Split
+	}
+	return nil
+}
+
+func (x *Route) GetMirror() *Mirror {
+	if x != nil {
+		return x.Mirror
+	}
+	return nil
+}
+
+func (x *Route) GetIdentity() *Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *Route) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+// GetRoutesResponse contains all routes for the routing manager
+type GetRoutesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Routes        []*Route               `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoutesResponse) Reset() {
+	*x = GetRoutesResponse{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoutesResponse) ProtoMessage() {}
+
+func (x *GetRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoutesResponse.ProtoReflect.Descriptor instead.
+func (*GetRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetRoutesResponse) GetRoutes() []*Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *GetRoutesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// KeyValue is a named value such as a header or a path parameter
+type KeyValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyValue) Reset() {
+	*x = KeyValue{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyValue) ProtoMessage() {}
+
+func (x *KeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyValue.ProtoReflect.Descriptor instead.
+func (*KeyValue) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *KeyValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// TestRouteRequest describes a request to run through the routing table
+type TestRouteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"` // Path with an optional query string
+	Headers       []*KeyValue            `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestRouteRequest) Reset() {
+	*x = TestRouteRequest{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRouteRequest) ProtoMessage() {}
+
+func (x *TestRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRouteRequest.ProtoReflect.Descriptor instead.
+func (*TestRouteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TestRouteRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *TestRouteRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *TestRouteRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TestRouteRequest) GetHeaders() []*KeyValue {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// TestRouteResponse tells how the gateway would route the request
+type TestRouteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matched       bool                   `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Route         string                 `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"` // Name of the selected route
+	PathParams    []*KeyValue            `protobuf:"bytes,3,rep,name=path_params,json=pathParams,proto3" json:"path_params,omitempty"`
+	MatchedPrefix string                 `protobuf:"bytes,4,opt,name=matched_prefix,json=matchedPrefix,proto3" json:"matched_prefix,omitempty"` // Part of the path matched by the route's pattern
+	UpstreamPath  string                 `protobuf:"bytes,5,opt,name=upstream_path,json=upstreamPath,proto3" json:"upstream_path,omitempty"`    // Path sent upstream after strip_prefix and rewrite
+	UpstreamHost  string                 `protobuf:"bytes,6,opt,name=upstream_host,json=upstreamHost,proto3" json:"upstream_host,omitempty"`    // Host header sent upstream, empty to keep the client's
+	Targets       []string               `protobuf:"bytes,7,rep,name=targets,proto3" json:"targets,omitempty"`                                  // Upstream targets the request may be sent to
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestRouteResponse) Reset() {
+	*x = TestRouteResponse{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestRouteResponse) ProtoMessage() {}
+
+func (x *TestRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestRouteResponse.ProtoReflect.Descriptor instead.
+func (*TestRouteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TestRouteResponse) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *TestRouteResponse) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *TestRouteResponse) GetPathParams() []*KeyValue {
+	if x != nil {
+		return x.PathParams
+	}
+	return nil
+}
+
+func (x *TestRouteResponse) GetMatchedPrefix() string {
+	if x != nil {
+		return x.MatchedPrefix
+	}
+	return ""
+}
+
+func (x *TestRouteResponse) GetUpstreamPath() string {
+	if x != nil {
+		return x.UpstreamPath
+	}
+	return ""
+}
+
+func (x *TestRouteResponse) GetUpstreamHost() string {
+	if x != nil {
+		return x.UpstreamHost
+	}
+	return ""
+}
+
+func (x *TestRouteResponse) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *TestRouteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UpdateConfigRequest is the request to update an existing config
+type UpdateConfigRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PathPrefix       string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	TargetUrl        string                 `protobuf:"bytes,4,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"` // Required unless targets or split are provided
+	StripPrefix      bool                   `protobuf:"varint,5,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
+	Authentication   *Authentication        `protobuf:"bytes,6,opt,name=authentication,proto3" json:"authentication,omitempty"`
+	Middleware       []string               `protobuf:"bytes,7,rep,name=middleware,proto3" json:"middleware,omitempty"`
+	Timeout          int64                  `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Targets          []*Target              `protobuf:"bytes,9,rep,name=targets,proto3" json:"targets,omitempty"`
+	LoadBalancer     *LoadBalancer          `protobuf:"bytes,10,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
+	HealthCheck      *HealthCheck           `protobuf:"bytes,11,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	CircuitBreaker   *CircuitBreaker        `protobuf:"bytes,12,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	RetryPolicy      *RetryPolicy           `protobuf:"bytes,13,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	MiddlewareConfig *structpb.Struct       `protobuf:"bytes,14,opt,name=middleware_config,json=middlewareConfig,proto3" json:"middleware_config,omitempty"` // Config of the middlewares by name
+	Hosts            []string               `protobuf:"bytes,15,rep,name=hosts,proto3" json:"hosts,omitempty"`                                               // Exact or wildcard (*.example.com) hosts, any host when empty
+	Match            *RouteMatch            `protobuf:"bytes,16,opt,name=match,proto3" json:"match,omitempty"`
+	Priority         int32                  `protobuf:"varint,17,opt,name=priority,proto3" json:"priority,omitempty"` // Higher priority routes of the same prefix are tried first
+	Rewrite          *Rewrite               `protobuf:"bytes,18,opt,name=rewrite,proto3" json:"rewrite,omitempty"`    // Can't be combined with strip_prefix
+	Split            *TrafficSplit          `protobuf:"bytes,19,opt,name=split,proto3" json:"split,omitempty"`        // Takes precedence over target_url and targets
+	Mirror           *Mirror                `protobuf:"bytes,20,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	Identity         *Identity              `protobuf:"bytes,21,opt,name=identity,proto3" json:"identity,omitempty"`
+	RateLimit        *RateLimit             `protobuf:"bytes,22,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Route) Reset() {
-	*x = Route{}
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[10]
+func (x *UpdateConfigRequest) Reset() {
+	*x = UpdateConfigRequest{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Route) String() string {
+func (x *UpdateConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Route) ProtoMessage() {}
+func (*UpdateConfigRequest) ProtoMessage() {}
 
-func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[10]
+func (x *UpdateConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,91 +2633,189 @@ func (x *Route) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Route.ProtoReflect.Descriptor instead.
-func (*Route) Descriptor() ([]byte, []int) {
-	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpdateConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Route) GetName() string {
+func (x *UpdateConfigRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateConfigRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Route) GetPathPrefix() string {
+func (x *UpdateConfigRequest) GetPathPrefix() string {
 	if x != nil {
 		return x.PathPrefix
 	}
 	return ""
 }
 
-func (x *Route) GetTargetUrl() string {
+func (x *UpdateConfigRequest) GetTargetUrl() string {
 	if x != nil {
 		return x.TargetUrl
 	}
 	return ""
 }
 
-func (x *Route) GetStripPrefix() bool {
+func (x *UpdateConfigRequest) GetStripPrefix() bool {
 	if x != nil {
 		return x.StripPrefix
 	}
 	return false
 }
 
-func (x *Route) GetAuthentication() *Authentication {
+func (x *UpdateConfigRequest) GetAuthentication() *Authentication {
 	if x != nil {
 		return x.Authentication
 	}
 	return nil
 }
 
-func (x *Route) GetMiddleware() []string {
+func (x *UpdateConfigRequest) GetMiddleware() []string {
 	if x != nil {
 		return x.Middleware
 	}
 	return nil
 }
 
-func (x *Route) GetTimeout() int64 {
+func (x *UpdateConfigRequest) GetTimeout() int64 {
 	if x != nil {
 		return x.Timeout
 	}
 	return 0
 }
 
-func (x *Route) GetUpdatedAt() int64 {
+func (x *UpdateConfigRequest) GetTargets() []*Target {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetLoadBalancer() *LoadBalancer {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetHealthCheck() *HealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetMiddlewareConfig() *structpb.Struct {
+	if x != nil {
+		return x.MiddlewareConfig
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetMatch() *RouteMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
 	}
 	return 0
 }
 
-// GetRoutesResponse contains all routes for the routing manager
-type GetRoutesResponse struct {
+func (x *UpdateConfigRequest) GetRewrite() *Rewrite {
+	if x != nil {
+		return x.Rewrite
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetSplit() *TrafficSplit {
+	if x != nil {
+		return x.Split
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetMirror() *Mirror {
+	if x != nil {
+		return x.Mirror
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetIdentity() *Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *UpdateConfigRequest) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+// UpdateConfigResponse is the response after updating a config
+type UpdateConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Routes        []*Route               `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	Config        *Config                `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoutesResponse) Reset() {
-	*x = GetRoutesResponse{}
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[11]
+func (x *UpdateConfigResponse) Reset() {
+	*x = UpdateConfigResponse{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoutesResponse) String() string {
+func (x *UpdateConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoutesResponse) ProtoMessage() {}
+func (*UpdateConfigResponse) ProtoMessage() {}
 
-func (x *GetRoutesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[11]
+func (x *UpdateConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,55 +2826,49 @@ func (x *GetRoutesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoutesResponse.ProtoReflect.Descriptor instead.
-func (*GetRoutesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpdateConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetRoutesResponse) GetRoutes() []*Route {
+func (x *UpdateConfigResponse) GetConfig() *Config {
 	if x != nil {
-		return x.Routes
+		return x.Config
 	}
 	return nil
 }
 
-func (x *GetRoutesResponse) GetMessage() string {
+func (x *UpdateConfigResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// UpdateConfigRequest is the request to update an existing config
-type UpdateConfigRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PathPrefix     string                 `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
-	TargetUrl      string                 `protobuf:"bytes,4,opt,name=target_url,json=targetUrl,proto3" json:"target_url,omitempty"`
-	StripPrefix    bool                   `protobuf:"varint,5,opt,name=strip_prefix,json=stripPrefix,proto3" json:"strip_prefix,omitempty"`
-	Authentication *Authentication        `protobuf:"bytes,6,opt,name=authentication,proto3" json:"authentication,omitempty"`
-	Middleware     []string               `protobuf:"bytes,7,rep,name=middleware,proto3" json:"middleware,omitempty"`
-	Timeout        int64                  `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// VersionWeight is the new weight of a version of a split route
+type VersionWeight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Weight        int32                  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateConfigRequest) Reset() {
-	*x = UpdateConfigRequest{}
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[12]
+func (x *VersionWeight) Reset() {
+	*x = VersionWeight{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateConfigRequest) String() string {
+func (x *VersionWeight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateConfigRequest) ProtoMessage() {}
+func (*VersionWeight) ProtoMessage() {}
 
-func (x *UpdateConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[12]
+func (x *VersionWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,69 +2879,80 @@ func (x *UpdateConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateConfigRequest.ProtoReflect.Descriptor instead.
-func (*UpdateConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use VersionWeight.ProtoReflect.Descriptor instead.
+func (*VersionWeight) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateConfigRequest) GetId() int64 {
+func (x *VersionWeight) GetVersion() string {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateConfigRequest) GetName() string {
+func (x *VersionWeight) GetWeight() int32 {
 	if x != nil {
-		return x.Name
+		return x.Weight
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateConfigRequest) GetPathPrefix() string {
-	if x != nil {
-		return x.PathPrefix
-	}
-	return ""
+// SetRouteWeightsRequest shifts the traffic of a split route between its versions
+type SetRouteWeightsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Weights       []*VersionWeight       `protobuf:"bytes,2,rep,name=weights,proto3" json:"weights,omitempty"` // Versions left out keep their weight
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateConfigRequest) GetTargetUrl() string {
-	if x != nil {
-		return x.TargetUrl
-	}
-	return ""
+func (x *SetRouteWeightsRequest) Reset() {
+	*x = SetRouteWeightsRequest{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateConfigRequest) GetStripPrefix() bool {
-	if x != nil {
-		return x.StripPrefix
-	}
-	return false
+func (x *SetRouteWeightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateConfigRequest) GetAuthentication() *Authentication {
+func (*SetRouteWeightsRequest) ProtoMessage() {}
+
+func (x *SetRouteWeightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[35]
 	if x != nil {
-		return x.Authentication
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateConfigRequest) GetMiddleware() []string {
+// Deprecated: Use SetRouteWeightsRequest.ProtoReflect.Descriptor instead.
+func (*SetRouteWeightsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetRouteWeightsRequest) GetId() int64 {
 	if x != nil {
-		return x.Middleware
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateConfigRequest) GetTimeout() int64 {
+func (x *SetRouteWeightsRequest) GetWeights() []*VersionWeight {
 	if x != nil {
-		return x.Timeout
+		return x.Weights
 	}
-	return 0
+	return nil
 }
 
-// UpdateConfigResponse is the response after updating a config
-type UpdateConfigResponse struct {
+// SetRouteWeightsResponse is the response after shifting the weights
+type SetRouteWeightsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Config        *Config                `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -914,21 +2960,21 @@ type UpdateConfigResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateConfigResponse) Reset() {
-	*x = UpdateConfigResponse{}
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[13]
+func (x *SetRouteWeightsResponse) Reset() {
+	*x = SetRouteWeightsResponse{}
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateConfigResponse) String() string {
+func (x *SetRouteWeightsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateConfigResponse) ProtoMessage() {}
+func (*SetRouteWeightsResponse) ProtoMessage() {}
 
-func (x *UpdateConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[13]
+func (x *SetRouteWeightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,19 +2985,19 @@ func (x *UpdateConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateConfigResponse.ProtoReflect.Descriptor instead.
-func (*UpdateConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SetRouteWeightsResponse.ProtoReflect.Descriptor instead.
+func (*SetRouteWeightsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *UpdateConfigResponse) GetConfig() *Config {
+func (x *SetRouteWeightsResponse) GetConfig() *Config {
 	if x != nil {
 		return x.Config
 	}
 	return nil
 }
 
-func (x *UpdateConfigResponse) GetMessage() string {
+func (x *SetRouteWeightsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
@@ -968,7 +3014,7 @@ type DeleteConfigRequest struct {
 
 func (x *DeleteConfigRequest) Reset() {
 	*x = DeleteConfigRequest{}
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[14]
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -980,7 +3026,7 @@ func (x *DeleteConfigRequest) String() string {
 func (*DeleteConfigRequest) ProtoMessage() {}
 
 func (x *DeleteConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[14]
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,7 +3039,7 @@ func (x *DeleteConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConfigRequest.ProtoReflect.Descriptor instead.
 func (*DeleteConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{14}
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *DeleteConfigRequest) GetId() int64 {
@@ -1013,7 +3059,7 @@ type DeleteConfigResponse struct {
 
 func (x *DeleteConfigResponse) Reset() {
 	*x = DeleteConfigResponse{}
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[15]
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1025,7 +3071,7 @@ func (x *DeleteConfigResponse) String() string {
 func (*DeleteConfigResponse) ProtoMessage() {}
 
 func (x *DeleteConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[15]
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,7 +3084,7 @@ func (x *DeleteConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConfigResponse.ProtoReflect.Descriptor instead.
 func (*DeleteConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{15}
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DeleteConfigResponse) GetMessage() string {
@@ -1057,7 +3103,7 @@ type GetStatsRequest struct {
 
 func (x *GetStatsRequest) Reset() {
 	*x = GetStatsRequest{}
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[16]
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1069,7 +3115,7 @@ func (x *GetStatsRequest) String() string {
 func (*GetStatsRequest) ProtoMessage() {}
 
 func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[16]
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,21 +3128,23 @@ func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{16}
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{39}
 }
 
 // GetStatsResponse contains dashboard statistics
 type GetStatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalRoutes   int32                  `protobuf:"varint,1,opt,name=total_routes,json=totalRoutes,proto3" json:"total_routes,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TotalRoutes      int32                  `protobuf:"varint,1,opt,name=total_routes,json=totalRoutes,proto3" json:"total_routes,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	HealthyTargets   int32                  `protobuf:"varint,3,opt,name=healthy_targets,json=healthyTargets,proto3" json:"healthy_targets,omitempty"`       // Targets of health checked routes currently in rotation
+	UnhealthyTargets int32                  `protobuf:"varint,4,opt,name=unhealthy_targets,json=unhealthyTargets,proto3" json:"unhealthy_targets,omitempty"` // Targets of health checked routes removed from rotation
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetStatsResponse) Reset() {
 	*x = GetStatsResponse{}
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[17]
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1108,7 +3156,7 @@ func (x *GetStatsResponse) String() string {
 func (*GetStatsResponse) ProtoMessage() {}
 
 func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_opengate_v1_config_proto_msgTypes[17]
+	mi := &file_proto_opengate_v1_config_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,7 +3169,7 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{17}
+	return file_proto_opengate_v1_config_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetStatsResponse) GetTotalRoutes() int32 {
@@ -1138,17 +3186,129 @@ func (x *GetStatsResponse) GetMessage() string {
 	return ""
 }
 
+func (x *GetStatsResponse) GetHealthyTargets() int32 {
+	if x != nil {
+		return x.HealthyTargets
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetUnhealthyTargets() int32 {
+	if x != nil {
+		return x.UnhealthyTargets
+	}
+	return 0
+}
+
 var File_proto_opengate_v1_config_proto protoreflect.FileDescriptor
 
 const file_proto_opengate_v1_config_proto_rawDesc = "" +
 	"\n" +
-	"\x1eproto/opengate/v1/config.proto\x12\vopengate.v1\x1a\x17validate/validate.proto\"G\n" +
+	"\x1eproto/opengate/v1/config.proto\x12\vopengate.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x17validate/validate.proto\"Z\n" +
+	"\x10ClaimRequirement\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"\x83\x01\n" +
+	"\fRequirements\x12 \n" +
+	"\vpermissions\x18\x01 \x03(\tR\vpermissions\x12\x1a\n" +
+	"\bprofiles\x18\x02 \x03(\x03R\bprofiles\x125\n" +
+	"\x06claims\x18\x03 \x03(\v2\x1d.opengate.v1.ClaimRequirementR\x06claims\"|\n" +
 	"\x17AuthenticationException\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
-	"\amethods\x18\x02 \x03(\tR\amethods\"j\n" +
+	"\amethods\x18\x02 \x03(\tR\amethods\x123\n" +
+	"\arequire\x18\x03 \x01(\v2\x19.opengate.v1.RequirementsR\arequire\"\xd3\x01\n" +
 	"\x0eAuthentication\x12\x1a\n" +
 	"\brequired\x18\x01 \x01(\bR\brequired\x12<\n" +
-	"\x06except\x18\x02 \x03(\v2$.opengate.v1.AuthenticationExceptionR\x06except\"\xcc\x02\n" +
+	"\x06except\x18\x02 \x03(\v2$.opengate.v1.AuthenticationExceptionR\x06except\x12\x1e\n" +
+	"\n" +
+	"strategies\x18\x03 \x03(\tR\n" +
+	"strategies\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\x123\n" +
+	"\arequire\x18\x05 \x01(\v2\x19.opengate.v1.RequirementsR\arequire\"2\n" +
+	"\x06Target\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x05R\x06weight\"i\n" +
+	"\fRouteVersion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x05R\x06weight\x12-\n" +
+	"\atargets\x18\x03 \x03(\v2\x13.opengate.v1.TargetR\atargets\"\x81\x01\n" +
+	"\fTrafficSplit\x125\n" +
+	"\bversions\x18\x01 \x03(\v2\x19.opengate.v1.RouteVersionR\bversions\x12\x1b\n" +
+	"\tsticky_on\x18\x02 \x01(\tR\bstickyOn\x12\x1d\n" +
+	"\n" +
+	"sticky_key\x18\x03 \x01(\tR\tstickyKey\"Z\n" +
+	"\fLoadBalancer\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x12\x17\n" +
+	"\ahash_on\x18\x02 \x01(\tR\x06hashOn\x12\x19\n" +
+	"\bhash_key\x18\x03 \x01(\tR\ahashKey\"\xde\x01\n" +
+	"\vHealthCheck\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\x03R\binterval\x12\x18\n" +
+	"\atimeout\x18\x03 \x01(\x03R\atimeout\x12+\n" +
+	"\x11healthy_threshold\x18\x04 \x01(\x05R\x10healthyThreshold\x12/\n" +
+	"\x13unhealthy_threshold\x18\x05 \x01(\x05R\x12unhealthyThreshold\x12'\n" +
+	"\x0fexpected_status\x18\x06 \x01(\x05R\x0eexpectedStatus\"\x8b\x02\n" +
+	"\x0eCircuitBreaker\x121\n" +
+	"\x14consecutive_failures\x18\x01 \x01(\x05R\x13consecutiveFailures\x120\n" +
+	"\x14error_rate_threshold\x18\x02 \x01(\x05R\x12errorRateThreshold\x12!\n" +
+	"\fmin_requests\x18\x03 \x01(\x05R\vminRequests\x12\x16\n" +
+	"\x06window\x18\x04 \x01(\x03R\x06window\x12+\n" +
+	"\x11ejection_duration\x18\x05 \x01(\x03R\x10ejectionDuration\x12,\n" +
+	"\x12half_open_requests\x18\x06 \x01(\x05R\x10halfOpenRequests\"\x9d\x02\n" +
+	"\vRetryPolicy\x12\x1a\n" +
+	"\battempts\x18\x01 \x01(\x05R\battempts\x12\x19\n" +
+	"\bretry_on\x18\x02 \x03(\tR\aretryOn\x12&\n" +
+	"\x0fper_try_timeout\x18\x03 \x01(\x03R\rperTryTimeout\x12!\n" +
+	"\fbackoff_base\x18\x04 \x01(\x03R\vbackoffBase\x12\x1f\n" +
+	"\vbackoff_max\x18\x05 \x01(\x03R\n" +
+	"backoffMax\x12\x16\n" +
+	"\x06budget\x18\x06 \x01(\x05R\x06budget\x12$\n" +
+	"\x0emax_body_bytes\x18\a \x01(\x03R\fmaxBodyBytes\x12-\n" +
+	"\x12idempotent_methods\x18\b \x03(\tR\x11idempotentMethods\"g\n" +
+	"\vHeaderMatch\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05exact\x18\x02 \x01(\tR\x05exact\x12\x14\n" +
+	"\x05regex\x18\x03 \x01(\tR\x05regex\x12\x18\n" +
+	"\apresent\x18\x04 \x01(\bR\apresent\"U\n" +
+	"\x0fQueryParamMatch\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05exact\x18\x02 \x01(\tR\x05exact\x12\x18\n" +
+	"\apresent\x18\x03 \x01(\bR\apresent\"\x9b\x01\n" +
+	"\n" +
+	"RouteMatch\x12\x18\n" +
+	"\amethods\x18\x01 \x03(\tR\amethods\x122\n" +
+	"\aheaders\x18\x02 \x03(\v2\x18.opengate.v1.HeaderMatchR\aheaders\x12?\n" +
+	"\fquery_params\x18\x03 \x03(\v2\x1c.opengate.v1.QueryParamMatchR\vqueryParams\"\x81\x01\n" +
+	"\aRewrite\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05regex\x18\x02 \x01(\tR\x05regex\x12 \n" +
+	"\vreplacement\x18\x03 \x01(\tR\vreplacement\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x12\n" +
+	"\x04host\x18\x05 \x01(\tR\x04host\"\x87\x01\n" +
+	"\x06Mirror\x12\x1d\n" +
+	"\n" +
+	"target_url\x18\x01 \x01(\tR\ttargetUrl\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x02 \x01(\x01R\n" +
+	"percentage\x12$\n" +
+	"\x0emax_body_bytes\x18\x03 \x01(\x03R\fmaxBodyBytes\x12\x18\n" +
+	"\atimeout\x18\x04 \x01(\x03R\atimeout\";\n" +
+	"\vClaimHeader\x12\x14\n" +
+	"\x05claim\x18\x01 \x01(\tR\x05claim\x12\x16\n" +
+	"\x06header\x18\x02 \x01(\tR\x06header\"\x85\x02\n" +
+	"\bIdentity\x12=\n" +
+	"\rclaim_headers\x18\x01 \x03(\v2\x18.opengate.v1.ClaimHeaderR\fclaimHeaders\x120\n" +
+	"\x14omit_default_headers\x18\x02 \x01(\bR\x12omitDefaultHeaders\x12#\n" +
+	"\rforward_token\x18\x03 \x01(\bR\fforwardToken\x12<\n" +
+	"\x0estatic_headers\x18\x04 \x03(\v2\x15.opengate.v1.KeyValueR\rstaticHeaders\x12%\n" +
+	"\x0einternal_token\x18\x05 \x01(\bR\rinternalToken\"\x9d\x01\n" +
+	"\tRateLimit\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x1a\n" +
+	"\brequests\x18\x02 \x01(\x03R\brequests\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\x03R\x06window\x12\x14\n" +
+	"\x05burst\x18\x04 \x01(\x03R\x05burst\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\x12\x16\n" +
+	"\x06header\x18\x06 \x01(\tR\x06header\"\x9a\b\n" +
 	"\x06Config\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
@@ -1166,19 +3326,50 @@ const file_proto_opengate_v1_config_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\x03R\tupdatedAt\"\xa6\x02\n" +
+	" \x01(\x03R\tupdatedAt\x12-\n" +
+	"\atargets\x18\v \x03(\v2\x13.opengate.v1.TargetR\atargets\x12>\n" +
+	"\rload_balancer\x18\f \x01(\v2\x19.opengate.v1.LoadBalancerR\floadBalancer\x12;\n" +
+	"\fhealth_check\x18\r \x01(\v2\x18.opengate.v1.HealthCheckR\vhealthCheck\x12D\n" +
+	"\x0fcircuit_breaker\x18\x0e \x01(\v2\x1b.opengate.v1.CircuitBreakerR\x0ecircuitBreaker\x12;\n" +
+	"\fretry_policy\x18\x0f \x01(\v2\x18.opengate.v1.RetryPolicyR\vretryPolicy\x12D\n" +
+	"\x11middleware_config\x18\x10 \x01(\v2\x17.google.protobuf.StructR\x10middlewareConfig\x12\x14\n" +
+	"\x05hosts\x18\x11 \x03(\tR\x05hosts\x12-\n" +
+	"\x05match\x18\x12 \x01(\v2\x17.opengate.v1.RouteMatchR\x05match\x12\x1a\n" +
+	"\bpriority\x18\x13 \x01(\x05R\bpriority\x12.\n" +
+	"\arewrite\x18\x14 \x01(\v2\x14.opengate.v1.RewriteR\arewrite\x12/\n" +
+	"\x05split\x18\x15 \x01(\v2\x19.opengate.v1.TrafficSplitR\x05split\x12+\n" +
+	"\x06mirror\x18\x16 \x01(\v2\x13.opengate.v1.MirrorR\x06mirror\x121\n" +
+	"\bidentity\x18\x17 \x01(\v2\x15.opengate.v1.IdentityR\bidentity\x125\n" +
+	"\n" +
+	"rate_limit\x18\x18 \x01(\v2\x16.opengate.v1.RateLimitR\trateLimit\"\xeb\a\n" +
 	"\x13CreateConfigRequest\x12\x1b\n" +
 	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12(\n" +
 	"\vpath_prefix\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
-	"pathPrefix\x12&\n" +
+	"pathPrefix\x12\x1d\n" +
 	"\n" +
-	"target_url\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\ttargetUrl\x12!\n" +
+	"target_url\x18\x03 \x01(\tR\ttargetUrl\x12!\n" +
 	"\fstrip_prefix\x18\x04 \x01(\bR\vstripPrefix\x12C\n" +
 	"\x0eauthentication\x18\x05 \x01(\v2\x1b.opengate.v1.AuthenticationR\x0eauthentication\x12\x1e\n" +
 	"\n" +
 	"middleware\x18\x06 \x03(\tR\n" +
 	"middleware\x12\x18\n" +
-	"\atimeout\x18\a \x01(\x03R\atimeout\"]\n" +
+	"\atimeout\x18\a \x01(\x03R\atimeout\x12-\n" +
+	"\atargets\x18\b \x03(\v2\x13.opengate.v1.TargetR\atargets\x12>\n" +
+	"\rload_balancer\x18\t \x01(\v2\x19.opengate.v1.LoadBalancerR\floadBalancer\x12;\n" +
+	"\fhealth_check\x18\n" +
+	" \x01(\v2\x18.opengate.v1.HealthCheckR\vhealthCheck\x12D\n" +
+	"\x0fcircuit_breaker\x18\v \x01(\v2\x1b.opengate.v1.CircuitBreakerR\x0ecircuitBreaker\x12;\n" +
+	"\fretry_policy\x18\f \x01(\v2\x18.opengate.v1.RetryPolicyR\vretryPolicy\x12D\n" +
+	"\x11middleware_config\x18\r \x01(\v2\x17.google.protobuf.StructR\x10middlewareConfig\x12\x14\n" +
+	"\x05hosts\x18\x0e \x03(\tR\x05hosts\x12-\n" +
+	"\x05match\x18\x0f \x01(\v2\x17.opengate.v1.RouteMatchR\x05match\x12\x1a\n" +
+	"\bpriority\x18\x10 \x01(\x05R\bpriority\x12.\n" +
+	"\arewrite\x18\x11 \x01(\v2\x14.opengate.v1.RewriteR\arewrite\x12/\n" +
+	"\x05split\x18\x12 \x01(\v2\x19.opengate.v1.TrafficSplitR\x05split\x12+\n" +
+	"\x06mirror\x18\x13 \x01(\v2\x13.opengate.v1.MirrorR\x06mirror\x121\n" +
+	"\bidentity\x18\x14 \x01(\v2\x15.opengate.v1.IdentityR\bidentity\x125\n" +
+	"\n" +
+	"rate_limit\x18\x15 \x01(\v2\x16.opengate.v1.RateLimitR\trateLimit\"]\n" +
 	"\x14CreateConfigResponse\x12+\n" +
 	"\x06config\x18\x01 \x01(\v2\x13.opengate.v1.ConfigR\x06config\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"+\n" +
@@ -1195,7 +3386,7 @@ const file_proto_opengate_v1_config_proto_rawDesc = "" +
 	"\aconfigs\x18\x01 \x03(\v2\x13.opengate.v1.ConfigR\aconfigs\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\"\x12\n" +
-	"\x10GetRoutesRequest\"\x9c\x02\n" +
+	"\x10GetRoutesRequest\"\xea\a\n" +
 	"\x05Route\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
 	"\vpath_prefix\x18\x02 \x01(\tR\n" +
@@ -1209,34 +3400,95 @@ const file_proto_opengate_v1_config_proto_rawDesc = "" +
 	"middleware\x12\x18\n" +
 	"\atimeout\x18\a \x01(\x03R\atimeout\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\x03R\tupdatedAt\"Y\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\x12-\n" +
+	"\atargets\x18\t \x03(\v2\x13.opengate.v1.TargetR\atargets\x12>\n" +
+	"\rload_balancer\x18\n" +
+	" \x01(\v2\x19.opengate.v1.LoadBalancerR\floadBalancer\x12;\n" +
+	"\fhealth_check\x18\v \x01(\v2\x18.opengate.v1.HealthCheckR\vhealthCheck\x12D\n" +
+	"\x0fcircuit_breaker\x18\f \x01(\v2\x1b.opengate.v1.CircuitBreakerR\x0ecircuitBreaker\x12;\n" +
+	"\fretry_policy\x18\r \x01(\v2\x18.opengate.v1.RetryPolicyR\vretryPolicy\x12D\n" +
+	"\x11middleware_config\x18\x0e \x01(\v2\x17.google.protobuf.StructR\x10middlewareConfig\x12\x14\n" +
+	"\x05hosts\x18\x0f \x03(\tR\x05hosts\x12-\n" +
+	"\x05match\x18\x10 \x01(\v2\x17.opengate.v1.RouteMatchR\x05match\x12\x1a\n" +
+	"\bpriority\x18\x11 \x01(\x05R\bpriority\x12.\n" +
+	"\arewrite\x18\x12 \x01(\v2\x14.opengate.v1.RewriteR\arewrite\x12/\n" +
+	"\x05split\x18\x13 \x01(\v2\x19.opengate.v1.TrafficSplitR\x05split\x12+\n" +
+	"\x06mirror\x18\x14 \x01(\v2\x13.opengate.v1.MirrorR\x06mirror\x121\n" +
+	"\bidentity\x18\x15 \x01(\v2\x15.opengate.v1.IdentityR\bidentity\x125\n" +
+	"\n" +
+	"rate_limit\x18\x16 \x01(\v2\x16.opengate.v1.RateLimitR\trateLimit\"Y\n" +
 	"\x11GetRoutesResponse\x12*\n" +
 	"\x06routes\x18\x01 \x03(\v2\x12.opengate.v1.RouteR\x06routes\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xbf\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"2\n" +
+	"\bKeyValue\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x95\x01\n" +
+	"\x10TestRouteRequest\x12\x1f\n" +
+	"\x06method\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06method\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x1b\n" +
+	"\x04path\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04path\x12/\n" +
+	"\aheaders\x18\x04 \x03(\v2\x15.opengate.v1.KeyValueR\aheaders\"\xa0\x02\n" +
+	"\x11TestRouteResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\bR\amatched\x12\x14\n" +
+	"\x05route\x18\x02 \x01(\tR\x05route\x126\n" +
+	"\vpath_params\x18\x03 \x03(\v2\x15.opengate.v1.KeyValueR\n" +
+	"pathParams\x12%\n" +
+	"\x0ematched_prefix\x18\x04 \x01(\tR\rmatchedPrefix\x12#\n" +
+	"\rupstream_path\x18\x05 \x01(\tR\fupstreamPath\x12#\n" +
+	"\rupstream_host\x18\x06 \x01(\tR\fupstreamHost\x12\x18\n" +
+	"\atargets\x18\a \x03(\tR\atargets\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"\x84\b\n" +
 	"\x13UpdateConfigRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1b\n" +
 	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12(\n" +
 	"\vpath_prefix\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
-	"pathPrefix\x12&\n" +
+	"pathPrefix\x12\x1d\n" +
 	"\n" +
-	"target_url\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\ttargetUrl\x12!\n" +
+	"target_url\x18\x04 \x01(\tR\ttargetUrl\x12!\n" +
 	"\fstrip_prefix\x18\x05 \x01(\bR\vstripPrefix\x12C\n" +
 	"\x0eauthentication\x18\x06 \x01(\v2\x1b.opengate.v1.AuthenticationR\x0eauthentication\x12\x1e\n" +
 	"\n" +
 	"middleware\x18\a \x03(\tR\n" +
 	"middleware\x12\x18\n" +
-	"\atimeout\x18\b \x01(\x03R\atimeout\"]\n" +
+	"\atimeout\x18\b \x01(\x03R\atimeout\x12-\n" +
+	"\atargets\x18\t \x03(\v2\x13.opengate.v1.TargetR\atargets\x12>\n" +
+	"\rload_balancer\x18\n" +
+	" \x01(\v2\x19.opengate.v1.LoadBalancerR\floadBalancer\x12;\n" +
+	"\fhealth_check\x18\v \x01(\v2\x18.opengate.v1.HealthCheckR\vhealthCheck\x12D\n" +
+	"\x0fcircuit_breaker\x18\f \x01(\v2\x1b.opengate.v1.CircuitBreakerR\x0ecircuitBreaker\x12;\n" +
+	"\fretry_policy\x18\r \x01(\v2\x18.opengate.v1.RetryPolicyR\vretryPolicy\x12D\n" +
+	"\x11middleware_config\x18\x0e \x01(\v2\x17.google.protobuf.StructR\x10middlewareConfig\x12\x14\n" +
+	"\x05hosts\x18\x0f \x03(\tR\x05hosts\x12-\n" +
+	"\x05match\x18\x10 \x01(\v2\x17.opengate.v1.RouteMatchR\x05match\x12\x1a\n" +
+	"\bpriority\x18\x11 \x01(\x05R\bpriority\x12.\n" +
+	"\arewrite\x18\x12 \x01(\v2\x14.opengate.v1.RewriteR\arewrite\x12/\n" +
+	"\x05split\x18\x13 \x01(\v2\x19.opengate.v1.TrafficSplitR\x05split\x12+\n" +
+	"\x06mirror\x18\x14 \x01(\v2\x13.opengate.v1.MirrorR\x06mirror\x121\n" +
+	"\bidentity\x18\x15 \x01(\v2\x15.opengate.v1.IdentityR\bidentity\x125\n" +
+	"\n" +
+	"rate_limit\x18\x16 \x01(\v2\x16.opengate.v1.RateLimitR\trateLimit\"]\n" +
 	"\x14UpdateConfigResponse\x12+\n" +
 	"\x06config\x18\x01 \x01(\v2\x13.opengate.v1.ConfigR\x06config\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"S\n" +
+	"\rVersionWeight\x12!\n" +
+	"\aversion\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aversion\x12\x1f\n" +
+	"\x06weight\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06weight\"g\n" +
+	"\x16SetRouteWeightsRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x124\n" +
+	"\aweights\x18\x02 \x03(\v2\x1a.opengate.v1.VersionWeightR\aweights\"`\n" +
+	"\x17SetRouteWeightsResponse\x12+\n" +
+	"\x06config\x18\x01 \x01(\v2\x13.opengate.v1.ConfigR\x06config\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\".\n" +
 	"\x13DeleteConfigRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"0\n" +
 	"\x14DeleteConfigResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"\x11\n" +
-	"\x0fGetStatsRequest\"O\n" +
+	"\x0fGetStatsRequest\"\xa5\x01\n" +
 	"\x10GetStatsResponse\x12!\n" +
 	"\ftotal_routes\x18\x01 \x01(\x05R\vtotalRoutes\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessageB\x0fZ\r./opengate_v1b\x06proto3"
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x0fhealthy_targets\x18\x03 \x01(\x05R\x0ehealthyTargets\x12+\n" +
+	"\x11unhealthy_targets\x18\x04 \x01(\x05R\x10unhealthyTargetsB\x0fZ\r./opengate_v1b\x06proto3"
 
 var (
 	file_proto_opengate_v1_config_proto_rawDescOnce sync.Once
@@ -1250,43 +3502,128 @@ func file_proto_opengate_v1_config_proto_rawDescGZIP() []byte {
 	return file_proto_opengate_v1_config_proto_rawDescData
 }
 
-var file_proto_opengate_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_proto_opengate_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_proto_opengate_v1_config_proto_goTypes = []any{
-	(*AuthenticationException)(nil), // 0: opengate.v1.AuthenticationException
-	(*Authentication)(nil),          // 1: opengate.v1.Authentication
-	(*Config)(nil),                  // 2: opengate.v1.Config
-	(*CreateConfigRequest)(nil),     // 3: opengate.v1.CreateConfigRequest
-	(*CreateConfigResponse)(nil),    // 4: opengate.v1.CreateConfigResponse
-	(*GetConfigRequest)(nil),        // 5: opengate.v1.GetConfigRequest
-	(*GetConfigResponse)(nil),       // 6: opengate.v1.GetConfigResponse
-	(*ListConfigsRequest)(nil),      // 7: opengate.v1.ListConfigsRequest
-	(*ListConfigsResponse)(nil),     // 8: opengate.v1.ListConfigsResponse
-	(*GetRoutesRequest)(nil),        // 9: opengate.v1.GetRoutesRequest
-	(*Route)(nil),                   // 10: opengate.v1.Route
-	(*GetRoutesResponse)(nil),       // 11: opengate.v1.GetRoutesResponse
-	(*UpdateConfigRequest)(nil),     // 12: opengate.v1.UpdateConfigRequest
-	(*UpdateConfigResponse)(nil),    // 13: opengate.v1.UpdateConfigResponse
-	(*DeleteConfigRequest)(nil),     // 14: opengate.v1.DeleteConfigRequest
-	(*DeleteConfigResponse)(nil),    // 15: opengate.v1.DeleteConfigResponse
-	(*GetStatsRequest)(nil),         // 16: opengate.v1.GetStatsRequest
-	(*GetStatsResponse)(nil),        // 17: opengate.v1.GetStatsResponse
+	(*ClaimRequirement)(nil),        // 0: opengate.v1.ClaimRequirement
+	(*Requirements)(nil),            // 1: opengate.v1.Requirements
+	(*AuthenticationException)(nil), // 2: opengate.v1.AuthenticationException
+	(*Authentication)(nil),          // 3: opengate.v1.Authentication
+	(*Target)(nil),                  // 4: opengate.v1.Target
+	(*RouteVersion)(nil),            // 5: opengate.v1.RouteVersion
+	(*TrafficSplit)(nil),            // 6: opengate.v1.TrafficSplit
+	(*LoadBalancer)(nil),            // 7: opengate.v1.LoadBalancer
+	(*HealthCheck)(nil),             // 8: opengate.v1.HealthCheck
+	(*CircuitBreaker)(nil),          // 9: opengate.v1.CircuitBreaker
+	(*RetryPolicy)(nil),             // 10: opengate.v1.RetryPolicy
+	(*HeaderMatch)(nil),             // 11: opengate.v1.HeaderMatch
+	(*QueryParamMatch)(nil),         // 12: opengate.v1.QueryParamMatch
+	(*RouteMatch)(nil),              // 13: opengate.v1.RouteMatch
+	(*Rewrite)(nil),                 // 14: opengate.v1.Rewrite
+	(*Mirror)(nil),                  // 15: opengate.v1.Mirror
+	(*ClaimHeader)(nil),             // 16: opengate.v1.ClaimHeader
+	(*Identity)(nil),                // 17: opengate.v1.Identity
+	(*RateLimit)(nil),               // 18: opengate.v1.RateLimit
+	(*Config)(nil),                  // 19: opengate.v1.Config
+	(*CreateConfigRequest)(nil),     // 20: opengate.v1.CreateConfigRequest
+	(*CreateConfigResponse)(nil),    // 21: opengate.v1.CreateConfigResponse
+	(*GetConfigRequest)(nil),        // 22: opengate.v1.GetConfigRequest
+	(*GetConfigResponse)(nil),       // 23: opengate.v1.GetConfigResponse
+	(*ListConfigsRequest)(nil),      // 24: opengate.v1.ListConfigsRequest
+	(*ListConfigsResponse)(nil),     // 25: opengate.v1.ListConfigsResponse
+	(*GetRoutesRequest)(nil),        // 26: opengate.v1.GetRoutesRequest
+	(*Route)(nil),                   // 27: opengate.v1.Route
+	(*GetRoutesResponse)(nil),       // 28: opengate.v1.GetRoutesResponse
+	(*KeyValue)(nil),                // 29: opengate.v1.KeyValue
+	(*TestRouteRequest)(nil),        // 30: opengate.v1.TestRouteRequest
+	(*TestRouteResponse)(nil),       // 31: opengate.v1.TestRouteResponse
+	(*UpdateConfigRequest)(nil),     // 32: opengate.v1.UpdateConfigRequest
+	(*UpdateConfigResponse)(nil),    // 33: opengate.v1.UpdateConfigResponse
+	(*VersionWeight)(nil),           // 34: opengate.v1.VersionWeight
+	(*SetRouteWeightsRequest)(nil),  // 35: opengate.v1.SetRouteWeightsRequest
+	(*SetRouteWeightsResponse)(nil), // 36: opengate.v1.SetRouteWeightsResponse
+	(*DeleteConfigRequest)(nil),     // 37: opengate.v1.DeleteConfigRequest
+	(*DeleteConfigResponse)(nil),    // 38: opengate.v1.DeleteConfigResponse
+	(*GetStatsRequest)(nil),         // 39: opengate.v1.GetStatsRequest
+	(*GetStatsResponse)(nil),        // 40: opengate.v1.GetStatsResponse
+	(*structpb.Struct)(nil),         // 41: google.protobuf.Struct
 }
 var file_proto_opengate_v1_config_proto_depIdxs = []int32{
-	0,  // 0: opengate.v1.Authentication.except:type_name -> opengate.v1.AuthenticationException
-	1,  // 1: opengate.v1.Config.authentication:type_name -> opengate.v1.Authentication
-	1,  // 2: opengate.v1.CreateConfigRequest.authentication:type_name -> opengate.v1.Authentication
-	2,  // 3: opengate.v1.CreateConfigResponse.config:type_name -> opengate.v1.Config
-	2,  // 4: opengate.v1.GetConfigResponse.config:type_name -> opengate.v1.Config
-	2,  // 5: opengate.v1.ListConfigsResponse.configs:type_name -> opengate.v1.Config
-	1,  // 6: opengate.v1.Route.authentication:type_name -> opengate.v1.Authentication
-	10, // 7: opengate.v1.GetRoutesResponse.routes:type_name -> opengate.v1.Route
-	1,  // 8: opengate.v1.UpdateConfigRequest.authentication:type_name -> opengate.v1.Authentication
-	2,  // 9: opengate.v1.UpdateConfigResponse.config:type_name -> opengate.v1.Config
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	0,  // 0: opengate.v1.Requirements.claims:type_name -> opengate.v1.ClaimRequirement
+	1,  // 1: opengate.v1.AuthenticationException.require:type_name -> opengate.v1.Requirements
+	2,  // 2: opengate.v1.Authentication.except:type_name -> opengate.v1.AuthenticationException
+	1,  // 3: opengate.v1.Authentication.require:type_name -> opengate.v1.Requirements
+	4,  // 4: opengate.v1.RouteVersion.targets:type_name -> opengate.v1.Target
+	5,  // 5: opengate.v1.TrafficSplit.versions:type_name -> opengate.v1.RouteVersion
+	11, // 6: opengate.v1.RouteMatch.headers:type_name -> opengate.v1.HeaderMatch
+	12, // 7: opengate.v1.RouteMatch.query_params:type_name -> opengate.v1.QueryParamMatch
+	16, // 8: opengate.v1.Identity.claim_headers:type_name -> opengate.v1.ClaimHeader
+	29, // 9: opengate.v1.Identity.static_headers:type_name -> opengate.v1.KeyValue
+	3,  // 10: opengate.v1.Config.authentication:type_name -> opengate.v1.Authentication
+	4,  // 11: opengate.v1.Config.targets:type_name -> opengate.v1.Target
+	7,  // 12: opengate.v1.Config.load_balancer:type_name -> opengate.v1.LoadBalancer
+	8,  // 13: opengate.v1.Config.health_check:type_name -> opengate.v1.HealthCheck
+	9,  // 14: opengate.v1.Config.circuit_breaker:type_name -> opengate.v1.CircuitBreaker
+	10, // 15: opengate.v1.Config.retry_policy:type_name -> opengate.v1.RetryPolicy
+	41, // 16: opengate.v1.Config.middleware_config:type_name -> google.protobuf.Struct
+	13, // 17: opengate.v1.Config.match:type_name -> opengate.v1.RouteMatch
+	14, // 18: opengate.v1.Config.rewrite:type_name -> opengate.v1.Rewrite
+	6,  // 19: opengate.v1.Config.split:type_name -> opengate.v1.TrafficSplit
+	15, // 20: opengate.v1.Config.mirror:type_name -> opengate.v1.Mirror
+	17, // 21: opengate.v1.Config.identity:type_name -> opengate.v1.Identity
+	18, // 22: opengate.v1.Config.rate_limit:type_name -> opengate.v1.RateLimit
+	3,  // 23: opengate.v1.CreateConfigRequest.authentication:type_name -> opengate.v1.Authentication
+	4,  // 24: opengate.v1.CreateConfigRequest.targets:type_name -> opengate.v1.Target
+	7,  // 25: opengate.v1.CreateConfigRequest.load_balancer:type_name -> opengate.v1.LoadBalancer
+	8,  // 26: opengate.v1.CreateConfigRequest.health_check:type_name -> opengate.v1.HealthCheck
+	9,  // 27: opengate.v1.CreateConfigRequest.circuit_breaker:type_name -> opengate.v1.CircuitBreaker
+	10, // 28: opengate.v1.CreateConfigRequest.retry_policy:type_name -> opengate.v1.RetryPolicy
+	41, // 29: opengate.v1.CreateConfigRequest.middleware_config:type_name -> google.protobuf.Struct
+	13, // 30: opengate.v1.CreateConfigRequest.match:type_name -> opengate.v1.RouteMatch
+	14, // 31: opengate.v1.CreateConfigRequest.rewrite:type_name -> opengate.v1.Rewrite
+	6,  // 32: opengate.v1.CreateConfigRequest.split:type_name -> opengate.v1.TrafficSplit
+	15, // 33: opengate.v1.CreateConfigRequest.mirror:type_name -> opengate.v1.Mirror
+	17, // 34: opengate.v1.CreateConfigRequest.identity:type_name -> opengate.v1.Identity
+	18, // 35: opengate.v1.CreateConfigRequest.rate_limit:type_name -> opengate.v1.RateLimit
+	19, // 36: opengate.v1.CreateConfigResponse.config:type_name -> opengate.v1.Config
+	19, // 37: opengate.v1.GetConfigResponse.config:type_name -> opengate.v1.Config
+	19, // 38: opengate.v1.ListConfigsResponse.configs:type_name -> opengate.v1.Config
+	3,  // 39: opengate.v1.Route.authentication:type_name -> opengate.v1.Authentication
+	4,  // 40: opengate.v1.Route.targets:type_name -> opengate.v1.Target
+	7,  // 41: opengate.v1.Route.load_balancer:type_name -> opengate.v1.LoadBalancer
+	8,  // 42: opengate.v1.Route.health_check:type_name -> opengate.v1.HealthCheck
+	9,  // 43: opengate.v1.Route.circuit_breaker:type_name -> opengate.v1.CircuitBreaker
+	10, // 44: opengate.v1.Route.retry_policy:type_name -> opengate.v1.RetryPolicy
+	41, // 45: opengate.v1.Route.middleware_config:type_name -> google.protobuf.Struct
+	13, // 46: opengate.v1.Route.match:type_name -> opengate.v1.RouteMatch
+	14, // 47: opengate.v1.Route.rewrite:type_name -> opengate.v1.Rewrite
+	6,  // 48: opengate.v1.Route.split:type_name -> opengate.v1.TrafficSplit
+	15, // 49: opengate.v1.Route.mirror:type_name -> opengate.v1.Mirror
+	17, // 50: opengate.v1.Route.identity:type_name -> opengate.v1.Identity
+	18, // 51: opengate.v1.Route.rate_limit:type_name -> opengate.v1.RateLimit
+	27, // 52: opengate.v1.GetRoutesResponse.routes:type_name -> opengate.v1.Route
+	29, // 53: opengate.v1.TestRouteRequest.headers:type_name -> opengate.v1.KeyValue
+	29, // 54: opengate.v1.TestRouteResponse.path_params:type_name -> opengate.v1.KeyValue
+	3,  // 55: opengate.v1.UpdateConfigRequest.authentication:type_name -> opengate.v1.Authentication
+	4,  // 56: opengate.v1.UpdateConfigRequest.targets:type_name -> opengate.v1.Target
+	7,  // 57: opengate.v1.UpdateConfigRequest.load_balancer:type_name -> opengate.v1.LoadBalancer
+	8,  // 58: opengate.v1.UpdateConfigRequest.health_check:type_name -> opengate.v1.HealthCheck
+	9,  // 59: opengate.v1.UpdateConfigRequest.circuit_breaker:type_name -> opengate.v1.CircuitBreaker
+	10, // 60: opengate.v1.UpdateConfigRequest.retry_policy:type_name -> opengate.v1.RetryPolicy
+	41, // 61: opengate.v1.UpdateConfigRequest.middleware_config:type_name -> google.protobuf.Struct
+	13, // 62: opengate.v1.UpdateConfigRequest.match:type_name -> opengate.v1.RouteMatch
+	14, // 63: opengate.v1.UpdateConfigRequest.rewrite:type_name -> opengate.v1.Rewrite
+	6,  // 64: opengate.v1.UpdateConfigRequest.split:type_name -> opengate.v1.TrafficSplit
+	15, // 65: opengate.v1.UpdateConfigRequest.mirror:type_name -> opengate.v1.Mirror
+	17, // 66: opengate.v1.UpdateConfigRequest.identity:type_name -> opengate.v1.Identity
+	18, // 67: opengate.v1.UpdateConfigRequest.rate_limit:type_name -> opengate.v1.RateLimit
+	19, // 68: opengate.v1.UpdateConfigResponse.config:type_name -> opengate.v1.Config
+	34, // 69: opengate.v1.SetRouteWeightsRequest.weights:type_name -> opengate.v1.VersionWeight
+	19, // 70: opengate.v1.SetRouteWeightsResponse.config:type_name -> opengate.v1.Config
+	71, // [71:71] is the sub-list for method output_type
+	71, // [71:71] is the sub-list for method input_type
+	71, // [71:71] is the sub-list for extension type_name
+	71, // [71:71] is the sub-list for extension extendee
+	0,  // [0:71] is the sub-list for field type_name
 }
 
 func init() { file_proto_opengate_v1_config_proto_init() }
@@ -1300,7 +3637,7 @@ func file_proto_opengate_v1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_opengate_v1_config_proto_rawDesc), len(file_proto_opengate_v1_config_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
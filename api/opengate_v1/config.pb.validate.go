@@ -35,44 +35,46 @@ var (
 	_ = sort.Sort
 )
 
-// Validate checks the field values on AuthenticationException with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuthenticationException) Validate() error {
+// Validate checks the field values on ClaimRequirement with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClaimRequirement) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthenticationException with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ClaimRequirement with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuthenticationExceptionMultiError, or nil if none found.
-func (m *AuthenticationException) ValidateAll() error {
+// ClaimRequirementMultiError, or nil if none found.
+func (m *ClaimRequirement) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthenticationException) validate(all bool) error {
+func (m *ClaimRequirement) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Path
+	// no validation rules for Name
+
+	// no validation rules for Operator
 
 	if len(errors) > 0 {
-		return AuthenticationExceptionMultiError(errors)
+		return ClaimRequirementMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthenticationExceptionMultiError is an error wrapping multiple validation
-// errors returned by AuthenticationException.ValidateAll() if the designated
-// constraints aren't met.
-type AuthenticationExceptionMultiError []error
+// ClaimRequirementMultiError is an error wrapping multiple validation errors
+// returned by ClaimRequirement.ValidateAll() if the designated constraints
+// aren't met.
+type ClaimRequirementMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthenticationExceptionMultiError) Error() string {
+func (m ClaimRequirementMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -81,11 +83,11 @@ func (m AuthenticationExceptionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthenticationExceptionMultiError) AllErrors() []error { return m }
+func (m ClaimRequirementMultiError) AllErrors() []error { return m }
 
-// AuthenticationExceptionValidationError is the validation error returned by
-// AuthenticationException.Validate if the designated constraints aren't met.
-type AuthenticationExceptionValidationError struct {
+// ClaimRequirementValidationError is the validation error returned by
+// ClaimRequirement.Validate if the designated constraints aren't met.
+type ClaimRequirementValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -93,24 +95,22 @@ type AuthenticationExceptionValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthenticationExceptionValidationError) Field() string { return e.field }
+func (e ClaimRequirementValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthenticationExceptionValidationError) Reason() string { return e.reason }
+func (e ClaimRequirementValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthenticationExceptionValidationError) Cause() error { return e.cause }
+func (e ClaimRequirementValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthenticationExceptionValidationError) Key() bool { return e.key }
+func (e ClaimRequirementValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthenticationExceptionValidationError) ErrorName() string {
-	return "AuthenticationExceptionValidationError"
-}
+func (e ClaimRequirementValidationError) ErrorName() string { return "ClaimRequirementValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AuthenticationExceptionValidationError) Error() string {
+func (e ClaimRequirementValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -122,14 +122,14 @@ func (e AuthenticationExceptionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthenticationException.%s: %s%s",
+		"invalid %sClaimRequirement.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthenticationExceptionValidationError{}
+var _ error = ClaimRequirementValidationError{}
 
 var _ interface {
 	Field() string
@@ -137,49 +137,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthenticationExceptionValidationError{}
+} = ClaimRequirementValidationError{}
 
-// Validate checks the field values on Authentication with the rules defined in
+// Validate checks the field values on Requirements with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Authentication) Validate() error {
+func (m *Requirements) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Authentication with the rules defined
+// ValidateAll checks the field values on Requirements with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AuthenticationMultiError,
-// or nil if none found.
-func (m *Authentication) ValidateAll() error {
+// result is a list of violation errors wrapped in RequirementsMultiError, or
+// nil if none found.
+func (m *Requirements) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Authentication) validate(all bool) error {
+func (m *Requirements) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Required
-
-	for idx, item := range m.GetExcept() {
+	for idx, item := range m.GetClaims() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AuthenticationValidationError{
-						field:  fmt.Sprintf("Except[%v]", idx),
+					errors = append(errors, RequirementsValidationError{
+						field:  fmt.Sprintf("Claims[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AuthenticationValidationError{
-						field:  fmt.Sprintf("Except[%v]", idx),
+					errors = append(errors, RequirementsValidationError{
+						field:  fmt.Sprintf("Claims[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -187,8 +185,8 @@ func (m *Authentication) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AuthenticationValidationError{
-					field:  fmt.Sprintf("Except[%v]", idx),
+				return RequirementsValidationError{
+					field:  fmt.Sprintf("Claims[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -198,19 +196,18 @@ func (m *Authentication) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AuthenticationMultiError(errors)
+		return RequirementsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthenticationMultiError is an error wrapping multiple validation errors
-// returned by Authentication.ValidateAll() if the designated constraints
-// aren't met.
-type AuthenticationMultiError []error
+// RequirementsMultiError is an error wrapping multiple validation errors
+// returned by Requirements.ValidateAll() if the designated constraints aren't met.
+type RequirementsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthenticationMultiError) Error() string {
+func (m RequirementsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -219,11 +216,11 @@ func (m AuthenticationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthenticationMultiError) AllErrors() []error { return m }
+func (m RequirementsMultiError) AllErrors() []error { return m }
 
-// AuthenticationValidationError is the validation error returned by
-// Authentication.Validate if the designated constraints aren't met.
-type AuthenticationValidationError struct {
+// RequirementsValidationError is the validation error returned by
+// Requirements.Validate if the designated constraints aren't met.
+type RequirementsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -231,22 +228,22 @@ type AuthenticationValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthenticationValidationError) Field() string { return e.field }
+func (e RequirementsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthenticationValidationError) Reason() string { return e.reason }
+func (e RequirementsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthenticationValidationError) Cause() error { return e.cause }
+func (e RequirementsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthenticationValidationError) Key() bool { return e.key }
+func (e RequirementsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthenticationValidationError) ErrorName() string { return "AuthenticationValidationError" }
+func (e RequirementsValidationError) ErrorName() string { return "RequirementsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AuthenticationValidationError) Error() string {
+func (e RequirementsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -258,14 +255,14 @@ func (e AuthenticationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthentication.%s: %s%s",
+		"invalid %sRequirements.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthenticationValidationError{}
+var _ error = RequirementsValidationError{}
 
 var _ interface {
 	Field() string
@@ -273,87 +270,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthenticationValidationError{}
+} = RequirementsValidationError{}
 
-// Validate checks the field values on Config with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Config) Validate() error {
+// Validate checks the field values on AuthenticationException with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthenticationException) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Config with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ConfigMultiError, or nil if none found.
-func (m *Config) ValidateAll() error {
+// ValidateAll checks the field values on AuthenticationException with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthenticationExceptionMultiError, or nil if none found.
+func (m *AuthenticationException) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Config) validate(all bool) error {
+func (m *AuthenticationException) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for PathPrefix
-
-	// no validation rules for TargetUrl
-
-	// no validation rules for StripPrefix
+	// no validation rules for Path
 
 	if all {
-		switch v := interface{}(m.GetAuthentication()).(type) {
+		switch v := interface{}(m.GetRequire()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ConfigValidationError{
-					field:  "Authentication",
+				errors = append(errors, AuthenticationExceptionValidationError{
+					field:  "Require",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ConfigValidationError{
-					field:  "Authentication",
+				errors = append(errors, AuthenticationExceptionValidationError{
+					field:  "Require",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRequire()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ConfigValidationError{
-				field:  "Authentication",
+			return AuthenticationExceptionValidationError{
+				field:  "Require",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Timeout
-
-	// no validation rules for CreatedAt
-
-	// no validation rules for UpdatedAt
-
 	if len(errors) > 0 {
-		return ConfigMultiError(errors)
+		return AuthenticationExceptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// ConfigMultiError is an error wrapping multiple validation errors returned by
-// Config.ValidateAll() if the designated constraints aren't met.
-type ConfigMultiError []error
+// AuthenticationExceptionMultiError is an error wrapping multiple validation
+// errors returned by AuthenticationException.ValidateAll() if the designated
+// constraints aren't met.
+type AuthenticationExceptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConfigMultiError) Error() string {
+func (m AuthenticationExceptionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -362,11 +347,11 @@ func (m ConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConfigMultiError) AllErrors() []error { return m }
+func (m AuthenticationExceptionMultiError) AllErrors() []error { return m }
 
-// ConfigValidationError is the validation error returned by Config.Validate if
-// the designated constraints aren't met.
-type ConfigValidationError struct {
+// AuthenticationExceptionValidationError is the validation error returned by
+// AuthenticationException.Validate if the designated constraints aren't met.
+type AuthenticationExceptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -374,22 +359,24 @@ type ConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConfigValidationError) Field() string { return e.field }
+func (e AuthenticationExceptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConfigValidationError) Reason() string { return e.reason }
+func (e AuthenticationExceptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConfigValidationError) Cause() error { return e.cause }
+func (e AuthenticationExceptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConfigValidationError) Key() bool { return e.key }
+func (e AuthenticationExceptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConfigValidationError) ErrorName() string { return "ConfigValidationError" }
+func (e AuthenticationExceptionValidationError) ErrorName() string {
+	return "AuthenticationExceptionValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ConfigValidationError) Error() string {
+func (e AuthenticationExceptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -401,14 +388,14 @@ func (e ConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConfig.%s: %s%s",
+		"invalid %sAuthenticationException.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConfigValidationError{}
+var _ error = AuthenticationExceptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -416,110 +403,111 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConfigValidationError{}
+} = AuthenticationExceptionValidationError{}
 
-// Validate checks the field values on CreateConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateConfigRequest) Validate() error {
+// Validate checks the field values on Authentication with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Authentication) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateConfigRequestMultiError, or nil if none found.
-func (m *CreateConfigRequest) ValidateAll() error {
+// ValidateAll checks the field values on Authentication with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthenticationMultiError,
+// or nil if none found.
+func (m *Authentication) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateConfigRequest) validate(all bool) error {
+func (m *Authentication) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := CreateConfigRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Required
 
-	if utf8.RuneCountInString(m.GetPathPrefix()) < 1 {
-		err := CreateConfigRequestValidationError{
-			field:  "PathPrefix",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetExcept() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetTargetUrl()) < 1 {
-		err := CreateConfigRequestValidationError{
-			field:  "TargetUrl",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuthenticationValidationError{
+						field:  fmt.Sprintf("Except[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuthenticationValidationError{
+						field:  fmt.Sprintf("Except[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuthenticationValidationError{
+					field:  fmt.Sprintf("Except[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
-	// no validation rules for StripPrefix
+	// no validation rules for Mode
 
 	if all {
-		switch v := interface{}(m.GetAuthentication()).(type) {
+		switch v := interface{}(m.GetRequire()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateConfigRequestValidationError{
-					field:  "Authentication",
+				errors = append(errors, AuthenticationValidationError{
+					field:  "Require",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateConfigRequestValidationError{
-					field:  "Authentication",
+				errors = append(errors, AuthenticationValidationError{
+					field:  "Require",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRequire()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateConfigRequestValidationError{
-				field:  "Authentication",
+			return AuthenticationValidationError{
+				field:  "Require",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Timeout
-
 	if len(errors) > 0 {
-		return CreateConfigRequestMultiError(errors)
+		return AuthenticationMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateConfigRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateConfigRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateConfigRequestMultiError []error
+// AuthenticationMultiError is an error wrapping multiple validation errors
+// returned by Authentication.ValidateAll() if the designated constraints
+// aren't met.
+type AuthenticationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateConfigRequestMultiError) Error() string {
+func (m AuthenticationMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -528,11 +516,11 @@ func (m CreateConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateConfigRequestMultiError) AllErrors() []error { return m }
+func (m AuthenticationMultiError) AllErrors() []error { return m }
 
-// CreateConfigRequestValidationError is the validation error returned by
-// CreateConfigRequest.Validate if the designated constraints aren't met.
-type CreateConfigRequestValidationError struct {
+// AuthenticationValidationError is the validation error returned by
+// Authentication.Validate if the designated constraints aren't met.
+type AuthenticationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -540,24 +528,22 @@ type CreateConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateConfigRequestValidationError) Field() string { return e.field }
+func (e AuthenticationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateConfigRequestValidationError) Reason() string { return e.reason }
+func (e AuthenticationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateConfigRequestValidationError) Cause() error { return e.cause }
+func (e AuthenticationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateConfigRequestValidationError) Key() bool { return e.key }
+func (e AuthenticationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateConfigRequestValidationError) ErrorName() string {
-	return "CreateConfigRequestValidationError"
-}
+func (e AuthenticationValidationError) ErrorName() string { return "AuthenticationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateConfigRequestValidationError) Error() string {
+func (e AuthenticationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -569,14 +555,14 @@ func (e CreateConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateConfigRequest.%s: %s%s",
+		"invalid %sAuthentication.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateConfigRequestValidationError{}
+var _ error = AuthenticationValidationError{}
 
 var _ interface {
 	Field() string
@@ -584,75 +570,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateConfigRequestValidationError{}
+} = AuthenticationValidationError{}
 
-// Validate checks the field values on CreateConfigResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateConfigResponse) Validate() error {
+// Validate checks the field values on Target with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Target) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateConfigResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateConfigResponseMultiError, or nil if none found.
-func (m *CreateConfigResponse) ValidateAll() error {
+// ValidateAll checks the field values on Target with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TargetMultiError, or nil if none found.
+func (m *Target) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateConfigResponse) validate(all bool) error {
+func (m *Target) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateConfigResponseValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateConfigResponseValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateConfigResponseValidationError{
-				field:  "Config",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Url
 
-	// no validation rules for Message
+	// no validation rules for Weight
 
 	if len(errors) > 0 {
-		return CreateConfigResponseMultiError(errors)
+		return TargetMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateConfigResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateConfigResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateConfigResponseMultiError []error
+// TargetMultiError is an error wrapping multiple validation errors returned by
+// Target.ValidateAll() if the designated constraints aren't met.
+type TargetMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateConfigResponseMultiError) Error() string {
+func (m TargetMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -661,11 +618,11 @@ func (m CreateConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateConfigResponseMultiError) AllErrors() []error { return m }
+func (m TargetMultiError) AllErrors() []error { return m }
 
-// CreateConfigResponseValidationError is the validation error returned by
-// CreateConfigResponse.Validate if the designated constraints aren't met.
-type CreateConfigResponseValidationError struct {
+// TargetValidationError is the validation error returned by Target.Validate if
+// the designated constraints aren't met.
+type TargetValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -673,24 +630,22 @@ type CreateConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateConfigResponseValidationError) Field() string { return e.field }
+func (e TargetValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateConfigResponseValidationError) Reason() string { return e.reason }
+func (e TargetValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateConfigResponseValidationError) Cause() error { return e.cause }
+func (e TargetValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateConfigResponseValidationError) Key() bool { return e.key }
+func (e TargetValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateConfigResponseValidationError) ErrorName() string {
-	return "CreateConfigResponseValidationError"
-}
+func (e TargetValidationError) ErrorName() string { return "TargetValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateConfigResponseValidationError) Error() string {
+func (e TargetValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -702,14 +657,14 @@ func (e CreateConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateConfigResponse.%s: %s%s",
+		"invalid %sTarget.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateConfigResponseValidationError{}
+var _ error = TargetValidationError{}
 
 var _ interface {
 	Field() string
@@ -717,55 +672,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateConfigResponseValidationError{}
+} = TargetValidationError{}
 
-// Validate checks the field values on GetConfigRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetConfigRequest) Validate() error {
+// Validate checks the field values on RouteVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RouteVersion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConfigRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetConfigRequestMultiError, or nil if none found.
-func (m *GetConfigRequest) ValidateAll() error {
+// ValidateAll checks the field values on RouteVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RouteVersionMultiError, or
+// nil if none found.
+func (m *RouteVersion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConfigRequest) validate(all bool) error {
+func (m *RouteVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetId() <= 0 {
-		err := GetConfigRequestValidationError{
-			field:  "Id",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+	// no validation rules for Name
+
+	// no validation rules for Weight
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RouteVersionValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RouteVersionValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RouteVersionValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return GetConfigRequestMultiError(errors)
+		return RouteVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConfigRequestMultiError is an error wrapping multiple validation errors
-// returned by GetConfigRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetConfigRequestMultiError []error
+// RouteVersionMultiError is an error wrapping multiple validation errors
+// returned by RouteVersion.ValidateAll() if the designated constraints aren't met.
+type RouteVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConfigRequestMultiError) Error() string {
+func (m RouteVersionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -774,11 +755,11 @@ func (m GetConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConfigRequestMultiError) AllErrors() []error { return m }
+func (m RouteVersionMultiError) AllErrors() []error { return m }
 
-// GetConfigRequestValidationError is the validation error returned by
-// GetConfigRequest.Validate if the designated constraints aren't met.
-type GetConfigRequestValidationError struct {
+// RouteVersionValidationError is the validation error returned by
+// RouteVersion.Validate if the designated constraints aren't met.
+type RouteVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -786,22 +767,22 @@ type GetConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConfigRequestValidationError) Field() string { return e.field }
+func (e RouteVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConfigRequestValidationError) Reason() string { return e.reason }
+func (e RouteVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConfigRequestValidationError) Cause() error { return e.cause }
+func (e RouteVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConfigRequestValidationError) Key() bool { return e.key }
+func (e RouteVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConfigRequestValidationError) ErrorName() string { return "GetConfigRequestValidationError" }
+func (e RouteVersionValidationError) ErrorName() string { return "RouteVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetConfigRequestValidationError) Error() string {
+func (e RouteVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -813,14 +794,14 @@ func (e GetConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConfigRequest.%s: %s%s",
+		"invalid %sRouteVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConfigRequestValidationError{}
+var _ error = RouteVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -828,75 +809,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConfigRequestValidationError{}
+} = RouteVersionValidationError{}
 
-// Validate checks the field values on GetConfigResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetConfigResponse) Validate() error {
+// Validate checks the field values on TrafficSplit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrafficSplit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetConfigResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetConfigResponseMultiError, or nil if none found.
-func (m *GetConfigResponse) ValidateAll() error {
+// ValidateAll checks the field values on TrafficSplit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrafficSplitMultiError, or
+// nil if none found.
+func (m *TrafficSplit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetConfigResponse) validate(all bool) error {
+func (m *TrafficSplit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetConfig()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetConfigResponseValidationError{
-					field:  "Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TrafficSplitValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TrafficSplitValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetConfigResponseValidationError{
-					field:  "Config",
+				return TrafficSplitValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetConfigResponseValidationError{
-				field:  "Config",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	// no validation rules for Message
+	// no validation rules for StickyOn
+
+	// no validation rules for StickyKey
 
 	if len(errors) > 0 {
-		return GetConfigResponseMultiError(errors)
+		return TrafficSplitMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetConfigResponseMultiError is an error wrapping multiple validation errors
-// returned by GetConfigResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetConfigResponseMultiError []error
+// TrafficSplitMultiError is an error wrapping multiple validation errors
+// returned by TrafficSplit.ValidateAll() if the designated constraints aren't met.
+type TrafficSplitMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetConfigResponseMultiError) Error() string {
+func (m TrafficSplitMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -905,11 +892,11 @@ func (m GetConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetConfigResponseMultiError) AllErrors() []error { return m }
+func (m TrafficSplitMultiError) AllErrors() []error { return m }
 
-// GetConfigResponseValidationError is the validation error returned by
-// GetConfigResponse.Validate if the designated constraints aren't met.
-type GetConfigResponseValidationError struct {
+// TrafficSplitValidationError is the validation error returned by
+// TrafficSplit.Validate if the designated constraints aren't met.
+type TrafficSplitValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -917,24 +904,22 @@ type GetConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetConfigResponseValidationError) Field() string { return e.field }
+func (e TrafficSplitValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetConfigResponseValidationError) Reason() string { return e.reason }
+func (e TrafficSplitValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetConfigResponseValidationError) Cause() error { return e.cause }
+func (e TrafficSplitValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetConfigResponseValidationError) Key() bool { return e.key }
+func (e TrafficSplitValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetConfigResponseValidationError) ErrorName() string {
-	return "GetConfigResponseValidationError"
-}
+func (e TrafficSplitValidationError) ErrorName() string { return "TrafficSplitValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetConfigResponseValidationError) Error() string {
+func (e TrafficSplitValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -946,14 +931,14 @@ func (e GetConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetConfigResponse.%s: %s%s",
+		"invalid %sTrafficSplit.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetConfigResponseValidationError{}
+var _ error = TrafficSplitValidationError{}
 
 var _ interface {
 	Field() string
@@ -961,68 +946,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetConfigResponseValidationError{}
+} = TrafficSplitValidationError{}
 
-// Validate checks the field values on ListConfigsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConfigsRequest) Validate() error {
+// Validate checks the field values on LoadBalancer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoadBalancer) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConfigsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListConfigsRequestMultiError, or nil if none found.
-func (m *ListConfigsRequest) ValidateAll() error {
+// ValidateAll checks the field values on LoadBalancer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoadBalancerMultiError, or
+// nil if none found.
+func (m *LoadBalancer) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListConfigsRequest) validate(all bool) error {
+func (m *LoadBalancer) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if val := m.GetLimit(); val <= 0 || val > 100 {
-		err := ListConfigsRequestValidationError{
-			field:  "Limit",
-			reason: "value must be inside range (0, 100]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Policy
 
-	if m.GetOffset() < 0 {
-		err := ListConfigsRequestValidationError{
-			field:  "Offset",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for HashOn
 
-	// no validation rules for Search
+	// no validation rules for HashKey
 
 	if len(errors) > 0 {
-		return ListConfigsRequestMultiError(errors)
+		return LoadBalancerMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConfigsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListConfigsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListConfigsRequestMultiError []error
+// LoadBalancerMultiError is an error wrapping multiple validation errors
+// returned by LoadBalancer.ValidateAll() if the designated constraints aren't met.
+type LoadBalancerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConfigsRequestMultiError) Error() string {
+func (m LoadBalancerMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1031,11 +997,11 @@ func (m ListConfigsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConfigsRequestMultiError) AllErrors() []error { return m }
+func (m LoadBalancerMultiError) AllErrors() []error { return m }
 
-// ListConfigsRequestValidationError is the validation error returned by
-// ListConfigsRequest.Validate if the designated constraints aren't met.
-type ListConfigsRequestValidationError struct {
+// LoadBalancerValidationError is the validation error returned by
+// LoadBalancer.Validate if the designated constraints aren't met.
+type LoadBalancerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1043,24 +1009,22 @@ type ListConfigsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConfigsRequestValidationError) Field() string { return e.field }
+func (e LoadBalancerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConfigsRequestValidationError) Reason() string { return e.reason }
+func (e LoadBalancerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConfigsRequestValidationError) Cause() error { return e.cause }
+func (e LoadBalancerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConfigsRequestValidationError) Key() bool { return e.key }
+func (e LoadBalancerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConfigsRequestValidationError) ErrorName() string {
-	return "ListConfigsRequestValidationError"
-}
+func (e LoadBalancerValidationError) ErrorName() string { return "LoadBalancerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListConfigsRequestValidationError) Error() string {
+func (e LoadBalancerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1072,14 +1036,14 @@ func (e ListConfigsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConfigsRequest.%s: %s%s",
+		"invalid %sLoadBalancer.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConfigsRequestValidationError{}
+var _ error = LoadBalancerValidationError{}
 
 var _ interface {
 	Field() string
@@ -1087,82 +1051,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConfigsRequestValidationError{}
+} = LoadBalancerValidationError{}
 
-// Validate checks the field values on ListConfigsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConfigsResponse) Validate() error {
+// Validate checks the field values on HealthCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HealthCheck) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConfigsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListConfigsResponseMultiError, or nil if none found.
-func (m *ListConfigsResponse) ValidateAll() error {
+// ValidateAll checks the field values on HealthCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HealthCheckMultiError, or
+// nil if none found.
+func (m *HealthCheck) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListConfigsResponse) validate(all bool) error {
+func (m *HealthCheck) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConfigs() {
-		_, _ = idx, item
+	// no validation rules for Path
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListConfigsResponseValidationError{
-						field:  fmt.Sprintf("Configs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListConfigsResponseValidationError{
-						field:  fmt.Sprintf("Configs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListConfigsResponseValidationError{
-					field:  fmt.Sprintf("Configs[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Interval
 
-	}
+	// no validation rules for Timeout
 
-	// no validation rules for Total
+	// no validation rules for HealthyThreshold
 
-	// no validation rules for Message
+	// no validation rules for UnhealthyThreshold
+
+	// no validation rules for ExpectedStatus
 
 	if len(errors) > 0 {
-		return ListConfigsResponseMultiError(errors)
+		return HealthCheckMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConfigsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListConfigsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListConfigsResponseMultiError []error
+// HealthCheckMultiError is an error wrapping multiple validation errors
+// returned by HealthCheck.ValidateAll() if the designated constraints aren't met.
+type HealthCheckMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConfigsResponseMultiError) Error() string {
+func (m HealthCheckMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1171,11 +1108,11 @@ func (m ListConfigsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConfigsResponseMultiError) AllErrors() []error { return m }
+func (m HealthCheckMultiError) AllErrors() []error { return m }
 
-// ListConfigsResponseValidationError is the validation error returned by
-// ListConfigsResponse.Validate if the designated constraints aren't met.
-type ListConfigsResponseValidationError struct {
+// HealthCheckValidationError is the validation error returned by
+// HealthCheck.Validate if the designated constraints aren't met.
+type HealthCheckValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1183,24 +1120,22 @@ type ListConfigsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConfigsResponseValidationError) Field() string { return e.field }
+func (e HealthCheckValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConfigsResponseValidationError) Reason() string { return e.reason }
+func (e HealthCheckValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConfigsResponseValidationError) Cause() error { return e.cause }
+func (e HealthCheckValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConfigsResponseValidationError) Key() bool { return e.key }
+func (e HealthCheckValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConfigsResponseValidationError) ErrorName() string {
-	return "ListConfigsResponseValidationError"
-}
+func (e HealthCheckValidationError) ErrorName() string { return "HealthCheckValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListConfigsResponseValidationError) Error() string {
+func (e HealthCheckValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1212,14 +1147,14 @@ func (e ListConfigsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConfigsResponse.%s: %s%s",
+		"invalid %sHealthCheck.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConfigsResponseValidationError{}
+var _ error = HealthCheckValidationError{}
 
 var _ interface {
 	Field() string
@@ -1227,44 +1162,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConfigsResponseValidationError{}
+} = HealthCheckValidationError{}
 
-// Validate checks the field values on GetRoutesRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetRoutesRequest) Validate() error {
+// Validate checks the field values on CircuitBreaker with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CircuitBreaker) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoutesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetRoutesRequestMultiError, or nil if none found.
-func (m *GetRoutesRequest) ValidateAll() error {
+// ValidateAll checks the field values on CircuitBreaker with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CircuitBreakerMultiError,
+// or nil if none found.
+func (m *CircuitBreaker) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoutesRequest) validate(all bool) error {
+func (m *CircuitBreaker) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ConsecutiveFailures
+
+	// no validation rules for ErrorRateThreshold
+
+	// no validation rules for MinRequests
+
+	// no validation rules for Window
+
+	// no validation rules for EjectionDuration
+
+	// no validation rules for HalfOpenRequests
+
 	if len(errors) > 0 {
-		return GetRoutesRequestMultiError(errors)
+		return CircuitBreakerMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoutesRequestMultiError is an error wrapping multiple validation errors
-// returned by GetRoutesRequest.ValidateAll() if the designated constraints
+// CircuitBreakerMultiError is an error wrapping multiple validation errors
+// returned by CircuitBreaker.ValidateAll() if the designated constraints
 // aren't met.
-type GetRoutesRequestMultiError []error
+type CircuitBreakerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoutesRequestMultiError) Error() string {
+func (m CircuitBreakerMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1273,11 +1220,11 @@ func (m GetRoutesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoutesRequestMultiError) AllErrors() []error { return m }
+func (m CircuitBreakerMultiError) AllErrors() []error { return m }
 
-// GetRoutesRequestValidationError is the validation error returned by
-// GetRoutesRequest.Validate if the designated constraints aren't met.
-type GetRoutesRequestValidationError struct {
+// CircuitBreakerValidationError is the validation error returned by
+// CircuitBreaker.Validate if the designated constraints aren't met.
+type CircuitBreakerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1285,22 +1232,22 @@ type GetRoutesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoutesRequestValidationError) Field() string { return e.field }
+func (e CircuitBreakerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoutesRequestValidationError) Reason() string { return e.reason }
+func (e CircuitBreakerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoutesRequestValidationError) Cause() error { return e.cause }
+func (e CircuitBreakerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoutesRequestValidationError) Key() bool { return e.key }
+func (e CircuitBreakerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoutesRequestValidationError) ErrorName() string { return "GetRoutesRequestValidationError" }
+func (e CircuitBreakerValidationError) ErrorName() string { return "CircuitBreakerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetRoutesRequestValidationError) Error() string {
+func (e CircuitBreakerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1312,14 +1259,14 @@ func (e GetRoutesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoutesRequest.%s: %s%s",
+		"invalid %sCircuitBreaker.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoutesRequestValidationError{}
+var _ error = CircuitBreakerValidationError{}
 
 var _ interface {
 	Field() string
@@ -1327,23 +1274,135 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoutesRequestValidationError{}
+} = CircuitBreakerValidationError{}
 
-// Validate checks the field values on Route with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Route) Validate() error {
+func (m *RetryPolicy) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Route with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in RouteMultiError, or nil if none found.
-func (m *Route) ValidateAll() error {
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
+// nil if none found.
+func (m *RetryPolicy) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Route) validate(all bool) error {
+func (m *RetryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Attempts
+
+	// no validation rules for PerTryTimeout
+
+	// no validation rules for BackoffBase
+
+	// no validation rules for BackoffMax
+
+	// no validation rules for Budget
+
+	// no validation rules for MaxBodyBytes
+
+	if len(errors) > 0 {
+		return RetryPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
+
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetryPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryPolicyValidationError{}
+
+// Validate checks the field values on HeaderMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HeaderMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HeaderMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HeaderMatchMultiError, or
+// nil if none found.
+func (m *HeaderMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HeaderMatch) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1352,58 +1411,4351 @@ func (m *Route) validate(all bool) error {
 
 	// no validation rules for Name
 
-	// no validation rules for PathPrefix
+	// no validation rules for Exact
 
-	// no validation rules for TargetUrl
+	// no validation rules for Regex
 
-	// no validation rules for StripPrefix
+	// no validation rules for Present
 
-	if all {
-		switch v := interface{}(m.GetAuthentication()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RouteValidationError{
-					field:  "Authentication",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RouteValidationError{
-					field:  "Authentication",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
+	if len(errors) > 0 {
+		return HeaderMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// HeaderMatchMultiError is an error wrapping multiple validation errors
+// returned by HeaderMatch.ValidateAll() if the designated constraints aren't met.
+type HeaderMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HeaderMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HeaderMatchMultiError) AllErrors() []error { return m }
+
+// HeaderMatchValidationError is the validation error returned by
+// HeaderMatch.Validate if the designated constraints aren't met.
+type HeaderMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HeaderMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HeaderMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HeaderMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HeaderMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HeaderMatchValidationError) ErrorName() string { return "HeaderMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HeaderMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHeaderMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HeaderMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HeaderMatchValidationError{}
+
+// Validate checks the field values on QueryParamMatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryParamMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryParamMatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryParamMatchMultiError, or nil if none found.
+func (m *QueryParamMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryParamMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Exact
+
+	// no validation rules for Present
+
+	if len(errors) > 0 {
+		return QueryParamMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryParamMatchMultiError is an error wrapping multiple validation errors
+// returned by QueryParamMatch.ValidateAll() if the designated constraints
+// aren't met.
+type QueryParamMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryParamMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryParamMatchMultiError) AllErrors() []error { return m }
+
+// QueryParamMatchValidationError is the validation error returned by
+// QueryParamMatch.Validate if the designated constraints aren't met.
+type QueryParamMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryParamMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryParamMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryParamMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryParamMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryParamMatchValidationError) ErrorName() string { return "QueryParamMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryParamMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryParamMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryParamMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryParamMatchValidationError{}
+
+// Validate checks the field values on RouteMatch with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RouteMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RouteMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RouteMatchMultiError, or
+// nil if none found.
+func (m *RouteMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RouteMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RouteMatchValidationError{
+						field:  fmt.Sprintf("Headers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RouteMatchValidationError{
+						field:  fmt.Sprintf("Headers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RouteMatchValidationError{
+					field:  fmt.Sprintf("Headers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetQueryParams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RouteMatchValidationError{
+						field:  fmt.Sprintf("QueryParams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RouteMatchValidationError{
+						field:  fmt.Sprintf("QueryParams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RouteMatchValidationError{
+					field:  fmt.Sprintf("QueryParams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RouteMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// RouteMatchMultiError is an error wrapping multiple validation errors
+// returned by RouteMatch.ValidateAll() if the designated constraints aren't met.
+type RouteMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RouteMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RouteMatchMultiError) AllErrors() []error { return m }
+
+// RouteMatchValidationError is the validation error returned by
+// RouteMatch.Validate if the designated constraints aren't met.
+type RouteMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RouteMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RouteMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RouteMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RouteMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RouteMatchValidationError) ErrorName() string { return "RouteMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RouteMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRouteMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RouteMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RouteMatchValidationError{}
+
+// Validate checks the field values on Rewrite with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Rewrite) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Rewrite with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RewriteMultiError, or nil if none found.
+func (m *Rewrite) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Rewrite) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Prefix
+
+	// no validation rules for Regex
+
+	// no validation rules for Replacement
+
+	// no validation rules for Path
+
+	// no validation rules for Host
+
+	if len(errors) > 0 {
+		return RewriteMultiError(errors)
+	}
+
+	return nil
+}
+
+// RewriteMultiError is an error wrapping multiple validation errors returned
+// by Rewrite.ValidateAll() if the designated constraints aren't met.
+type RewriteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RewriteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RewriteMultiError) AllErrors() []error { return m }
+
+// RewriteValidationError is the validation error returned by Rewrite.Validate
+// if the designated constraints aren't met.
+type RewriteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RewriteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RewriteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RewriteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RewriteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RewriteValidationError) ErrorName() string { return "RewriteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RewriteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRewrite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RewriteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RewriteValidationError{}
+
+// Validate checks the field values on Mirror with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Mirror) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Mirror with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MirrorMultiError, or nil if none found.
+func (m *Mirror) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Mirror) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TargetUrl
+
+	// no validation rules for Percentage
+
+	// no validation rules for MaxBodyBytes
+
+	// no validation rules for Timeout
+
+	if len(errors) > 0 {
+		return MirrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// MirrorMultiError is an error wrapping multiple validation errors returned by
+// Mirror.ValidateAll() if the designated constraints aren't met.
+type MirrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MirrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MirrorMultiError) AllErrors() []error { return m }
+
+// MirrorValidationError is the validation error returned by Mirror.Validate if
+// the designated constraints aren't met.
+type MirrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MirrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MirrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MirrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MirrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MirrorValidationError) ErrorName() string { return "MirrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MirrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMirror.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MirrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MirrorValidationError{}
+
+// Validate checks the field values on ClaimHeader with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClaimHeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimHeader with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClaimHeaderMultiError, or
+// nil if none found.
+func (m *ClaimHeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimHeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Claim
+
+	// no validation rules for Header
+
+	if len(errors) > 0 {
+		return ClaimHeaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimHeaderMultiError is an error wrapping multiple validation errors
+// returned by ClaimHeader.ValidateAll() if the designated constraints aren't met.
+type ClaimHeaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimHeaderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimHeaderMultiError) AllErrors() []error { return m }
+
+// ClaimHeaderValidationError is the validation error returned by
+// ClaimHeader.Validate if the designated constraints aren't met.
+type ClaimHeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimHeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimHeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimHeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimHeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimHeaderValidationError) ErrorName() string { return "ClaimHeaderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClaimHeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimHeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimHeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimHeaderValidationError{}
+
+// Validate checks the field values on Identity with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Identity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Identity with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IdentityMultiError, or nil
+// if none found.
+func (m *Identity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Identity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClaimHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IdentityValidationError{
+						field:  fmt.Sprintf("ClaimHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IdentityValidationError{
+						field:  fmt.Sprintf("ClaimHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IdentityValidationError{
+					field:  fmt.Sprintf("ClaimHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for OmitDefaultHeaders
+
+	// no validation rules for ForwardToken
+
+	for idx, item := range m.GetStaticHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IdentityValidationError{
+						field:  fmt.Sprintf("StaticHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IdentityValidationError{
+						field:  fmt.Sprintf("StaticHeaders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IdentityValidationError{
+					field:  fmt.Sprintf("StaticHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for InternalToken
+
+	if len(errors) > 0 {
+		return IdentityMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdentityMultiError is an error wrapping multiple validation errors returned
+// by Identity.ValidateAll() if the designated constraints aren't met.
+type IdentityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdentityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdentityMultiError) AllErrors() []error { return m }
+
+// IdentityValidationError is the validation error returned by
+// Identity.Validate if the designated constraints aren't met.
+type IdentityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdentityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdentityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdentityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdentityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdentityValidationError) ErrorName() string { return "IdentityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IdentityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdentity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdentityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdentityValidationError{}
+
+// Validate checks the field values on RateLimit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RateLimit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RateLimitMultiError, or nil
+// if none found.
+func (m *RateLimit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Algorithm
+
+	// no validation rules for Requests
+
+	// no validation rules for Window
+
+	// no validation rules for Burst
+
+	// no validation rules for Key
+
+	// no validation rules for Header
+
+	if len(errors) > 0 {
+		return RateLimitMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitMultiError is an error wrapping multiple validation errors returned
+// by RateLimit.ValidateAll() if the designated constraints aren't met.
+type RateLimitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitMultiError) AllErrors() []error { return m }
+
+// RateLimitValidationError is the validation error returned by
+// RateLimit.Validate if the designated constraints aren't met.
+type RateLimitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitValidationError) ErrorName() string { return "RateLimitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RateLimitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitValidationError{}
+
+// Validate checks the field values on Config with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Config) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Config with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ConfigMultiError, or nil if none found.
+func (m *Config) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Config) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for PathPrefix
+
+	// no validation rules for TargetUrl
+
+	// no validation rules for StripPrefix
+
+	if all {
+		switch v := interface{}(m.GetAuthentication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Authentication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timeout
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLoadBalancer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "LoadBalancer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "LoadBalancer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLoadBalancer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "LoadBalancer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealthCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealthCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "HealthCheck",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCircuitBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCircuitBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "CircuitBreaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMiddlewareConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "MiddlewareConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "MiddlewareConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMiddlewareConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "MiddlewareConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Match",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Priority
+
+	if all {
+		switch v := interface{}(m.GetRewrite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRewrite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Rewrite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSplit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSplit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Split",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMirror()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Mirror",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Mirror",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMirror()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Mirror",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdentity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdentity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Identity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigMultiError is an error wrapping multiple validation errors returned by
+// Config.ValidateAll() if the designated constraints aren't met.
+type ConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigMultiError) AllErrors() []error { return m }
+
+// ConfigValidationError is the validation error returned by Config.Validate if
+// the designated constraints aren't met.
+type ConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigValidationError) ErrorName() string { return "ConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigValidationError{}
+
+// Validate checks the field values on CreateConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConfigRequestMultiError, or nil if none found.
+func (m *CreateConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateConfigRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPathPrefix()) < 1 {
+		err := CreateConfigRequestValidationError{
+			field:  "PathPrefix",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TargetUrl
+
+	// no validation rules for StripPrefix
+
+	if all {
+		switch v := interface{}(m.GetAuthentication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "Authentication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timeout
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateConfigRequestValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateConfigRequestValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateConfigRequestValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLoadBalancer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "LoadBalancer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "LoadBalancer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLoadBalancer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "LoadBalancer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealthCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealthCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "HealthCheck",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCircuitBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCircuitBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "CircuitBreaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMiddlewareConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "MiddlewareConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "MiddlewareConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMiddlewareConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "MiddlewareConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "Match",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Priority
+
+	if all {
+		switch v := interface{}(m.GetRewrite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRewrite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "Rewrite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSplit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSplit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "Split",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMirror()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Mirror",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Mirror",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMirror()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "Mirror",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdentity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdentity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "Identity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigRequestValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigRequestValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConfigRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConfigRequestMultiError) AllErrors() []error { return m }
+
+// CreateConfigRequestValidationError is the validation error returned by
+// CreateConfigRequest.Validate if the designated constraints aren't met.
+type CreateConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConfigRequestValidationError) ErrorName() string {
+	return "CreateConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConfigRequestValidationError{}
+
+// Validate checks the field values on CreateConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConfigResponseMultiError, or nil if none found.
+func (m *CreateConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigResponseValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return CreateConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConfigResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateConfigResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConfigResponseMultiError) AllErrors() []error { return m }
+
+// CreateConfigResponseValidationError is the validation error returned by
+// CreateConfigResponse.Validate if the designated constraints aren't met.
+type CreateConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConfigResponseValidationError) ErrorName() string {
+	return "CreateConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConfigResponseValidationError{}
+
+// Validate checks the field values on GetConfigRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigRequestMultiError, or nil if none found.
+func (m *GetConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetConfigRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConfigRequestMultiError is an error wrapping multiple validation errors
+// returned by GetConfigRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConfigRequestMultiError) AllErrors() []error { return m }
+
+// GetConfigRequestValidationError is the validation error returned by
+// GetConfigRequest.Validate if the designated constraints aren't met.
+type GetConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigRequestValidationError) ErrorName() string { return "GetConfigRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigRequestValidationError{}
+
+// Validate checks the field values on GetConfigResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigResponseMultiError, or nil if none found.
+func (m *GetConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetConfigResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetConfigResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetConfigResponseValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return GetConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConfigResponseMultiError is an error wrapping multiple validation errors
+// returned by GetConfigResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConfigResponseMultiError) AllErrors() []error { return m }
+
+// GetConfigResponseValidationError is the validation error returned by
+// GetConfigResponse.Validate if the designated constraints aren't met.
+type GetConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigResponseValidationError) ErrorName() string {
+	return "GetConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigResponseValidationError{}
+
+// Validate checks the field values on ListConfigsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConfigsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConfigsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConfigsRequestMultiError, or nil if none found.
+func (m *ListConfigsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConfigsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLimit(); val <= 0 || val > 100 {
+		err := ListConfigsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := ListConfigsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Search
+
+	if len(errors) > 0 {
+		return ListConfigsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConfigsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListConfigsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListConfigsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConfigsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConfigsRequestMultiError) AllErrors() []error { return m }
+
+// ListConfigsRequestValidationError is the validation error returned by
+// ListConfigsRequest.Validate if the designated constraints aren't met.
+type ListConfigsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConfigsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConfigsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConfigsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConfigsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConfigsRequestValidationError) ErrorName() string {
+	return "ListConfigsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConfigsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConfigsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConfigsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConfigsRequestValidationError{}
+
+// Validate checks the field values on ListConfigsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConfigsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConfigsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConfigsResponseMultiError, or nil if none found.
+func (m *ListConfigsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConfigsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConfigs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConfigsResponseValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConfigsResponseValidationError{
+						field:  fmt.Sprintf("Configs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConfigsResponseValidationError{
+					field:  fmt.Sprintf("Configs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ListConfigsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConfigsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListConfigsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListConfigsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConfigsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConfigsResponseMultiError) AllErrors() []error { return m }
+
+// ListConfigsResponseValidationError is the validation error returned by
+// ListConfigsResponse.Validate if the designated constraints aren't met.
+type ListConfigsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConfigsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConfigsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConfigsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConfigsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConfigsResponseValidationError) ErrorName() string {
+	return "ListConfigsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConfigsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConfigsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConfigsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConfigsResponseValidationError{}
+
+// Validate checks the field values on GetRoutesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRoutesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoutesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoutesRequestMultiError, or nil if none found.
+func (m *GetRoutesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoutesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetRoutesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoutesRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRoutesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRoutesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoutesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoutesRequestMultiError) AllErrors() []error { return m }
+
+// GetRoutesRequestValidationError is the validation error returned by
+// GetRoutesRequest.Validate if the designated constraints aren't met.
+type GetRoutesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoutesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoutesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoutesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoutesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoutesRequestValidationError) ErrorName() string { return "GetRoutesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRoutesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoutesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoutesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoutesRequestValidationError{}
+
+// Validate checks the field values on Route with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Route) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Route with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RouteMultiError, or nil if none found.
+func (m *Route) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Route) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for PathPrefix
+
+	// no validation rules for TargetUrl
+
+	// no validation rules for StripPrefix
+
+	if all {
+		switch v := interface{}(m.GetAuthentication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "Authentication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timeout
+
+	// no validation rules for UpdatedAt
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RouteValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RouteValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RouteValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLoadBalancer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "LoadBalancer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "LoadBalancer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLoadBalancer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "LoadBalancer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealthCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealthCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "HealthCheck",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCircuitBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCircuitBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "CircuitBreaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMiddlewareConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "MiddlewareConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "MiddlewareConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMiddlewareConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "MiddlewareConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "Match",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Priority
+
+	if all {
+		switch v := interface{}(m.GetRewrite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRewrite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "Rewrite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSplit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSplit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "Split",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMirror()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Mirror",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Mirror",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMirror()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "Mirror",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdentity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdentity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "Identity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RouteValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RouteValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RouteMultiError(errors)
+	}
+
+	return nil
+}
+
+// RouteMultiError is an error wrapping multiple validation errors returned by
+// Route.ValidateAll() if the designated constraints aren't met.
+type RouteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RouteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RouteMultiError) AllErrors() []error { return m }
+
+// RouteValidationError is the validation error returned by Route.Validate if
+// the designated constraints aren't met.
+type RouteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RouteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RouteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RouteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RouteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RouteValidationError) ErrorName() string { return "RouteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RouteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RouteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RouteValidationError{}
+
+// Validate checks the field values on GetRoutesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRoutesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRoutesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRoutesResponseMultiError, or nil if none found.
+func (m *GetRoutesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRoutesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoutes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRoutesResponseValidationError{
+						field:  fmt.Sprintf("Routes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRoutesResponseValidationError{
+						field:  fmt.Sprintf("Routes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRoutesResponseValidationError{
+					field:  fmt.Sprintf("Routes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return GetRoutesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRoutesResponseMultiError is an error wrapping multiple validation errors
+// returned by GetRoutesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetRoutesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRoutesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRoutesResponseMultiError) AllErrors() []error { return m }
+
+// GetRoutesResponseValidationError is the validation error returned by
+// GetRoutesResponse.Validate if the designated constraints aren't met.
+type GetRoutesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRoutesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRoutesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRoutesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRoutesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRoutesResponseValidationError) ErrorName() string {
+	return "GetRoutesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRoutesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRoutesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRoutesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRoutesResponseValidationError{}
+
+// Validate checks the field values on KeyValue with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KeyValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KeyValue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KeyValueMultiError, or nil
+// if none found.
+func (m *KeyValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KeyValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return KeyValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// KeyValueMultiError is an error wrapping multiple validation errors returned
+// by KeyValue.ValidateAll() if the designated constraints aren't met.
+type KeyValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KeyValueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KeyValueMultiError) AllErrors() []error { return m }
+
+// KeyValueValidationError is the validation error returned by
+// KeyValue.Validate if the designated constraints aren't met.
+type KeyValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KeyValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KeyValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KeyValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KeyValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KeyValueValidationError) ErrorName() string { return "KeyValueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KeyValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKeyValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KeyValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KeyValueValidationError{}
+
+// Validate checks the field values on TestRouteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TestRouteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestRouteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TestRouteRequestMultiError, or nil if none found.
+func (m *TestRouteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestRouteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMethod()) < 1 {
+		err := TestRouteRequestValidationError{
+			field:  "Method",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Host
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := TestRouteRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TestRouteRequestValidationError{
+						field:  fmt.Sprintf("Headers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TestRouteRequestValidationError{
+						field:  fmt.Sprintf("Headers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TestRouteRequestValidationError{
+					field:  fmt.Sprintf("Headers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TestRouteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestRouteRequestMultiError is an error wrapping multiple validation errors
+// returned by TestRouteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TestRouteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestRouteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestRouteRequestMultiError) AllErrors() []error { return m }
+
+// TestRouteRequestValidationError is the validation error returned by
+// TestRouteRequest.Validate if the designated constraints aren't met.
+type TestRouteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestRouteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestRouteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestRouteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestRouteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestRouteRequestValidationError) ErrorName() string { return "TestRouteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestRouteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestRouteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestRouteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestRouteRequestValidationError{}
+
+// Validate checks the field values on TestRouteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TestRouteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestRouteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TestRouteResponseMultiError, or nil if none found.
+func (m *TestRouteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestRouteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Matched
+
+	// no validation rules for Route
+
+	for idx, item := range m.GetPathParams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TestRouteResponseValidationError{
+						field:  fmt.Sprintf("PathParams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TestRouteResponseValidationError{
+						field:  fmt.Sprintf("PathParams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TestRouteResponseValidationError{
+					field:  fmt.Sprintf("PathParams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MatchedPrefix
+
+	// no validation rules for UpstreamPath
+
+	// no validation rules for UpstreamHost
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return TestRouteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestRouteResponseMultiError is an error wrapping multiple validation errors
+// returned by TestRouteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TestRouteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestRouteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestRouteResponseMultiError) AllErrors() []error { return m }
+
+// TestRouteResponseValidationError is the validation error returned by
+// TestRouteResponse.Validate if the designated constraints aren't met.
+type TestRouteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestRouteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestRouteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestRouteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestRouteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestRouteResponseValidationError) ErrorName() string {
+	return "TestRouteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestRouteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestRouteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestRouteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestRouteResponseValidationError{}
+
+// Validate checks the field values on UpdateConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateConfigRequestMultiError, or nil if none found.
+func (m *UpdateConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateConfigRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateConfigRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPathPrefix()) < 1 {
+		err := UpdateConfigRequestValidationError{
+			field:  "PathPrefix",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TargetUrl
+
+	// no validation rules for StripPrefix
+
+	if all {
+		switch v := interface{}(m.GetAuthentication()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Authentication",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "Authentication",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timeout
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateConfigRequestValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateConfigRequestValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateConfigRequestValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLoadBalancer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "LoadBalancer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "LoadBalancer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLoadBalancer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "LoadBalancer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealthCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealthCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "HealthCheck",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCircuitBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCircuitBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "CircuitBreaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "RetryPolicy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMiddlewareConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "MiddlewareConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "MiddlewareConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMiddlewareConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "MiddlewareConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Match",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "Match",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Priority
+
+	if all {
+		switch v := interface{}(m.GetRewrite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Rewrite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRewrite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "Rewrite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSplit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Split",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSplit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "Split",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMirror()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Mirror",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Mirror",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMirror()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "Mirror",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdentity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdentity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "Identity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigRequestValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigRequestValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateConfigRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateConfigRequestMultiError) AllErrors() []error { return m }
+
+// UpdateConfigRequestValidationError is the validation error returned by
+// UpdateConfigRequest.Validate if the designated constraints aren't met.
+type UpdateConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateConfigRequestValidationError) ErrorName() string {
+	return "UpdateConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateConfigRequestValidationError{}
+
+// Validate checks the field values on UpdateConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateConfigResponseMultiError, or nil if none found.
+func (m *UpdateConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigResponseValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RouteValidationError{
-				field:  "Authentication",
+			return UpdateConfigResponseValidationError{
+				field:  "Config",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Timeout
-
-	// no validation rules for UpdatedAt
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return RouteMultiError(errors)
+		return UpdateConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RouteMultiError is an error wrapping multiple validation errors returned by
-// Route.ValidateAll() if the designated constraints aren't met.
-type RouteMultiError []error
+// UpdateConfigResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateConfigResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RouteMultiError) Error() string {
+func (m UpdateConfigResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1412,11 +5764,11 @@ func (m RouteMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RouteMultiError) AllErrors() []error { return m }
+func (m UpdateConfigResponseMultiError) AllErrors() []error { return m }
 
-// RouteValidationError is the validation error returned by Route.Validate if
-// the designated constraints aren't met.
-type RouteValidationError struct {
+// UpdateConfigResponseValidationError is the validation error returned by
+// UpdateConfigResponse.Validate if the designated constraints aren't met.
+type UpdateConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1424,22 +5776,24 @@ type RouteValidationError struct {
 }
 
 // Field function returns field value.
-func (e RouteValidationError) Field() string { return e.field }
+func (e UpdateConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RouteValidationError) Reason() string { return e.reason }
+func (e UpdateConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RouteValidationError) Cause() error { return e.cause }
+func (e UpdateConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RouteValidationError) Key() bool { return e.key }
+func (e UpdateConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RouteValidationError) ErrorName() string { return "RouteValidationError" }
+func (e UpdateConfigResponseValidationError) ErrorName() string {
+	return "UpdateConfigResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RouteValidationError) Error() string {
+func (e UpdateConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1451,14 +5805,14 @@ func (e RouteValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRoute.%s: %s%s",
+		"invalid %sUpdateConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RouteValidationError{}
+var _ error = UpdateConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1466,80 +5820,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RouteValidationError{}
+} = UpdateConfigResponseValidationError{}
 
-// Validate checks the field values on GetRoutesResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetRoutesResponse) Validate() error {
+// Validate checks the field values on VersionWeight with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VersionWeight) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRoutesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetRoutesResponseMultiError, or nil if none found.
-func (m *GetRoutesResponse) ValidateAll() error {
+// ValidateAll checks the field values on VersionWeight with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VersionWeightMultiError, or
+// nil if none found.
+func (m *VersionWeight) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRoutesResponse) validate(all bool) error {
+func (m *VersionWeight) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRoutes() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetRoutesResponseValidationError{
-						field:  fmt.Sprintf("Routes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetRoutesResponseValidationError{
-						field:  fmt.Sprintf("Routes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetRoutesResponseValidationError{
-					field:  fmt.Sprintf("Routes[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetVersion()) < 1 {
+		err := VersionWeightValidationError{
+			field:  "Version",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for Message
+	if m.GetWeight() < 0 {
+		err := VersionWeightValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GetRoutesResponseMultiError(errors)
+		return VersionWeightMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRoutesResponseMultiError is an error wrapping multiple validation errors
-// returned by GetRoutesResponse.ValidateAll() if the designated constraints
+// VersionWeightMultiError is an error wrapping multiple validation errors
+// returned by VersionWeight.ValidateAll() if the designated constraints
 // aren't met.
-type GetRoutesResponseMultiError []error
+type VersionWeightMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRoutesResponseMultiError) Error() string {
+func (m VersionWeightMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1548,11 +5888,11 @@ func (m GetRoutesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRoutesResponseMultiError) AllErrors() []error { return m }
+func (m VersionWeightMultiError) AllErrors() []error { return m }
 
-// GetRoutesResponseValidationError is the validation error returned by
-// GetRoutesResponse.Validate if the designated constraints aren't met.
-type GetRoutesResponseValidationError struct {
+// VersionWeightValidationError is the validation error returned by
+// VersionWeight.Validate if the designated constraints aren't met.
+type VersionWeightValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1560,24 +5900,22 @@ type GetRoutesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRoutesResponseValidationError) Field() string { return e.field }
+func (e VersionWeightValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRoutesResponseValidationError) Reason() string { return e.reason }
+func (e VersionWeightValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRoutesResponseValidationError) Cause() error { return e.cause }
+func (e VersionWeightValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRoutesResponseValidationError) Key() bool { return e.key }
+func (e VersionWeightValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRoutesResponseValidationError) ErrorName() string {
-	return "GetRoutesResponseValidationError"
-}
+func (e VersionWeightValidationError) ErrorName() string { return "VersionWeightValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetRoutesResponseValidationError) Error() string {
+func (e VersionWeightValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1589,14 +5927,14 @@ func (e GetRoutesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRoutesResponse.%s: %s%s",
+		"invalid %sVersionWeight.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRoutesResponseValidationError{}
+var _ error = VersionWeightValidationError{}
 
 var _ interface {
 	Field() string
@@ -1604,24 +5942,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRoutesResponseValidationError{}
+} = VersionWeightValidationError{}
 
-// Validate checks the field values on UpdateConfigRequest with the rules
+// Validate checks the field values on SetRouteWeightsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateConfigRequest) Validate() error {
+func (m *SetRouteWeightsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateConfigRequest with the rules
+// ValidateAll checks the field values on SetRouteWeightsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateConfigRequestMultiError, or nil if none found.
-func (m *UpdateConfigRequest) ValidateAll() error {
+// SetRouteWeightsRequestMultiError, or nil if none found.
+func (m *SetRouteWeightsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateConfigRequest) validate(all bool) error {
+func (m *SetRouteWeightsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1629,7 +5967,7 @@ func (m *UpdateConfigRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetId() <= 0 {
-		err := UpdateConfigRequestValidationError{
+		err := SetRouteWeightsRequestValidationError{
 			field:  "Id",
 			reason: "value must be greater than 0",
 		}
@@ -1639,86 +5977,54 @@ func (m *UpdateConfigRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := UpdateConfigRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetPathPrefix()) < 1 {
-		err := UpdateConfigRequestValidationError{
-			field:  "PathPrefix",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetTargetUrl()) < 1 {
-		err := UpdateConfigRequestValidationError{
-			field:  "TargetUrl",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for StripPrefix
+	for idx, item := range m.GetWeights() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetAuthentication()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateConfigRequestValidationError{
-					field:  "Authentication",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetRouteWeightsRequestValidationError{
+						field:  fmt.Sprintf("Weights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetRouteWeightsRequestValidationError{
+						field:  fmt.Sprintf("Weights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateConfigRequestValidationError{
-					field:  "Authentication",
+				return SetRouteWeightsRequestValidationError{
+					field:  fmt.Sprintf("Weights[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAuthentication()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateConfigRequestValidationError{
-				field:  "Authentication",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for Timeout
+	}
 
 	if len(errors) > 0 {
-		return UpdateConfigRequestMultiError(errors)
+		return SetRouteWeightsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateConfigRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateConfigRequest.ValidateAll() if the designated
+// SetRouteWeightsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetRouteWeightsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateConfigRequestMultiError []error
+type SetRouteWeightsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateConfigRequestMultiError) Error() string {
+func (m SetRouteWeightsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1727,11 +6033,11 @@ func (m UpdateConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateConfigRequestMultiError) AllErrors() []error { return m }
+func (m SetRouteWeightsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateConfigRequestValidationError is the validation error returned by
-// UpdateConfigRequest.Validate if the designated constraints aren't met.
-type UpdateConfigRequestValidationError struct {
+// SetRouteWeightsRequestValidationError is the validation error returned by
+// SetRouteWeightsRequest.Validate if the designated constraints aren't met.
+type SetRouteWeightsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1739,24 +6045,24 @@ type UpdateConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateConfigRequestValidationError) Field() string { return e.field }
+func (e SetRouteWeightsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateConfigRequestValidationError) Reason() string { return e.reason }
+func (e SetRouteWeightsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateConfigRequestValidationError) Cause() error { return e.cause }
+func (e SetRouteWeightsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateConfigRequestValidationError) Key() bool { return e.key }
+func (e SetRouteWeightsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateConfigRequestValidationError) ErrorName() string {
-	return "UpdateConfigRequestValidationError"
+func (e SetRouteWeightsRequestValidationError) ErrorName() string {
+	return "SetRouteWeightsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateConfigRequestValidationError) Error() string {
+func (e SetRouteWeightsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1768,14 +6074,14 @@ func (e UpdateConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateConfigRequest.%s: %s%s",
+		"invalid %sSetRouteWeightsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateConfigRequestValidationError{}
+var _ error = SetRouteWeightsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1783,24 +6089,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateConfigRequestValidationError{}
+} = SetRouteWeightsRequestValidationError{}
 
-// Validate checks the field values on UpdateConfigResponse with the rules
+// Validate checks the field values on SetRouteWeightsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateConfigResponse) Validate() error {
+func (m *SetRouteWeightsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateConfigResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetRouteWeightsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateConfigResponseMultiError, or nil if none found.
-func (m *UpdateConfigResponse) ValidateAll() error {
+// SetRouteWeightsResponseMultiError, or nil if none found.
+func (m *SetRouteWeightsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateConfigResponse) validate(all bool) error {
+func (m *SetRouteWeightsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1811,7 +6117,7 @@ func (m *UpdateConfigResponse) validate(all bool) error {
 		switch v := interface{}(m.GetConfig()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateConfigResponseValidationError{
+				errors = append(errors, SetRouteWeightsResponseValidationError{
 					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1819,7 +6125,7 @@ func (m *UpdateConfigResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateConfigResponseValidationError{
+				errors = append(errors, SetRouteWeightsResponseValidationError{
 					field:  "Config",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1828,7 +6134,7 @@ func (m *UpdateConfigResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateConfigResponseValidationError{
+			return SetRouteWeightsResponseValidationError{
 				field:  "Config",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1839,19 +6145,19 @@ func (m *UpdateConfigResponse) validate(all bool) error {
 	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return UpdateConfigResponseMultiError(errors)
+		return SetRouteWeightsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateConfigResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateConfigResponse.ValidateAll() if the designated
+// SetRouteWeightsResponseMultiError is an error wrapping multiple validation
+// errors returned by SetRouteWeightsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateConfigResponseMultiError []error
+type SetRouteWeightsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateConfigResponseMultiError) Error() string {
+func (m SetRouteWeightsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1860,11 +6166,11 @@ func (m UpdateConfigResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateConfigResponseMultiError) AllErrors() []error { return m }
+func (m SetRouteWeightsResponseMultiError) AllErrors() []error { return m }
 
-// UpdateConfigResponseValidationError is the validation error returned by
-// UpdateConfigResponse.Validate if the designated constraints aren't met.
-type UpdateConfigResponseValidationError struct {
+// SetRouteWeightsResponseValidationError is the validation error returned by
+// SetRouteWeightsResponse.Validate if the designated constraints aren't met.
+type SetRouteWeightsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1872,24 +6178,24 @@ type UpdateConfigResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateConfigResponseValidationError) Field() string { return e.field }
+func (e SetRouteWeightsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateConfigResponseValidationError) Reason() string { return e.reason }
+func (e SetRouteWeightsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateConfigResponseValidationError) Cause() error { return e.cause }
+func (e SetRouteWeightsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateConfigResponseValidationError) Key() bool { return e.key }
+func (e SetRouteWeightsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateConfigResponseValidationError) ErrorName() string {
-	return "UpdateConfigResponseValidationError"
+func (e SetRouteWeightsResponseValidationError) ErrorName() string {
+	return "SetRouteWeightsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateConfigResponseValidationError) Error() string {
+func (e SetRouteWeightsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1901,14 +6207,14 @@ func (e UpdateConfigResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateConfigResponse.%s: %s%s",
+		"invalid %sSetRouteWeightsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateConfigResponseValidationError{}
+var _ error = SetRouteWeightsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1916,7 +6222,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateConfigResponseValidationError{}
+} = SetRouteWeightsResponseValidationError{}
 
 // Validate checks the field values on DeleteConfigRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -2261,6 +6567,10 @@ func (m *GetStatsResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
+	// no validation rules for HealthyTargets
+
+	// no validation rules for UnhealthyTargets
+
 	if len(errors) > 0 {
 		return GetStatsResponseMultiError(errors)
 	}
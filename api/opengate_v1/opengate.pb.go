@@ -26,7 +26,7 @@ var File_proto_opengate_v1_opengate_proto protoreflect.FileDescriptor
 
 const file_proto_opengate_v1_opengate_proto_rawDesc = "" +
 	"\n" +
-	" proto/opengate/v1/opengate.proto\x12\vopengate.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a proto/opengate/common/ping.proto\x1a\x1eproto/opengate/v1/config.proto\x1a$proto/opengate/v1/app_settings.proto2\x90\x0f\n" +
+	" proto/opengate/v1/opengate.proto\x12\vopengate.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a proto/opengate/common/ping.proto\x1a\x1eproto/opengate/v1/config.proto\x1a$proto/opengate/v1/app_settings.proto\x1a\x1eproto/opengate/v1/health.proto\x1a proto/opengate/v1/api_keys.proto2\xb7\x1c\n" +
 	"\x0fOpenGateService\x12\x8f\x01\n" +
 	"\x04Ping\x12\x18.opengate.v1.PingRequest\x1a\x19.opengate.v1.PingResponse\"R\x92A6\n" +
 	"\x04Ping\x12\x0fPing the server\x1a\x1dCheck if the server is alive.\x82\xd3\xe4\x93\x02\x13\x12\x11/opengate/v1/ping\x12\xcd\x01\n" +
@@ -37,17 +37,31 @@ const file_proto_opengate_v1_opengate_proto_rawDesc = "" +
 	"\vListConfigs\x12\x1f.opengate.v1.ListConfigsRequest\x1a .opengate.v1.ListConfigsResponse\"j\x92AK\n" +
 	"\aConfigs\x12\fList configs\x1a2List route configurations with pagination support.\x82\xd3\xe4\x93\x02\x16\x12\x14/opengate/v1/configs\x12\xbf\x01\n" +
 	"\fUpdateConfig\x12 .opengate.v1.UpdateConfigRequest\x1a!.opengate.v1.UpdateConfigResponse\"j\x92AC\n" +
-	"\aConfigs\x12\x0fUpdate a config\x1a'Update an existing route configuration.\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/opengate/v1/configs/{id}\x12\xbc\x01\n" +
+	"\aConfigs\x12\x0fUpdate a config\x1a'Update an existing route configuration.\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/opengate/v1/configs/{id}\x12\x82\x02\n" +
+	"\x0fSetRouteWeights\x12#.opengate.v1.SetRouteWeightsRequest\x1a$.opengate.v1.SetRouteWeightsResponse\"\xa3\x01\x92At\n" +
+	"\aConfigs\x12\x11Set route weights\x1aVSet the weights of the versions of a split route, e.g. to roll a canary out gradually.\x82\xd3\xe4\x93\x02&:\x01*\x1a!/opengate/v1/configs/{id}/weights\x12\xbc\x01\n" +
 	"\fDeleteConfig\x12 .opengate.v1.DeleteConfigRequest\x1a!.opengate.v1.DeleteConfigResponse\"g\x92AC\n" +
 	"\aConfigs\x12\x0fDelete a config\x1a'Delete a route configuration by its ID.\x82\xd3\xe4\x93\x02\x1b*\x19/opengate/v1/configs/{id}\x12\xb0\x01\n" +
 	"\tGetRoutes\x12\x1d.opengate.v1.GetRoutesRequest\x1a\x1e.opengate.v1.GetRoutesResponse\"d\x92AF\n" +
-	"\x06Routes\x12\x0eGet all routes\x1a,Retrieve all routes for the routing manager.\x82\xd3\xe4\x93\x02\x15\x12\x13/opengate/v1/routes\x12\xb0\x01\n" +
+	"\x06Routes\x12\x0eGet all routes\x1a,Retrieve all routes for the routing manager.\x82\xd3\xe4\x93\x02\x15\x12\x13/opengate/v1/routes\x12\xfe\x01\n" +
+	"\tTestRoute\x12\x1d.opengate.v1.TestRouteRequest\x1a\x1e.opengate.v1.TestRouteResponse\"\xb1\x01\x92A\x8a\x01\n" +
+	"\x06Routes\x12\fTest a route\x1arShow the route a request would be sent to along with its path parameters and the rewritten upstream path and host.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/opengate/v1/routes/test\x12\xb0\x01\n" +
 	"\bGetStats\x12\x1c.opengate.v1.GetStatsRequest\x1a\x1d.opengate.v1.GetStatsResponse\"g\x92AJ\n" +
 	"\x05Stats\x12\x13Get dashboard stats\x1a,Retrieve statistics for the admin dashboard.\x82\xd3\xe4\x93\x02\x14\x12\x12/opengate/v1/stats\x12\xd9\x01\n" +
 	"\x0eGetAppSettings\x12\".opengate.v1.GetAppSettingsRequest\x1a#.opengate.v1.GetAppSettingsResponse\"~\x92AZ\n" +
 	"\vAppSettings\x12\x14Get all app settings\x1a5Retrieve all application settings as a key-value map.\x82\xd3\xe4\x93\x02\x1b\x12\x19/opengate/v1/app-settings\x12\xdd\x01\n" +
 	"\x10UpsertAppSetting\x12$.opengate.v1.UpsertAppSettingRequest\x1a%.opengate.v1.UpsertAppSettingResponse\"|\x92AU\n" +
-	"\vAppSettings\x12\x15Upsert an app setting\x1a/Create or update an application setting by key.\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/opengate/v1/app-settingsB\x86\x04\x92A\xf3\x03\x12Q\n" +
+	"\vAppSettings\x12\x15Upsert an app setting\x1a/Create or update an application setting by key.\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/opengate/v1/app-settings\x12\xe7\x01\n" +
+	"\tGetHealth\x12\x1d.opengate.v1.GetHealthRequest\x1a\x1e.opengate.v1.GetHealthResponse\"\x9a\x01\x92A|\n" +
+	"\x06Health\x12\x13Get upstream health\x1a]Retrieve the active health check and circuit breaker state of every route's upstream targets.\x82\xd3\xe4\x93\x02\x15\x12\x13/opengate/v1/health\x12\xed\x01\n" +
+	"\fCreateAPIKey\x12 .opengate.v1.CreateAPIKeyRequest\x1a!.opengate.v1.CreateAPIKeyResponse\"\x97\x01\x92At\n" +
+	"\aAPIKeys\x12\x11Create an API key\x1aVCreate an API key. The key is only returned in this response, just its hash is stored.\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/opengate/v1/api-keys\x12\xd5\x01\n" +
+	"\vListAPIKeys\x12\x1f.opengate.v1.ListAPIKeysRequest\x1a .opengate.v1.ListAPIKeysResponse\"\x82\x01\x92Ab\n" +
+	"\aAPIKeys\x12\rList API keys\x1aHList the API keys along with their owner, scopes, expiry and revocation.\x82\xd3\xe4\x93\x02\x17\x12\x15/opengate/v1/api-keys\x12\x92\x02\n" +
+	"\fRotateAPIKey\x12 .opengate.v1.RotateAPIKeyRequest\x1a!.opengate.v1.RotateAPIKeyResponse\"\xbc\x01\x92A\x8c\x01\n" +
+	"\aAPIKeys\x12\x11Rotate an API key\x1anGenerate a new key for an API key, keeping its name, owner, scopes and expiry. The previous key stops working.\x82\xd3\xe4\x93\x02&:\x01*\"!/opengate/v1/api-keys/{id}/rotate\x12\xd7\x01\n" +
+	"\fRevokeAPIKey\x12 .opengate.v1.RevokeAPIKeyRequest\x1a!.opengate.v1.RevokeAPIKeyResponse\"\x81\x01\x92AR\n" +
+	"\aAPIKeys\x12\x11Revoke an API key\x1a4Revoke an API key so requests using it are rejected.\x82\xd3\xe4\x93\x02&:\x01*\"!/opengate/v1/api-keys/{id}/revokeB\xf9\x04\x92A\xe6\x04\x12Q\n" +
 	"\fOpenGate API\x129OpenGate API Gateway - Configuration and Route Management2\x06v1.0.0Z\x86\x01\n" +
 	"L\n" +
 	"\vPermissions\x12=\b\x02\x12)Comma-separated list of user permissions.\x1a\fX-User-Perms \x02\n" +
@@ -62,7 +76,9 @@ const file_proto_opengate_v1_opengate_proto_rawDesc = "" +
 	"\aConfigs\x12+Endpoints for managing route configurationsj5\n" +
 	"\x06Routes\x12+Endpoints for retrieving routes for routingj+\n" +
 	"\x05Stats\x12\"Endpoints for dashboard statisticsj:\n" +
-	"\vAppSettings\x12+Endpoints for managing application settingsZ\r./opengate_v1b\x06proto3"
+	"\vAppSettings\x12+Endpoints for managing application settingsj.\n" +
+	"\x06Health\x12$Endpoints for upstream target healthjA\n" +
+	"\aAPIKeys\x126Endpoints for managing the API keys of machine clientsZ\r./opengate_v1b\x06proto3"
 
 var file_proto_opengate_v1_opengate_proto_goTypes = []any{
 	(*PingRequest)(nil),              // 0: opengate.v1.PingRequest
@@ -70,21 +86,35 @@ var file_proto_opengate_v1_opengate_proto_goTypes = []any{
 	(*GetConfigRequest)(nil),         // 2: opengate.v1.GetConfigRequest
 	(*ListConfigsRequest)(nil),       // 3: opengate.v1.ListConfigsRequest
 	(*UpdateConfigRequest)(nil),      // 4: opengate.v1.UpdateConfigRequest
-	(*DeleteConfigRequest)(nil),      // 5: opengate.v1.DeleteConfigRequest
-	(*GetRoutesRequest)(nil),         // 6: opengate.v1.GetRoutesRequest
-	(*GetStatsRequest)(nil),          // 7: opengate.v1.GetStatsRequest
-	(*GetAppSettingsRequest)(nil),    // 8: opengate.v1.GetAppSettingsRequest
-	(*UpsertAppSettingRequest)(nil),  // 9: opengate.v1.UpsertAppSettingRequest
-	(*PingResponse)(nil),             // 10: opengate.v1.PingResponse
-	(*CreateConfigResponse)(nil),     // 11: opengate.v1.CreateConfigResponse
-	(*GetConfigResponse)(nil),        // 12: opengate.v1.GetConfigResponse
-	(*ListConfigsResponse)(nil),      // 13: opengate.v1.ListConfigsResponse
-	(*UpdateConfigResponse)(nil),     // 14: opengate.v1.UpdateConfigResponse
-	(*DeleteConfigResponse)(nil),     // 15: opengate.v1.DeleteConfigResponse
-	(*GetRoutesResponse)(nil),        // 16: opengate.v1.GetRoutesResponse
-	(*GetStatsResponse)(nil),         // 17: opengate.v1.GetStatsResponse
-	(*GetAppSettingsResponse)(nil),   // 18: opengate.v1.GetAppSettingsResponse
-	(*UpsertAppSettingResponse)(nil), // 19: opengate.v1.UpsertAppSettingResponse
+	(*SetRouteWeightsRequest)(nil),   // 5: opengate.v1.SetRouteWeightsRequest
+	(*DeleteConfigRequest)(nil),      // 6: opengate.v1.DeleteConfigRequest
+	(*GetRoutesRequest)(nil),         // 7: opengate.v1.GetRoutesRequest
+	(*TestRouteRequest)(nil),         // 8: opengate.v1.TestRouteRequest
+	(*GetStatsRequest)(nil),          // 9: opengate.v1.GetStatsRequest
+	(*GetAppSettingsRequest)(nil),    // 10: opengate.v1.GetAppSettingsRequest
+	(*UpsertAppSettingRequest)(nil),  // 11: opengate.v1.UpsertAppSettingRequest
+	(*GetHealthRequest)(nil),         // 12: opengate.v1.GetHealthRequest
+	(*CreateAPIKeyRequest)(nil),      // 13: opengate.v1.CreateAPIKeyRequest
+	(*ListAPIKeysRequest)(nil),       // 14: opengate.v1.ListAPIKeysRequest
+	(*RotateAPIKeyRequest)(nil),      // 15: opengate.v1.RotateAPIKeyRequest
+	(*RevokeAPIKeyRequest)(nil),      // 16: opengate.v1.RevokeAPIKeyRequest
+	(*PingResponse)(nil),             // 17: opengate.v1.PingResponse
+	(*CreateConfigResponse)(nil),     // 18: opengate.v1.CreateConfigResponse
+	(*GetConfigResponse)(nil),        // 19: opengate.v1.GetConfigResponse
+	(*ListConfigsResponse)(nil),      // 20: opengate.v1.ListConfigsResponse
+	(*UpdateConfigResponse)(nil),     // 21: opengate.v1.UpdateConfigResponse
+	(*SetRouteWeightsResponse)(nil),  // 22: opengate.v1.SetRouteWeightsResponse
+	(*DeleteConfigResponse)(nil),     // 23: opengate.v1.DeleteConfigResponse
+	(*GetRoutesResponse)(nil),        // 24: opengate.v1.GetRoutesResponse
+	(*TestRouteResponse)(nil),        // 25: opengate.v1.TestRouteResponse
+	(*GetStatsResponse)(nil),         // 26: opengate.v1.GetStatsResponse
+	(*GetAppSettingsResponse)(nil),   // 27: opengate.v1.GetAppSettingsResponse
+	(*UpsertAppSettingResponse)(nil), // 28: opengate.v1.UpsertAppSettingResponse
+	(*GetHealthResponse)(nil),        // 29: opengate.v1.GetHealthResponse
+	(*CreateAPIKeyResponse)(nil),     // 30: opengate.v1.CreateAPIKeyResponse
+	(*ListAPIKeysResponse)(nil),      // 31: opengate.v1.ListAPIKeysResponse
+	(*RotateAPIKeyResponse)(nil),     // 32: opengate.v1.RotateAPIKeyResponse
+	(*RevokeAPIKeyResponse)(nil),     // 33: opengate.v1.RevokeAPIKeyResponse
 }
 var file_proto_opengate_v1_opengate_proto_depIdxs = []int32{
 	0,  // 0: opengate.v1.OpenGateService.Ping:input_type -> opengate.v1.PingRequest
@@ -92,23 +122,37 @@ var file_proto_opengate_v1_opengate_proto_depIdxs = []int32{
 	2,  // 2: opengate.v1.OpenGateService.GetConfig:input_type -> opengate.v1.GetConfigRequest
 	3,  // 3: opengate.v1.OpenGateService.ListConfigs:input_type -> opengate.v1.ListConfigsRequest
 	4,  // 4: opengate.v1.OpenGateService.UpdateConfig:input_type -> opengate.v1.UpdateConfigRequest
-	5,  // 5: opengate.v1.OpenGateService.DeleteConfig:input_type -> opengate.v1.DeleteConfigRequest
-	6,  // 6: opengate.v1.OpenGateService.GetRoutes:input_type -> opengate.v1.GetRoutesRequest
-	7,  // 7: opengate.v1.OpenGateService.GetStats:input_type -> opengate.v1.GetStatsRequest
-	8,  // 8: opengate.v1.OpenGateService.GetAppSettings:input_type -> opengate.v1.GetAppSettingsRequest
-	9,  // 9: opengate.v1.OpenGateService.UpsertAppSetting:input_type -> opengate.v1.UpsertAppSettingRequest
-	10, // 10: opengate.v1.OpenGateService.Ping:output_type -> opengate.v1.PingResponse
-	11, // 11: opengate.v1.OpenGateService.CreateConfig:output_type -> opengate.v1.CreateConfigResponse
-	12, // 12: opengate.v1.OpenGateService.GetConfig:output_type -> opengate.v1.GetConfigResponse
-	13, // 13: opengate.v1.OpenGateService.ListConfigs:output_type -> opengate.v1.ListConfigsResponse
-	14, // 14: opengate.v1.OpenGateService.UpdateConfig:output_type -> opengate.v1.UpdateConfigResponse
-	15, // 15: opengate.v1.OpenGateService.DeleteConfig:output_type -> opengate.v1.DeleteConfigResponse
-	16, // 16: opengate.v1.OpenGateService.GetRoutes:output_type -> opengate.v1.GetRoutesResponse
-	17, // 17: opengate.v1.OpenGateService.GetStats:output_type -> opengate.v1.GetStatsResponse
-	18, // 18: opengate.v1.OpenGateService.GetAppSettings:output_type -> opengate.v1.GetAppSettingsResponse
-	19, // 19: opengate.v1.OpenGateService.UpsertAppSetting:output_type -> opengate.v1.UpsertAppSettingResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	5,  // 5: opengate.v1.OpenGateService.SetRouteWeights:input_type -> opengate.v1.SetRouteWeightsRequest
+	6,  // 6: opengate.v1.OpenGateService.DeleteConfig:input_type -> opengate.v1.DeleteConfigRequest
+	7,  // 7: opengate.v1.OpenGateService.GetRoutes:input_type -> opengate.v1.GetRoutesRequest
+	8,  // 8: opengate.v1.OpenGateService.TestRoute:input_type -> opengate.v1.TestRouteRequest
+	9,  // 9: opengate.v1.OpenGateService.GetStats:input_type -> opengate.v1.GetStatsRequest
+	10, // 10: opengate.v1.OpenGateService.GetAppSettings:input_type -> opengate.v1.GetAppSettingsRequest
+	11, // 11: opengate.v1.OpenGateService.UpsertAppSetting:input_type -> opengate.v1.UpsertAppSettingRequest
+	12, // 12: opengate.v1.OpenGateService.GetHealth:input_type -> opengate.v1.GetHealthRequest
+	13, // 13: opengate.v1.OpenGateService.CreateAPIKey:input_type -> opengate.v1.CreateAPIKeyRequest
+	14, // 14: opengate.v1.OpenGateService.ListAPIKeys:input_type -> opengate.v1.ListAPIKeysRequest
+	15, // 15: opengate.v1.OpenGateService.RotateAPIKey:input_type -> opengate.v1.RotateAPIKeyRequest
+	16, // 16: opengate.v1.OpenGateService.RevokeAPIKey:input_type -> opengate.v1.RevokeAPIKeyRequest
+	17, // 17: opengate.v1.OpenGateService.Ping:output_type -> opengate.v1.PingResponse
+	18, // 18: opengate.v1.OpenGateService.CreateConfig:output_type -> opengate.v1.CreateConfigResponse
+	19, // 19: opengate.v1.OpenGateService.GetConfig:output_type -> opengate.v1.GetConfigResponse
+	20, // 20: opengate.v1.OpenGateService.ListConfigs:output_type -> opengate.v1.ListConfigsResponse
+	21, // 21: opengate.v1.OpenGateService.UpdateConfig:output_type -> opengate.v1.UpdateConfigResponse
+	22, // 22: opengate.v1.OpenGateService.SetRouteWeights:output_type -> opengate.v1.SetRouteWeightsResponse
+	23, // 23: opengate.v1.OpenGateService.DeleteConfig:output_type -> opengate.v1.DeleteConfigResponse
+	24, // 24: opengate.v1.OpenGateService.GetRoutes:output_type -> opengate.v1.GetRoutesResponse
+	25, // 25: opengate.v1.OpenGateService.TestRoute:output_type -> opengate.v1.TestRouteResponse
+	26, // 26: opengate.v1.OpenGateService.GetStats:output_type -> opengate.v1.GetStatsResponse
+	27, // 27: opengate.v1.OpenGateService.GetAppSettings:output_type -> opengate.v1.GetAppSettingsResponse
+	28, // 28: opengate.v1.OpenGateService.UpsertAppSetting:output_type -> opengate.v1.UpsertAppSettingResponse
+	29, // 29: opengate.v1.OpenGateService.GetHealth:output_type -> opengate.v1.GetHealthResponse
+	30, // 30: opengate.v1.OpenGateService.CreateAPIKey:output_type -> opengate.v1.CreateAPIKeyResponse
+	31, // 31: opengate.v1.OpenGateService.ListAPIKeys:output_type -> opengate.v1.ListAPIKeysResponse
+	32, // 32: opengate.v1.OpenGateService.RotateAPIKey:output_type -> opengate.v1.RotateAPIKeyResponse
+	33, // 33: opengate.v1.OpenGateService.RevokeAPIKey:output_type -> opengate.v1.RevokeAPIKeyResponse
+	17, // [17:34] is the sub-list for method output_type
+	0,  // [0:17] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -122,6 +166,8 @@ func file_proto_opengate_v1_opengate_proto_init() {
 	file_proto_opengate_common_ping_proto_init()
 	file_proto_opengate_v1_config_proto_init()
 	file_proto_opengate_v1_app_settings_proto_init()
+	file_proto_opengate_v1_health_proto_init()
+	file_proto_opengate_v1_api_keys_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
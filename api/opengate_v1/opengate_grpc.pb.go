@@ -24,11 +24,18 @@ const (
 	OpenGateService_GetConfig_FullMethodName        = "/opengate.v1.OpenGateService/GetConfig"
 	OpenGateService_ListConfigs_FullMethodName      = "/opengate.v1.OpenGateService/ListConfigs"
 	OpenGateService_UpdateConfig_FullMethodName     = "/opengate.v1.OpenGateService/UpdateConfig"
+	OpenGateService_SetRouteWeights_FullMethodName  = "/opengate.v1.OpenGateService/SetRouteWeights"
 	OpenGateService_DeleteConfig_FullMethodName     = "/opengate.v1.OpenGateService/DeleteConfig"
 	OpenGateService_GetRoutes_FullMethodName        = "/opengate.v1.OpenGateService/GetRoutes"
+	OpenGateService_TestRoute_FullMethodName        = "/opengate.v1.OpenGateService/TestRoute"
 	OpenGateService_GetStats_FullMethodName         = "/opengate.v1.OpenGateService/GetStats"
 	OpenGateService_GetAppSettings_FullMethodName   = "/opengate.v1.OpenGateService/GetAppSettings"
 	OpenGateService_UpsertAppSetting_FullMethodName = "/opengate.v1.OpenGateService/UpsertAppSetting"
+	OpenGateService_GetHealth_FullMethodName        = "/opengate.v1.OpenGateService/GetHealth"
+	OpenGateService_CreateAPIKey_FullMethodName     = "/opengate.v1.OpenGateService/CreateAPIKey"
+	OpenGateService_ListAPIKeys_FullMethodName      = "/opengate.v1.OpenGateService/ListAPIKeys"
+	OpenGateService_RotateAPIKey_FullMethodName     = "/opengate.v1.OpenGateService/RotateAPIKey"
+	OpenGateService_RevokeAPIKey_FullMethodName     = "/opengate.v1.OpenGateService/RevokeAPIKey"
 )
 
 // OpenGateServiceClient is the client API for OpenGateService service.
@@ -45,16 +52,30 @@ type OpenGateServiceClient interface {
 	ListConfigs(ctx context.Context, in *ListConfigsRequest, opts ...grpc.CallOption) (*ListConfigsResponse, error)
 	// UpdateConfig updates an existing config
 	UpdateConfig(ctx context.Context, in *UpdateConfigRequest, opts ...grpc.CallOption) (*UpdateConfigResponse, error)
+	// SetRouteWeights shifts the traffic of a split route between its versions
+	SetRouteWeights(ctx context.Context, in *SetRouteWeightsRequest, opts ...grpc.CallOption) (*SetRouteWeightsResponse, error)
 	// DeleteConfig deletes a config by ID
 	DeleteConfig(ctx context.Context, in *DeleteConfigRequest, opts ...grpc.CallOption) (*DeleteConfigResponse, error)
 	// GetRoutes retrieves all routes for routing purposes
 	GetRoutes(ctx context.Context, in *GetRoutesRequest, opts ...grpc.CallOption) (*GetRoutesResponse, error)
+	// TestRoute runs a request through the routing table without proxying it
+	TestRoute(ctx context.Context, in *TestRouteRequest, opts ...grpc.CallOption) (*TestRouteResponse, error)
 	// GetStats retrieves dashboard statistics
 	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
 	// GetAppSettings retrieves all application settings
 	GetAppSettings(ctx context.Context, in *GetAppSettingsRequest, opts ...grpc.CallOption) (*GetAppSettingsResponse, error)
 	// UpsertAppSetting creates or updates a single application setting
 	UpsertAppSetting(ctx context.Context, in *UpsertAppSettingRequest, opts ...grpc.CallOption) (*UpsertAppSettingResponse, error)
+	// GetHealth retrieves the health of the upstream targets of every route
+	GetHealth(ctx context.Context, in *GetHealthRequest, opts ...grpc.CallOption) (*GetHealthResponse, error)
+	// CreateAPIKey creates an API key for a machine client
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys lists the API keys
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// RotateAPIKey replaces the key of an API key
+	RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*RotateAPIKeyResponse, error)
+	// RevokeAPIKey revokes an API key
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
 }
 
 type openGateServiceClient struct {
@@ -115,6 +136,16 @@ func (c *openGateServiceClient) UpdateConfig(ctx context.Context, in *UpdateConf
 	return out, nil
 }
 
+func (c *openGateServiceClient) SetRouteWeights(ctx context.Context, in *SetRouteWeightsRequest, opts ...grpc.CallOption) (*SetRouteWeightsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRouteWeightsResponse)
+	err := c.cc.Invoke(ctx, OpenGateService_SetRouteWeights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *openGateServiceClient) DeleteConfig(ctx context.Context, in *DeleteConfigRequest, opts ...grpc.CallOption) (*DeleteConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteConfigResponse)
@@ -135,6 +166,16 @@ func (c *openGateServiceClient) GetRoutes(ctx context.Context, in *GetRoutesRequ
 	return out, nil
 }
 
+func (c *openGateServiceClient) TestRoute(ctx context.Context, in *TestRouteRequest, opts ...grpc.CallOption) (*TestRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestRouteResponse)
+	err := c.cc.Invoke(ctx, OpenGateService_TestRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *openGateServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStatsResponse)
@@ -165,6 +206,56 @@ func (c *openGateServiceClient) UpsertAppSetting(ctx context.Context, in *Upsert
 	return out, nil
 }
 
+func (c *openGateServiceClient) GetHealth(ctx context.Context, in *GetHealthRequest, opts ...grpc.CallOption) (*GetHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHealthResponse)
+	err := c.cc.Invoke(ctx, OpenGateService_GetHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openGateServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, OpenGateService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openGateServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, OpenGateService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openGateServiceClient) RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*RotateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, OpenGateService_RotateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *openGateServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, OpenGateService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OpenGateServiceServer is the server API for OpenGateService service.
 // All implementations must embed UnimplementedOpenGateServiceServer
 // for forward compatibility.
@@ -179,16 +270,30 @@ type OpenGateServiceServer interface {
 	ListConfigs(context.Context, *ListConfigsRequest) (*ListConfigsResponse, error)
 	// UpdateConfig updates an existing config
 	UpdateConfig(context.Context, *UpdateConfigRequest) (*UpdateConfigResponse, error)
+	// SetRouteWeights shifts the traffic of a split route between its versions
+	SetRouteWeights(context.Context, *SetRouteWeightsRequest) (*SetRouteWeightsResponse, error)
 	// DeleteConfig deletes a config by ID
 	DeleteConfig(context.Context, *DeleteConfigRequest) (*DeleteConfigResponse, error)
 	// GetRoutes retrieves all routes for routing purposes
 	GetRoutes(context.Context, *GetRoutesRequest) (*GetRoutesResponse, error)
+	// TestRoute runs a request through the routing table without proxying it
+	TestRoute(context.Context, *TestRouteRequest) (*TestRouteResponse, error)
 	// GetStats retrieves dashboard statistics
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
 	// GetAppSettings retrieves all application settings
 	GetAppSettings(context.Context, *GetAppSettingsRequest) (*GetAppSettingsResponse, error)
 	// UpsertAppSetting creates or updates a single application setting
 	UpsertAppSetting(context.Context, *UpsertAppSettingRequest) (*UpsertAppSettingResponse, error)
+	// GetHealth retrieves the health of the upstream targets of every route
+	GetHealth(context.Context, *GetHealthRequest) (*GetHealthResponse, error)
+	// CreateAPIKey creates an API key for a machine client
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// ListAPIKeys lists the API keys
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// RotateAPIKey replaces the key of an API key
+	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error)
+	// RevokeAPIKey revokes an API key
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
 	mustEmbedUnimplementedOpenGateServiceServer()
 }
 
@@ -214,12 +319,18 @@ func (UnimplementedOpenGateServiceServer) ListConfigs(context.Context, *ListConf
 func (UnimplementedOpenGateServiceServer) UpdateConfig(context.Context, *UpdateConfigRequest) (*UpdateConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfig not implemented")
 }
+func (UnimplementedOpenGateServiceServer) SetRouteWeights(context.Context, *SetRouteWeightsRequest) (*SetRouteWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRouteWeights not implemented")
+}
 func (UnimplementedOpenGateServiceServer) DeleteConfig(context.Context, *DeleteConfigRequest) (*DeleteConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfig not implemented")
 }
 func (UnimplementedOpenGateServiceServer) GetRoutes(context.Context, *GetRoutesRequest) (*GetRoutesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoutes not implemented")
 }
+func (UnimplementedOpenGateServiceServer) TestRoute(context.Context, *TestRouteRequest) (*TestRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestRoute not implemented")
+}
 func (UnimplementedOpenGateServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
 }
@@ -229,6 +340,21 @@ func (UnimplementedOpenGateServiceServer) GetAppSettings(context.Context, *GetAp
 func (UnimplementedOpenGateServiceServer) UpsertAppSetting(context.Context, *UpsertAppSettingRequest) (*UpsertAppSettingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertAppSetting not implemented")
 }
+func (UnimplementedOpenGateServiceServer) GetHealth(context.Context, *GetHealthRequest) (*GetHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
+}
+func (UnimplementedOpenGateServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedOpenGateServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedOpenGateServiceServer) RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAPIKey not implemented")
+}
+func (UnimplementedOpenGateServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
 func (UnimplementedOpenGateServiceServer) mustEmbedUnimplementedOpenGateServiceServer() {}
 func (UnimplementedOpenGateServiceServer) testEmbeddedByValue()                         {}
 
@@ -340,6 +466,24 @@ func _OpenGateService_UpdateConfig_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenGateService_SetRouteWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRouteWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenGateServiceServer).SetRouteWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenGateService_SetRouteWeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenGateServiceServer).SetRouteWeights(ctx, req.(*SetRouteWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OpenGateService_DeleteConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteConfigRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +520,24 @@ func _OpenGateService_GetRoutes_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenGateService_TestRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenGateServiceServer).TestRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenGateService_TestRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenGateServiceServer).TestRoute(ctx, req.(*TestRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OpenGateService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStatsRequest)
 	if err := dec(in); err != nil {
@@ -430,6 +592,96 @@ func _OpenGateService_UpsertAppSetting_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OpenGateService_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenGateServiceServer).GetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenGateService_GetHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenGateServiceServer).GetHealth(ctx, req.(*GetHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenGateService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenGateServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenGateService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenGateServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenGateService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenGateServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenGateService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenGateServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenGateService_RotateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenGateServiceServer).RotateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenGateService_RotateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenGateServiceServer).RotateAPIKey(ctx, req.(*RotateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpenGateService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpenGateServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpenGateService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpenGateServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OpenGateService_ServiceDesc is the grpc.ServiceDesc for OpenGateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +709,10 @@ var OpenGateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateConfig",
 			Handler:    _OpenGateService_UpdateConfig_Handler,
 		},
+		{
+			MethodName: "SetRouteWeights",
+			Handler:    _OpenGateService_SetRouteWeights_Handler,
+		},
 		{
 			MethodName: "DeleteConfig",
 			Handler:    _OpenGateService_DeleteConfig_Handler,
@@ -465,6 +721,10 @@ var OpenGateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoutes",
 			Handler:    _OpenGateService_GetRoutes_Handler,
 		},
+		{
+			MethodName: "TestRoute",
+			Handler:    _OpenGateService_TestRoute_Handler,
+		},
 		{
 			MethodName: "GetStats",
 			Handler:    _OpenGateService_GetStats_Handler,
@@ -477,6 +737,26 @@ var OpenGateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertAppSetting",
 			Handler:    _OpenGateService_UpsertAppSetting_Handler,
 		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _OpenGateService_GetHealth_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _OpenGateService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _OpenGateService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RotateAPIKey",
+			Handler:    _OpenGateService_RotateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _OpenGateService_RevokeAPIKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/opengate/v1/opengate.proto",
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gofreego/opengate/internal/configs"
 	"github.com/gofreego/opengate/internal/service"
+	tlsmanager "github.com/gofreego/opengate/internal/tls_manager"
 	"github.com/gofreego/opengate/pkg/utils"
 
 	"github.com/gin-gonic/gin"
@@ -15,9 +16,10 @@ import (
 )
 
 type GatewayServer struct {
-	cfg     *configs.Server
-	server  *http.Server
-	service *service.Service
+	cfg      *configs.Server
+	server   *http.Server
+	redirect *http.Server
+	service  *service.Service
 }
 
 func (g *GatewayServer) Name() string {
@@ -25,6 +27,9 @@ func (g *GatewayServer) Name() string {
 }
 
 func (g *GatewayServer) Shutdown(ctx context.Context) {
+	if g.redirect != nil {
+		g.redirect.Shutdown(ctx)
+	}
 	if g.server == nil {
 		return
 	}
@@ -57,6 +62,11 @@ func (g *GatewayServer) Run(ctx context.Context) error {
 	// Apply CORS middleware using dynamic config from settings store
 	handler := utils.CorsMiddleware(ginRouter, g.service.GetCORSConfig)
 
+	tlsManager, err := tlsmanager.New(ctx, &g.cfg.TLS)
+	if err != nil {
+		logger.Panic(ctx, "invalid gateway TLS config : %v", err)
+	}
+
 	g.server = &http.Server{
 		Addr:           fmt.Sprintf(":%d", g.cfg.GatewayPort),
 		Handler:        logger.WithRequestMiddleware(logger.WithRequestTimeMiddleware(handler)),
@@ -66,10 +76,19 @@ func (g *GatewayServer) Run(ctx context.Context) error {
 		MaxHeaderBytes: g.cfg.MaxHeaderBytes,
 	}
 
-	logger.Info(ctx, "Started Gateway server on port %d", g.cfg.GatewayPort)
-
-	// Start HTTP server
-	err := g.server.ListenAndServe()
+	// Start HTTPS server when TLS is configured, the certificates are in TLSConfig
+	if tlsManager != nil {
+		g.server.TLSConfig = tlsManager.TLSConfig()
+		if g.cfg.TLS.RedirectPort != 0 {
+			g.redirect = tlsmanager.NewRedirectServer(g.cfg.TLS.RedirectPort, g.cfg.GatewayPort)
+			go tlsmanager.RunRedirect(ctx, g.redirect)
+		}
+		logger.Info(ctx, "Started Gateway server on port %d with TLS", g.cfg.GatewayPort)
+		err = g.server.ListenAndServeTLS("", "")
+	} else {
+		logger.Info(ctx, "Started Gateway server on port %d", g.cfg.GatewayPort)
+		err = g.server.ListenAndServe()
+	}
 	if err != nil && err != http.ErrServerClosed {
 		logger.Panic(ctx, "failed to start gateway server : %v", err)
 	}
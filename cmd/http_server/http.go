@@ -9,6 +9,7 @@ import (
 	"github.com/gofreego/opengate/api/opengate_v1"
 	"github.com/gofreego/opengate/internal/configs"
 	"github.com/gofreego/opengate/internal/service"
+	tlsmanager "github.com/gofreego/opengate/internal/tls_manager"
 	"github.com/gofreego/opengate/pkg/utils"
 
 	"github.com/gofreego/goutils/logger"
@@ -18,6 +19,7 @@ import (
 type HTTPServer struct {
 	cfg       *configs.Server
 	server    *http.Server
+	redirect  *http.Server
 	service   *service.Service
 	env       string
 	uiHandler http.Handler
@@ -28,6 +30,9 @@ func (a *HTTPServer) Name() string {
 }
 
 func (a *HTTPServer) Shutdown(ctx context.Context) {
+	if a.redirect != nil {
+		a.redirect.Shutdown(ctx)
+	}
 	if a.server == nil {
 		return
 	}
@@ -76,6 +81,12 @@ func (a *HTTPServer) Run(ctx context.Context) error {
 	finalHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 
+		// Publish the keys upstreams verify the gateway's internal tokens with
+		if path == service.JWKSPath {
+			a.service.ServeJWKS(w, r)
+			return
+		}
+
 		// Direct API requests to grpc-gateway mux
 		if strings.HasPrefix(path, "/opengate/v1/") {
 			grpcMux.ServeHTTP(w, r)
@@ -101,16 +112,35 @@ func (a *HTTPServer) Run(ctx context.Context) error {
 		MaxHeaderBytes: a.cfg.MaxHeaderBytes,
 	}
 
+	tlsManager, err := tlsmanager.New(ctx, &a.cfg.AdminTLS)
+	if err != nil {
+		logger.Panic(ctx, "invalid admin TLS config : %v", err)
+	}
+	scheme := "http"
+	if tlsManager != nil {
+		scheme = "https"
+		a.server.TLSConfig = tlsManager.TLSConfig()
+		if a.cfg.AdminTLS.RedirectPort != 0 {
+			a.redirect = tlsmanager.NewRedirectServer(a.cfg.AdminTLS.RedirectPort, a.cfg.AdminPort)
+			go tlsmanager.RunRedirect(ctx, a.redirect)
+		}
+	}
+
 	if a.cfg.Debug.Enabled {
-		logger.Info(ctx, "Debug dashboard available at `http://localhost:%d/opengate/v1/debug`", a.cfg.AdminPort)
+		logger.Info(ctx, "Debug dashboard available at `%s://localhost:%d/opengate/v1/debug`", scheme, a.cfg.AdminPort)
 	}
 	logger.Info(ctx, "Started Admin HTTP server on port %d", a.cfg.AdminPort)
-	logger.Info(ctx, "Admin UI available at `http://localhost:%d/gateway/`", a.cfg.AdminPort)
-	logger.Info(ctx, "API endpoints available at `http://localhost:%d/opengate/v1/`", a.cfg.AdminPort)
-	logger.Info(ctx, "Swagger UI available at `http://localhost:%d/opengate/v1/swagger`", a.cfg.AdminPort)
-
-	// Start HTTP server
-	err = a.server.ListenAndServe()
+	logger.Info(ctx, "Admin UI available at `%s://localhost:%d/gateway/`", scheme, a.cfg.AdminPort)
+	logger.Info(ctx, "API endpoints available at `%s://localhost:%d/opengate/v1/`", scheme, a.cfg.AdminPort)
+	logger.Info(ctx, "Swagger UI available at `%s://localhost:%d/opengate/v1/swagger`", scheme, a.cfg.AdminPort)
+	logger.Info(ctx, "Internal token JWKS available at `%s://localhost:%d%s`", scheme, a.cfg.AdminPort, service.JWKSPath)
+
+	// Start HTTPS server when TLS is configured, the certificates are in TLSConfig
+	if tlsManager != nil {
+		err = a.server.ListenAndServeTLS("", "")
+	} else {
+		err = a.server.ListenAndServe()
+	}
 	if err != nil && err != http.ErrServerClosed {
 		logger.Panic(ctx, "failed to start http server : %v", err)
 	}
@@ -7,6 +7,7 @@ import (
 
 	repo "github.com/gofreego/opengate/internal/repository"
 	"github.com/gofreego/opengate/internal/service"
+	tlsmanager "github.com/gofreego/opengate/internal/tls_manager"
 
 	"github.com/gofreego/goutils/api/debug"
 	"github.com/gofreego/goutils/cache"
@@ -40,13 +41,15 @@ func LoadConfig(ctx context.Context, path string, env string) *Configuration {
 
 // Config represents admin server settings
 type Server struct {
-	AdminPort      int           `json:"adminPort" yaml:"AdminPort"`
-	GatewayPort    int           `json:"gatewayPort" yaml:"GatewayPort"`
-	GinMode        string        `json:"ginMode" yaml:"GinMode"`
-	ReadTimeout    time.Duration `json:"readTimeout" yaml:"ReadTimeout"`
-	WriteTimeout   time.Duration `json:"writeTimeout" yaml:"WriteTimeout"`
-	IdleTimeout    time.Duration `json:"idleTimeout" yaml:"IdleTimeout"`
-	MaxHeaderBytes int           `json:"maxHeaderBytes" yaml:"MaxHeaderBytes"`
-	EnableCORS     bool          `json:"enableCors" yaml:"EnableCors"`
-	Debug          debug.Config  `json:"debug" yaml:"Debug"`
+	AdminPort      int               `json:"adminPort" yaml:"AdminPort"`
+	GatewayPort    int               `json:"gatewayPort" yaml:"GatewayPort"`
+	GinMode        string            `json:"ginMode" yaml:"GinMode"`
+	ReadTimeout    time.Duration     `json:"readTimeout" yaml:"ReadTimeout"`
+	WriteTimeout   time.Duration     `json:"writeTimeout" yaml:"WriteTimeout"`
+	IdleTimeout    time.Duration     `json:"idleTimeout" yaml:"IdleTimeout"`
+	MaxHeaderBytes int               `json:"maxHeaderBytes" yaml:"MaxHeaderBytes"`
+	EnableCORS     bool              `json:"enableCors" yaml:"EnableCors"`
+	Debug          debug.Config      `json:"debug" yaml:"Debug"`
+	TLS            tlsmanager.Config `json:"tls" yaml:"TLS"`           // TLS of the gateway listener
+	AdminTLS       tlsmanager.Config `json:"adminTls" yaml:"AdminTLS"` // TLS of the admin listener
 }
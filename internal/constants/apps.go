@@ -4,6 +4,17 @@ const (
 	HTTP_SERVER = "HTTP_SERVER"
 	GRPC_SERVER = "GRPC_SERVER"
 	JWT_CLAIMS  = "jwt_claims"
+	// TOKEN_CLAIMS are all the claims of the request's token as a map[string]any
+	TOKEN_CLAIMS = "token_claims"
+	PATH_PARAMS  = "path_params"
+	// UPSTREAM_HOST is the Host header a route rewrites its requests to
+	UPSTREAM_HOST = "upstream_host"
+	// UPSTREAM_HEADERS are the headers, an http.Header, authentication adds to the request sent upstream
+	UPSTREAM_HEADERS = "upstream_headers"
+	// UPSTREAM_URL is the URL a request was last proxied to
+	UPSTREAM_URL = "upstream_url"
+	// API_KEY_ID is the id, an int64, of the API key a request authenticated with
+	API_KEY_ID = "api_key_id"
 
 	COOKIE_AUTHORIZATION = "authorization"
 )
@@ -13,3 +24,9 @@ const (
 	PERMISSION_ROUTES_READ  = "routes:read"
 	PERMISSION_ROUTES_WRITE = "routes:write"
 )
+
+// API key permissions
+const (
+	PERMISSION_API_KEYS_READ  = "api_keys:read"
+	PERMISSION_API_KEYS_WRITE = "api_keys:write"
+)
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrAPIKeyNotFound is returned when no API key has the requested id or hash
+var ErrAPIKeyNotFound = errors.New("api key not found")
+
+// APIKey is a key machine clients authenticate with. Only the SHA-256 hash of the key is stored.
+type APIKey struct {
+	ID        int64      `json:"id" yaml:"ID"`
+	Name      string     `json:"name" yaml:"Name"`
+	Prefix    string     `json:"prefix" yaml:"Prefix"`   // first characters of the key, to tell keys apart
+	KeyHash   string     `json:"keyHash" yaml:"KeyHash"` // hex encoded SHA-256 of the key
+	Owner     string     `json:"owner" yaml:"Owner"`
+	Scopes    []string   `json:"scopes" yaml:"Scopes"`
+	ExpiresAt *time.Time `json:"expiresAt" yaml:"ExpiresAt"` // never expires when nil
+	RevokedAt *time.Time `json:"revokedAt" yaml:"RevokedAt"`
+	CreatedAt time.Time  `json:"createdAt" yaml:"CreatedAt"`
+	UpdatedAt time.Time  `json:"updatedAt" yaml:"UpdatedAt"`
+}
+
+// Active reports whether the key can be used at the time
+func (k *APIKey) Active(now time.Time) bool {
+	if k.RevokedAt != nil && !k.RevokedAt.After(now) {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
@@ -4,29 +4,57 @@ import "time"
 
 // Config represents a route configuration stored in the database
 type Config struct {
-	ID             int64           `json:"id"`
-	Name           string          `json:"name"`
-	PathPrefix     string          `json:"pathPrefix"`
-	TargetURL      string          `json:"targetURL"`
-	StripPrefix    bool            `json:"stripPrefix"`
-	Authentication *Authentication `json:"authentication"`
-	Middleware     []string        `json:"middleware"`
-	Timeout        time.Duration   `json:"timeout"`
-	CreatedAt      time.Time       `json:"createdAt"`
-	UpdatedAt      time.Time       `json:"updatedAt"`
+	ID               int64                     `json:"id"`
+	Name             string                    `json:"name"`
+	PathPrefix       string                    `json:"pathPrefix"`
+	Hosts            []string                  `json:"hosts"`
+	Match            *RouteMatch               `json:"match"`
+	Priority         int                       `json:"priority"`
+	TargetURL        string                    `json:"targetURL"`
+	Targets          []Target                  `json:"targets"`
+	Split            *TrafficSplit             `json:"split"`
+	Mirror           *Mirror                   `json:"mirror"`
+	Identity         *Identity                 `json:"identity"`
+	RateLimit        *RateLimit                `json:"rateLimit"`
+	LoadBalancer     *LoadBalancer             `json:"loadBalancer"`
+	HealthCheck      *HealthCheck              `json:"healthCheck"`
+	CircuitBreaker   *CircuitBreaker           `json:"circuitBreaker"`
+	RetryPolicy      *RetryPolicy              `json:"retryPolicy"`
+	StripPrefix      bool                      `json:"stripPrefix"`
+	Rewrite          *Rewrite                  `json:"rewrite"`
+	Authentication   *Authentication           `json:"authentication"`
+	Middleware       []string                  `json:"middleware"`
+	MiddlewareConfig map[string]map[string]any `json:"middlewareConfig"`
+	Timeout          time.Duration             `json:"timeout"`
+	CreatedAt        time.Time                 `json:"createdAt"`
+	UpdatedAt        time.Time                 `json:"updatedAt"`
 }
 
 // ToServiceRoute converts a Config to a ServiceRoute
 func (c *Config) ToServiceRoute() *ServiceRoute {
 	return &ServiceRoute{
-		Name:           c.Name,
-		PathPrefix:     c.PathPrefix,
-		TargetURL:      c.TargetURL,
-		StripPrefix:    c.StripPrefix,
-		Authentication: c.Authentication,
-		Middleware:     c.Middleware,
-		Timeout:        c.Timeout,
-		UpdatedAt:      c.UpdatedAt.UnixMilli(),
+		Name:             c.Name,
+		PathPrefix:       c.PathPrefix,
+		Hosts:            c.Hosts,
+		Match:            c.Match,
+		Priority:         c.Priority,
+		TargetURL:        c.TargetURL,
+		Targets:          c.Targets,
+		Split:            c.Split,
+		Mirror:           c.Mirror,
+		Identity:         c.Identity,
+		RateLimit:        c.RateLimit,
+		LoadBalancer:     c.LoadBalancer,
+		HealthCheck:      c.HealthCheck,
+		CircuitBreaker:   c.CircuitBreaker,
+		RetryPolicy:      c.RetryPolicy,
+		StripPrefix:      c.StripPrefix,
+		Rewrite:          c.Rewrite,
+		Authentication:   c.Authentication,
+		Middleware:       c.Middleware,
+		MiddlewareConfig: c.MiddlewareConfig,
+		Timeout:          c.Timeout,
+		UpdatedAt:        c.UpdatedAt.UnixMilli(),
 	}
 }
 
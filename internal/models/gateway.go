@@ -9,48 +9,254 @@ import (
 type ServiceRoute struct {
 	Name           string          `json:"name" yaml:"Name"`
 	PathPrefix     string          `json:"pathPrefix" yaml:"PathPrefix"`
+	Hosts          []string        `json:"hosts" yaml:"Hosts"`       // exact or wildcard (*.example.com) hosts, any host when empty
+	Match          *RouteMatch     `json:"match" yaml:"Match"`       // conditions a request must meet besides the path prefix
+	Priority       int             `json:"priority" yaml:"Priority"` // higher priority routes are tried first among routes of the same prefix
 	TargetURL      string          `json:"targetURL" yaml:"TargetURL"`
+	Targets        []Target        `json:"targets" yaml:"Targets"` // takes precedence over TargetURL when set
+	Split          *TrafficSplit   `json:"split" yaml:"Split"`     // weighted versions of the backend, takes precedence over Targets
+	Mirror         *Mirror         `json:"mirror" yaml:"Mirror"`   // secondary upstream receiving a copy of the requests
+	LoadBalancer   *LoadBalancer   `json:"loadBalancer" yaml:"LoadBalancer"`
+	HealthCheck    *HealthCheck    `json:"healthCheck" yaml:"HealthCheck"`
+	CircuitBreaker *CircuitBreaker `json:"circuitBreaker" yaml:"CircuitBreaker"`
+	RetryPolicy    *RetryPolicy    `json:"retryPolicy" yaml:"RetryPolicy"`
 	StripPrefix    bool            `json:"stripPrefix" yaml:"StripPrefix"`
+	Rewrite        *Rewrite        `json:"rewrite" yaml:"Rewrite"` // path and host rewrite, can't be combined with StripPrefix
 	Authentication *Authentication `json:"authentication" yaml:"Authentication"`
-	Middleware     []string        `json:"middleware" yaml:"Middleware"`
-	Timeout        time.Duration   `json:"timeout" yaml:"Timeout"`
-	UpdatedAt      int64           `json:"-" yaml:"-"` // Unix timestamp of last update
+	Identity       *Identity       `json:"identity" yaml:"Identity"`     // how the client's identity is sent upstream
+	RateLimit      *RateLimit      `json:"rateLimit" yaml:"RateLimit"`   // requests a client may send, answered with 429 over it
+	Middleware     []string        `json:"middleware" yaml:"Middleware"` // names of the middlewares run around the proxy, in order
+	// MiddlewareConfig is the config of the middlewares by name, middlewares without an entry use their defaults
+	MiddlewareConfig map[string]map[string]any `json:"middlewareConfig" yaml:"MiddlewareConfig"`
+	Timeout          time.Duration             `json:"timeout" yaml:"Timeout"`
+	UpdatedAt        int64                     `json:"-" yaml:"-"` // Unix timestamp of last update
+}
+
+// Target is an upstream instance a route can forward requests to
+type Target struct {
+	URL    string `json:"url" yaml:"URL"`
+	Weight int    `json:"weight" yaml:"Weight"` // relative weight, defaults to 1
+}
+
+// TrafficSplit splits the requests of a route between weighted versions of its backend, such as a
+// stable release and a canary. The route's load balancer spreads each version's share across its targets.
+type TrafficSplit struct {
+	Versions []RouteVersion `json:"versions" yaml:"Versions"`
+	// header, cookie or client_ip whose value pins a client to a version, versions are picked at random by weight when empty
+	StickyOn string `json:"stickyOn" yaml:"StickyOn"`
+	// header or cookie name to hash on
+	StickyKey string `json:"stickyKey" yaml:"StickyKey"`
+}
+
+// RouteVersion is a version of a route's backend receiving a weighted share of its requests
+type RouteVersion struct {
+	Name    string   `json:"name" yaml:"Name"`
+	Weight  int      `json:"weight" yaml:"Weight"` // share of the requests relative to the other versions, 0 drains the version
+	Targets []Target `json:"targets" yaml:"Targets"`
+}
+
+// Mirror replays a sample of the requests of a route to a secondary upstream and discards its responses.
+// Zero values fall back to the mirror defaults.
+type Mirror struct {
+	TargetURL    string        `json:"targetURL" yaml:"TargetURL"`
+	Percentage   float64       `json:"percentage" yaml:"Percentage"`     // share of the requests mirrored, default 100
+	MaxBodyBytes int64         `json:"maxBodyBytes" yaml:"MaxBodyBytes"` // requests with larger bodies are not mirrored, default 64KB
+	Timeout      time.Duration `json:"timeout" yaml:"Timeout"`           // how long to wait for the mirror, default 5s
+}
+
+// Identity defines how the identity of a route's clients is sent upstream. Routes without it send the
+// user id, UUID, profile ids and permissions headers and strip the Authorization header.
+type Identity struct {
+	ClaimHeaders       []ClaimHeader     `json:"claimHeaders" yaml:"ClaimHeaders"`             // claims sent as headers
+	OmitDefaultHeaders bool              `json:"omitDefaultHeaders" yaml:"OmitDefaultHeaders"` // don't send the user id, UUID, profile ids and permissions headers
+	ForwardToken       bool              `json:"forwardToken" yaml:"ForwardToken"`             // send the client's Authorization header instead of stripping it
+	StaticHeaders      map[string]string `json:"staticHeaders" yaml:"StaticHeaders"`           // headers set on every request of the route
+	InternalToken      bool              `json:"internalToken" yaml:"InternalToken"`           // send a JWT minted by the gateway holding the verified claims
+}
+
+// RateLimit limits the requests the clients of a route send, each client being counted by its Key.
+// Zero values fall back to the rate limit defaults.
+type RateLimit struct {
+	Algorithm string        `json:"algorithm" yaml:"Algorithm"` // token_bucket (default) or sliding_window
+	Requests  int64         `json:"requests" yaml:"Requests"`   // requests allowed per window
+	Window    time.Duration `json:"window" yaml:"Window"`       // default 1s
+	Burst     int64         `json:"burst" yaml:"Burst"`         // token_bucket only, requests allowed at once, default Requests
+	Key       string        `json:"key" yaml:"Key"`             // client_ip (default), user_id, api_key or header
+	Header    string        `json:"header" yaml:"Header"`       // header the key is read from with the header key
+}
+
+// ClaimHeader sends a claim of the client upstream as a header
+type ClaimHeader struct {
+	Claim  string `json:"claim" yaml:"Claim"` // nested claims are joined by dots
+	Header string `json:"header" yaml:"Header"`
+}
+
+// LoadBalancer defines how requests are spread across a route's targets
+type LoadBalancer struct {
+	// round_robin (default), weighted_round_robin, least_connections, random_two_choices or consistent_hash
+	Policy string `json:"policy" yaml:"Policy"`
+	// consistent_hash only: header, cookie or client_ip
+	HashOn string `json:"hashOn" yaml:"HashOn"`
+	// header or cookie name to hash on
+	HashKey string `json:"hashKey" yaml:"HashKey"`
+}
+
+// HealthCheck defines the active health check probing each target of a route.
+// Zero values fall back to the health checker defaults.
+type HealthCheck struct {
+	Path               string        `json:"path" yaml:"Path"` // HTTP path probed with GET on every target
+	Interval           time.Duration `json:"interval" yaml:"Interval"`
+	Timeout            time.Duration `json:"timeout" yaml:"Timeout"`
+	HealthyThreshold   int           `json:"healthyThreshold" yaml:"HealthyThreshold"`     // consecutive successes to mark a target healthy
+	UnhealthyThreshold int           `json:"unhealthyThreshold" yaml:"UnhealthyThreshold"` // consecutive failures to mark a target unhealthy
+	ExpectedStatus     int           `json:"expectedStatus" yaml:"ExpectedStatus"`         // 0 accepts any 2xx
+}
+
+// CircuitBreaker defines the passive outlier detection of the targets of a route.
+// Each target gets its own breaker driven by the responses of proxied requests.
+type CircuitBreaker struct {
+	ConsecutiveFailures int           `json:"consecutiveFailures" yaml:"ConsecutiveFailures"` // consecutive failures that open the breaker, 0 disables
+	ErrorRateThreshold  int           `json:"errorRateThreshold" yaml:"ErrorRateThreshold"`   // failure percentage within Window that opens the breaker, 0 disables
+	MinRequests         int           `json:"minRequests" yaml:"MinRequests"`                 // requests within Window before the error rate is evaluated
+	Window              time.Duration `json:"window" yaml:"Window"`
+	EjectionDuration    time.Duration `json:"ejectionDuration" yaml:"EjectionDuration"` // how long the breaker stays open
+	HalfOpenRequests    int           `json:"halfOpenRequests" yaml:"HalfOpenRequests"` // successful probes needed to close again
+}
+
+// RouteMatch holds the conditions a request must meet, besides the path prefix, to be sent to a route.
+// All conditions must hold.
+type RouteMatch struct {
+	Methods     []string          `json:"methods" yaml:"Methods"` // any method when empty
+	Headers     []HeaderMatch     `json:"headers" yaml:"Headers"`
+	QueryParams []QueryParamMatch `json:"queryParams" yaml:"QueryParams"`
+}
+
+// HeaderMatch matches a request header by exact value, regular expression or presence
+type HeaderMatch struct {
+	Name    string `json:"name" yaml:"Name"`
+	Exact   string `json:"exact" yaml:"Exact"`
+	Regex   string `json:"regex" yaml:"Regex"` // matched against the whole value
+	Present bool   `json:"present" yaml:"Present"`
+}
+
+// QueryParamMatch matches a query parameter by exact value or presence
+type QueryParamMatch struct {
+	Name    string `json:"name" yaml:"Name"`
+	Exact   string `json:"exact" yaml:"Exact"`
+	Present bool   `json:"present" yaml:"Present"`
+}
+
+// Rewrite defines how the path and host of a route's requests are rewritten before they are proxied.
+// At most one of Prefix, Regex or Path may be set.
+type Rewrite struct {
+	Prefix      string `json:"prefix" yaml:"Prefix"`           // replaces the part of the path matched by the route's pattern, may reference {params}
+	Regex       string `json:"regex" yaml:"Regex"`             // rewrites the path matching the regex with Replacement
+	Replacement string `json:"replacement" yaml:"Replacement"` // may reference the regex capture groups as $1 or ${name}
+	Path        string `json:"path" yaml:"Path"`               // replaces the whole path, may reference {params}
+	Host        string `json:"host" yaml:"Host"`               // Host header sent upstream, may reference {params}
+}
+
+// RetryPolicy defines when failed upstream attempts of a route are retried.
+// Zero values fall back to the retry policy defaults.
+type RetryPolicy struct {
+	Attempts          int           `json:"attempts" yaml:"Attempts"`                   // total attempts including the first one
+	RetryOn           []string      `json:"retryOn" yaml:"RetryOn"`                     // conditions such as connect-failure, reset, timeout, 5xx, gateway-error or status codes like "503"
+	PerTryTimeout     time.Duration `json:"perTryTimeout" yaml:"PerTryTimeout"`         // how long each attempt may wait for the response headers
+	BackoffBase       time.Duration `json:"backoffBase" yaml:"BackoffBase"`             // backoff before the first retry, doubled for every further one
+	BackoffMax        time.Duration `json:"backoffMax" yaml:"BackoffMax"`               // upper bound of the backoff
+	Budget            int           `json:"budget" yaml:"Budget"`                       // retries allowed as a percentage of the route's requests
+	MaxBodyBytes      int64         `json:"maxBodyBytes" yaml:"MaxBodyBytes"`           // larger request bodies are not buffered and not retried
+	IdempotentMethods []string      `json:"idempotentMethods" yaml:"IdempotentMethods"` // methods such as POST to retry besides the idempotent ones
+}
+
+// GetTargets returns the upstream targets of the route.
+// Routes without Targets fall back to a single target built from TargetURL.
+func (route *ServiceRoute) GetTargets() []Target {
+	if len(route.Targets) > 0 {
+		return route.Targets
+	}
+	if route.TargetURL == "" {
+		return nil
+	}
+	return []Target{{URL: route.TargetURL, Weight: 1}}
 }
 
 type Authentication struct {
 	Required bool `json:"required" yaml:"Required"`
+	// Strategies are the names of the auth strategies tried, in order, the gateway's default strategy when empty
+	Strategies []string `json:"strategies" yaml:"Strategies"`
+	// Mode is any (default), where one of the strategies must authenticate the request, or all
+	Mode string `json:"mode" yaml:"Mode"`
+	// Require is what authenticated clients must have, checked on every authenticated request
+	Require *Requirements `json:"require,omitempty" yaml:"Require,omitempty"`
 	// if required is true, then Excepted path and methods does not require authentication
 	// if required is false, then Excepted path and methods require authentication
-	Except []struct {
-		Path    string   `json:"path" yaml:"Path"`
-		Methods []string `json:"methods" yaml:"Methods"`
-	} `json:"except" yaml:"Except"`
+	Except []AuthenticationException `json:"except" yaml:"Except"`
+}
+
+// AuthenticationException is a path and methods excepted from the authentication rule of a route.
+// An exception with its own requirements always requires authentication, with its requirements
+// in place of the route's.
+type AuthenticationException struct {
+	Path    string        `json:"path" yaml:"Path"`
+	Methods []string      `json:"methods" yaml:"Methods"`
+	Require *Requirements `json:"require,omitempty" yaml:"Require,omitempty"`
+}
+
+// Requirements are what an authenticated client must have to be let through, it's denied with a 403 otherwise
+type Requirements struct {
+	// Permissions are all required, system.admin grants every permission
+	Permissions []string `json:"permissions,omitempty" yaml:"Permissions,omitempty"`
+	// Profiles are profile IDs, one of them is required
+	Profiles []int64 `json:"profiles,omitempty" yaml:"Profiles,omitempty"`
+	// Claims are predicates on the claims of the token, all of them must hold
+	Claims []ClaimRequirement `json:"claims,omitempty" yaml:"Claims,omitempty"`
+}
+
+// ClaimRequirement is a predicate on a claim of the token
+type ClaimRequirement struct {
+	// Name of the claim, nested claims are joined by dots, e.g. org.tier
+	Name string `json:"name" yaml:"Name"`
+	// Operator is exists, equals (default), not_equals or contains
+	Operator string   `json:"operator,omitempty" yaml:"Operator,omitempty"`
+	Values   []string `json:"values,omitempty" yaml:"Values,omitempty"`
 }
 
 func (auth *Authentication) IsAuthenticationRequired(path, method string) bool {
+	required, _ := auth.Policy(path, method)
+	return required
+}
+
+// Policy returns whether the request must be authenticated and the requirements it must meet then
+func (auth *Authentication) Policy(path, method string) (bool, *Requirements) {
 	if auth == nil {
-		return false
+		return false, nil
 	}
-	if auth.Required {
-		return !auth.isExcepted(path, method)
+	except := auth.exception(path, method)
+	switch {
+	case except != nil && except.Require != nil:
+		return true, except.Require
+	case auth.Required:
+		return except == nil, auth.Require
 	}
-	return auth.isExcepted(path, method)
+	return except != nil, auth.Require
 }
 
-func (auth *Authentication) isExcepted(path, method string) bool {
-	for _, except := range auth.Except {
+// exception returns the first exception matching the path and method
+func (auth *Authentication) exception(path, method string) *AuthenticationException {
+	for i, except := range auth.Except {
 		// if path is not prefix of except.Path, then it is not excepted
 		if !strings.HasPrefix(path, except.Path) {
 			continue
 		}
 		if len(except.Methods) == 0 {
-			return true
+			return &auth.Except[i]
 		}
 		for _, m := range except.Methods {
 			if m == method {
-				return true
+				return &auth.Except[i]
 			}
 		}
 	}
-	return false
+	return nil
 }
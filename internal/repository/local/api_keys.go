@@ -0,0 +1,129 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// apiKeysFile caches the API keys file, reloading it when it changes
+type apiKeysFile struct {
+	mu      sync.Mutex
+	modTime time.Time
+	keys    []*models.APIKey
+}
+
+// load returns the keys of the file at path, read again only when its modification time changed.
+// The keys are shared by the callers, which must copy them before changing them.
+func (f *apiKeysFile) load(path string) ([]*models.APIKey, error) {
+	if path == "" {
+		return nil, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat api keys file: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if info.ModTime().Equal(f.modTime) {
+		return f.keys, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read api keys file: %w", err)
+	}
+	var keys []*models.APIKey
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, &keys)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &keys)
+	default:
+		return nil, fmt.Errorf("unsupported api keys file extension: %s, only JSON and YAML files are supported", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse api keys file: %w", err)
+	}
+	for i, key := range keys {
+		if key.KeyHash == "" {
+			return nil, fmt.Errorf("key hash is required for api key %q", key.Name)
+		}
+		key.KeyHash = strings.ToLower(key.KeyHash)
+		if key.ID == 0 {
+			key.ID = int64(i + 1)
+		}
+	}
+
+	f.modTime = info.ModTime()
+	f.keys = keys
+	return keys, nil
+}
+
+// CreateAPIKey is not implemented for local repository, keys are added to the file
+func (r *Repository) CreateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error) {
+	return nil, ErrNotImplemented
+}
+
+// GetAPIKeyByID retrieves an API key of the file by its ID
+func (r *Repository) GetAPIKeyByID(ctx context.Context, id int64) (*models.APIKey, error) {
+	keys, err := r.apiKeys.load(r.cfg.APIKeysFilePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.ID == id {
+			k := *key
+			return &k, nil
+		}
+	}
+	return nil, fmt.Errorf("api key with id %d: %w", id, models.ErrAPIKeyNotFound)
+}
+
+// GetAPIKeyByHash retrieves the API key of the file with the hash
+func (r *Repository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	keys, err := r.apiKeys.load(r.cfg.APIKeysFilePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.KeyHash == keyHash {
+			k := *key
+			return &k, nil
+		}
+	}
+	return nil, models.ErrAPIKeyNotFound
+}
+
+// ListAPIKeys retrieves the API keys of the file
+func (r *Repository) ListAPIKeys(ctx context.Context) ([]*models.APIKey, error) {
+	keys, err := r.apiKeys.load(r.cfg.APIKeysFilePath)
+	if err != nil {
+		return nil, err
+	}
+	copies := make([]*models.APIKey, len(keys))
+	for i, key := range keys {
+		k := *key
+		copies[i] = &k
+	}
+	return copies, nil
+}
+
+// APIKeysReadOnly reports that the keys can't be changed through the gateway, only in the file
+func (r *Repository) APIKeysReadOnly() bool {
+	return true
+}
+
+// UpdateAPIKey is not implemented for local repository, keys are changed in the file
+func (r *Repository) UpdateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error) {
+	return nil, ErrNotImplemented
+}
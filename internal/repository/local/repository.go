@@ -22,10 +22,13 @@ type Config struct {
 	// path to folder containing route definitions in JSON or YAML format
 	// each file represents a route
 	RoutesFolderPath string `yaml:"RoutesFolderPath"`
+	// path to a JSON or YAML file listing the API keys, by hash
+	APIKeysFilePath string `yaml:"APIKeysFilePath"`
 }
 
 type Repository struct {
-	cfg *Config
+	cfg     *Config
+	apiKeys apiKeysFile
 }
 
 func NewRepository(ctx context.Context, cfg *Config) (*Repository, error) {
@@ -130,8 +133,39 @@ func (r *Repository) parseRouteFile(ctx context.Context, filePath string) (*mode
 	if route.PathPrefix == "" {
 		return nil, fmt.Errorf("path_prefix is required")
 	}
-	if route.TargetURL == "" {
-		return nil, fmt.Errorf("target_url is required")
+	if route.TargetURL == "" && len(route.Targets) == 0 && route.Split == nil {
+		return nil, fmt.Errorf("target_url, targets or split is required")
+	}
+	for _, target := range route.Targets {
+		if target.URL == "" {
+			return nil, fmt.Errorf("url is required for every target")
+		}
+	}
+	if route.Split != nil {
+		for _, version := range route.Split.Versions {
+			if version.Name == "" || len(version.Targets) == 0 {
+				return nil, fmt.Errorf("name and targets are required for every split version")
+			}
+		}
+	}
+	if route.Mirror != nil && route.Mirror.TargetURL == "" {
+		return nil, fmt.Errorf("target_url is required for mirror")
+	}
+	if route.Identity != nil {
+		for _, claimHeader := range route.Identity.ClaimHeaders {
+			if claimHeader.Claim == "" || claimHeader.Header == "" {
+				return nil, fmt.Errorf("claim and header are required for every identity claim header")
+			}
+		}
+	}
+	if route.RateLimit != nil && route.RateLimit.Requests <= 0 {
+		return nil, fmt.Errorf("requests is required for rate limit")
+	}
+	if route.HealthCheck != nil && route.HealthCheck.Path == "" {
+		return nil, fmt.Errorf("path is required for health check")
+	}
+	if cb := route.CircuitBreaker; cb != nil && cb.ConsecutiveFailures <= 0 && cb.ErrorRateThreshold <= 0 {
+		return nil, fmt.Errorf("consecutive_failures or error_rate_threshold is required for circuit breaker")
 	}
 
 	return &route, nil
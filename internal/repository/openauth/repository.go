@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/gofreego/goutils/logger"
 	"github.com/gofreego/openauth/pkg/clients/openauth"
@@ -42,8 +43,8 @@ func (r *Repository) GetRoutes(ctx context.Context) ([]*models.ServiceRoute, err
 	for _, config := range configs.GetConfigs() {
 		var route models.ServiceRoute
 		if err := json.Unmarshal([]byte(config.GetJsonValue()), &route); err != nil {
-			logger.Error(ctx, "Failed to unmarshal route config: %v", err)
-			continue
+			// the routing table is replaced with these routes, so a skipped config would stop serving its route
+			return nil, fmt.Errorf("failed to unmarshal route config: %w", err)
 		}
 		route.UpdatedAt = config.UpdatedAt
 		routes = append(routes, &route)
@@ -85,3 +86,28 @@ func (r *Repository) GetAppSettings(ctx context.Context) ([]*models.AppSetting,
 func (r *Repository) UpsertAppSetting(ctx context.Context, setting *models.AppSetting) error {
 	return ErrNotImplemented
 }
+
+// CreateAPIKey is not implemented for openauth repository
+func (r *Repository) CreateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error) {
+	return nil, ErrNotImplemented
+}
+
+// GetAPIKeyByID is not implemented for openauth repository
+func (r *Repository) GetAPIKeyByID(ctx context.Context, id int64) (*models.APIKey, error) {
+	return nil, ErrNotImplemented
+}
+
+// GetAPIKeyByHash finds no key as the openauth repository stores none
+func (r *Repository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	return nil, models.ErrAPIKeyNotFound
+}
+
+// ListAPIKeys is not implemented for openauth repository
+func (r *Repository) ListAPIKeys(ctx context.Context) ([]*models.APIKey, error) {
+	return nil, ErrNotImplemented
+}
+
+// UpdateAPIKey is not implemented for openauth repository
+func (r *Repository) UpdateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error) {
+	return nil, ErrNotImplemented
+}
@@ -0,0 +1,154 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+const apiKeyColumns = `id, name, prefix, key_hash, owner, scopes, expires_at, revoked_at, created_at, updated_at`
+
+// CreateAPIKey stores a new API key
+func (r *Repository) CreateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error) {
+	scopesJSON, err := json.Marshal(key.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal scopes: %w", err)
+	}
+
+	query := `
+		INSERT INTO api_keys (name, prefix, key_hash, owner, scopes, expires_at, revoked_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`
+	err = r.connManager.Primary().QueryRowContext(ctx, query,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		key.Owner,
+		scopesJSON,
+		key.ExpiresAt,
+		key.RevokedAt,
+	).Scan(&key.ID, &key.CreatedAt, &key.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert api key: %w", err)
+	}
+	return key, nil
+}
+
+// GetAPIKeyByID retrieves an API key by its ID
+func (r *Repository) GetAPIKeyByID(ctx context.Context, id int64) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = $1`
+	key, err := scanAPIKey(r.connManager.Primary().QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("api key with id %d: %w", id, models.ErrAPIKeyNotFound)
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return key, nil
+}
+
+// GetAPIKeyByHash retrieves the API key with the hash
+func (r *Repository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1`
+	key, err := scanAPIKey(r.connManager.Primary().QueryRowContext(ctx, query, keyHash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return key, nil
+}
+
+// ListAPIKeys retrieves all API keys, newest first
+func (r *Repository) ListAPIKeys(ctx context.Context) ([]*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys ORDER BY created_at DESC`
+	rows, err := r.connManager.Primary().QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*models.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// UpdateAPIKey updates the hash, metadata and revocation of an API key
+func (r *Repository) UpdateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error) {
+	scopesJSON, err := json.Marshal(key.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal scopes: %w", err)
+	}
+
+	query := `
+		UPDATE api_keys
+		SET name = $1, prefix = $2, key_hash = $3, owner = $4, scopes = $5, expires_at = $6, revoked_at = $7
+		WHERE id = $8
+		RETURNING created_at, updated_at
+	`
+	err = r.connManager.Primary().QueryRowContext(ctx, query,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		key.Owner,
+		scopesJSON,
+		key.ExpiresAt,
+		key.RevokedAt,
+		key.ID,
+	).Scan(&key.CreatedAt, &key.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("api key with id %d: %w", key.ID, models.ErrAPIKeyNotFound)
+		}
+		return nil, fmt.Errorf("failed to update api key: %w", err)
+	}
+	return key, nil
+}
+
+// scanAPIKey scans a single api_keys row into an APIKey struct
+func scanAPIKey(row rowScanner) (*models.APIKey, error) {
+	var key models.APIKey
+	var scopesJSON []byte
+	var expiresAt, revokedAt sql.NullTime
+
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.Owner,
+		&scopesJSON,
+		&expiresAt,
+		&revokedAt,
+		&key.CreatedAt,
+		&key.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt.Valid {
+		key.ExpiresAt = &expiresAt.Time
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	if len(scopesJSON) > 0 {
+		if err := json.Unmarshal(scopesJSON, &key.Scopes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal scopes: %w", err)
+		}
+	}
+	return &key, nil
+}
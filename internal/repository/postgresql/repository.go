@@ -36,8 +36,8 @@ func (r *Repository) Ping(ctx context.Context) error {
 // GetRoutes retrieves all routes for the routing manager
 func (r *Repository) GetRoutes(ctx context.Context) ([]*models.ServiceRoute, error) {
 	query := `
-		SELECT id, name, path_prefix, target_url, strip_prefix, 
-		       authentication, middleware, timeout, created_at, updated_at
+		SELECT id, name, path_prefix, hosts, match_conditions, priority, target_url, targets, split, mirror, identity, rate_limit, load_balancer, health_check, circuit_breaker, retry_policy, strip_prefix, rewrite,
+		       authentication, middleware, middleware_config, timeout, created_at, updated_at
 		FROM configs
 		ORDER BY name
 	`
@@ -52,8 +52,8 @@ func (r *Repository) GetRoutes(ctx context.Context) ([]*models.ServiceRoute, err
 	for rows.Next() {
 		config, err := r.scanConfig(rows)
 		if err != nil {
-			logger.Error(ctx, "Failed to scan config row: %v", err)
-			continue
+			// the routing table is replaced with these routes, so a skipped row would stop serving its route
+			return nil, fmt.Errorf("failed to scan config row: %w", err)
 		}
 		routes = append(routes, config.ToServiceRoute())
 	}
@@ -67,6 +67,11 @@ func (r *Repository) GetRoutes(ctx context.Context) ([]*models.ServiceRoute, err
 
 // CreateConfig creates a new config in the database
 func (r *Repository) CreateConfig(ctx context.Context, config *models.Config) (*models.Config, error) {
+	rewriteJSON, err := json.Marshal(config.Rewrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rewrite: %w", err)
+	}
+
 	authJSON, err := json.Marshal(config.Authentication)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal authentication: %w", err)
@@ -77,9 +82,70 @@ func (r *Repository) CreateConfig(ctx context.Context, config *models.Config) (*
 		return nil, fmt.Errorf("failed to marshal middleware: %w", err)
 	}
 
+	middlewareConfigJSON, err := json.Marshal(config.MiddlewareConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal middleware config: %w", err)
+	}
+
+	hostsJSON, err := json.Marshal(config.Hosts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal hosts: %w", err)
+	}
+
+	matchJSON, err := json.Marshal(config.Match)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal match conditions: %w", err)
+	}
+
+	targetsJSON, err := json.Marshal(config.Targets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal targets: %w", err)
+	}
+
+	splitJSON, err := json.Marshal(config.Split)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal split: %w", err)
+	}
+
+	mirrorJSON, err := json.Marshal(config.Mirror)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mirror: %w", err)
+	}
+
+	identityJSON, err := json.Marshal(config.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal identity: %w", err)
+	}
+
+	rateLimitJSON, err := json.Marshal(config.RateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rate limit: %w", err)
+	}
+
+	loadBalancerJSON, err := json.Marshal(config.LoadBalancer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal load balancer: %w", err)
+	}
+
+	healthCheckJSON, err := json.Marshal(config.HealthCheck)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal health check: %w", err)
+	}
+
+	circuitBreakerJSON, err := json.Marshal(config.CircuitBreaker)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal circuit breaker: %w", err)
+	}
+
+	retryPolicyJSON, err := json.Marshal(config.RetryPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal retry policy: %w", err)
+	}
+
 	query := `
-		INSERT INTO configs (name, path_prefix, target_url, strip_prefix, authentication, middleware, timeout)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO configs (name, path_prefix, hosts, match_conditions, priority, target_url, targets, split, mirror, identity, rate_limit, load_balancer,
+		                     health_check, circuit_breaker, retry_policy, strip_prefix, rewrite, authentication, middleware, middleware_config, timeout)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
 		RETURNING id, created_at, updated_at
 	`
 
@@ -89,10 +155,24 @@ func (r *Repository) CreateConfig(ctx context.Context, config *models.Config) (*
 	err = r.connManager.Primary().QueryRowContext(ctx, query,
 		config.Name,
 		config.PathPrefix,
+		hostsJSON,
+		matchJSON,
+		config.Priority,
 		config.TargetURL,
+		targetsJSON,
+		splitJSON,
+		mirrorJSON,
+		identityJSON,
+		rateLimitJSON,
+		loadBalancerJSON,
+		healthCheckJSON,
+		circuitBreakerJSON,
+		retryPolicyJSON,
 		config.StripPrefix,
+		rewriteJSON,
 		authJSON,
 		middlewareJSON,
+		middlewareConfigJSON,
 		config.Timeout,
 	).Scan(&id, &createdAt, &updatedAt)
 
@@ -114,14 +194,14 @@ func (r *Repository) CreateConfig(ctx context.Context, config *models.Config) (*
 // GetConfigByID retrieves a config by its ID
 func (r *Repository) GetConfigByID(ctx context.Context, id int64) (*models.Config, error) {
 	query := `
-		SELECT id, name, path_prefix, target_url, strip_prefix, 
-		       authentication, middleware, timeout, created_at, updated_at
+		SELECT id, name, path_prefix, hosts, match_conditions, priority, target_url, targets, split, mirror, identity, rate_limit, load_balancer, health_check, circuit_breaker, retry_policy, strip_prefix, rewrite,
+		       authentication, middleware, middleware_config, timeout, created_at, updated_at
 		FROM configs
 		WHERE id = $1
 	`
 
 	row := r.connManager.Primary().QueryRowContext(ctx, query, id)
-	config, err := r.scanConfigRow(row)
+	config, err := r.scanConfig(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("config with id %d not found", id)
@@ -155,8 +235,8 @@ func (r *Repository) ListConfigs(ctx context.Context, filter *models.ConfigFilte
 
 	// Get paginated results
 	selectQuery := fmt.Sprintf(`
-		SELECT id, name, path_prefix, target_url, strip_prefix, 
-		       authentication, middleware, timeout, created_at, updated_at
+		SELECT id, name, path_prefix, hosts, match_conditions, priority, target_url, targets, split, mirror, identity, rate_limit, load_balancer, health_check, circuit_breaker, retry_policy, strip_prefix, rewrite,
+		       authentication, middleware, middleware_config, timeout, created_at, updated_at
 		%s
 		ORDER BY name
 		LIMIT $%d OFFSET $%d
@@ -189,6 +269,11 @@ func (r *Repository) ListConfigs(ctx context.Context, filter *models.ConfigFilte
 
 // UpdateConfig updates an existing config
 func (r *Repository) UpdateConfig(ctx context.Context, config *models.Config) (*models.Config, error) {
+	rewriteJSON, err := json.Marshal(config.Rewrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rewrite: %w", err)
+	}
+
 	authJSON, err := json.Marshal(config.Authentication)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal authentication: %w", err)
@@ -199,11 +284,72 @@ func (r *Repository) UpdateConfig(ctx context.Context, config *models.Config) (*
 		return nil, fmt.Errorf("failed to marshal middleware: %w", err)
 	}
 
+	middlewareConfigJSON, err := json.Marshal(config.MiddlewareConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal middleware config: %w", err)
+	}
+
+	hostsJSON, err := json.Marshal(config.Hosts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal hosts: %w", err)
+	}
+
+	matchJSON, err := json.Marshal(config.Match)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal match conditions: %w", err)
+	}
+
+	targetsJSON, err := json.Marshal(config.Targets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal targets: %w", err)
+	}
+
+	splitJSON, err := json.Marshal(config.Split)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal split: %w", err)
+	}
+
+	mirrorJSON, err := json.Marshal(config.Mirror)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mirror: %w", err)
+	}
+
+	identityJSON, err := json.Marshal(config.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal identity: %w", err)
+	}
+
+	rateLimitJSON, err := json.Marshal(config.RateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rate limit: %w", err)
+	}
+
+	loadBalancerJSON, err := json.Marshal(config.LoadBalancer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal load balancer: %w", err)
+	}
+
+	healthCheckJSON, err := json.Marshal(config.HealthCheck)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal health check: %w", err)
+	}
+
+	circuitBreakerJSON, err := json.Marshal(config.CircuitBreaker)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal circuit breaker: %w", err)
+	}
+
+	retryPolicyJSON, err := json.Marshal(config.RetryPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal retry policy: %w", err)
+	}
+
 	query := `
 		UPDATE configs
-		SET name = $1, path_prefix = $2, target_url = $3, strip_prefix = $4,
-		    authentication = $5, middleware = $6, timeout = $7
-		WHERE id = $8
+		SET name = $1, path_prefix = $2, hosts = $3, match_conditions = $4, priority = $5, target_url = $6, targets = $7,
+		    split = $8, mirror = $9, identity = $10, rate_limit = $11, load_balancer = $12, health_check = $13, circuit_breaker = $14,
+		    retry_policy = $15, strip_prefix = $16, rewrite = $17, authentication = $18, middleware = $19, middleware_config = $20, timeout = $21
+		WHERE id = $22
 		RETURNING created_at, updated_at
 	`
 
@@ -211,10 +357,24 @@ func (r *Repository) UpdateConfig(ctx context.Context, config *models.Config) (*
 	err = r.connManager.Primary().QueryRowContext(ctx, query,
 		config.Name,
 		config.PathPrefix,
+		hostsJSON,
+		matchJSON,
+		config.Priority,
 		config.TargetURL,
+		targetsJSON,
+		splitJSON,
+		mirrorJSON,
+		identityJSON,
+		rateLimitJSON,
+		loadBalancerJSON,
+		healthCheckJSON,
+		circuitBreakerJSON,
+		retryPolicyJSON,
 		config.StripPrefix,
+		rewriteJSON,
 		authJSON,
 		middlewareJSON,
+		middlewareConfigJSON,
 		config.Timeout,
 		config.ID,
 	).Scan(&createdAt, &updatedAt)
@@ -256,20 +416,39 @@ func (r *Repository) DeleteConfig(ctx context.Context, id int64) error {
 	return nil
 }
 
-// scanConfig scans a row from sql.Rows into a Config struct
-func (r *Repository) scanConfig(rows *sql.Rows) (*models.Config, error) {
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanConfig scans a single configs row into a Config struct
+func (r *Repository) scanConfig(row rowScanner) (*models.Config, error) {
 	var config models.Config
-	var authJSON, middlewareJSON []byte
+	var authJSON, middlewareJSON, hostsJSON, matchJSON, rewriteJSON, targetsJSON, splitJSON, mirrorJSON, identityJSON, rateLimitJSON, loadBalancerJSON, healthCheckJSON, circuitBreakerJSON, retryPolicyJSON, middlewareConfigJSON []byte
 	var timeout int64
 
-	err := rows.Scan(
+	err := row.Scan(
 		&config.ID,
 		&config.Name,
 		&config.PathPrefix,
+		&hostsJSON,
+		&matchJSON,
+		&config.Priority,
 		&config.TargetURL,
+		&targetsJSON,
+		&splitJSON,
+		&mirrorJSON,
+		&identityJSON,
+		&rateLimitJSON,
+		&loadBalancerJSON,
+		&healthCheckJSON,
+		&circuitBreakerJSON,
+		&retryPolicyJSON,
 		&config.StripPrefix,
+		&rewriteJSON,
 		&authJSON,
 		&middlewareJSON,
+		&middlewareConfigJSON,
 		&timeout,
 		&config.CreatedAt,
 		&config.UpdatedAt,
@@ -280,44 +459,77 @@ func (r *Repository) scanConfig(rows *sql.Rows) (*models.Config, error) {
 
 	config.Timeout = time.Duration(timeout)
 
-	if len(authJSON) > 0 {
-		if err := json.Unmarshal(authJSON, &config.Authentication); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal authentication: %w", err)
+	if len(hostsJSON) > 0 {
+		if err := json.Unmarshal(hostsJSON, &config.Hosts); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal hosts: %w", err)
 		}
 	}
 
-	if len(middlewareJSON) > 0 {
-		if err := json.Unmarshal(middlewareJSON, &config.Middleware); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal middleware: %w", err)
+	if len(matchJSON) > 0 {
+		if err := json.Unmarshal(matchJSON, &config.Match); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal match conditions: %w", err)
 		}
 	}
 
-	return &config, nil
-}
+	if len(targetsJSON) > 0 {
+		if err := json.Unmarshal(targetsJSON, &config.Targets); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal targets: %w", err)
+		}
+	}
 
-// scanConfigRow scans a single row from sql.Row into a Config struct
-func (r *Repository) scanConfigRow(row *sql.Row) (*models.Config, error) {
-	var config models.Config
-	var authJSON, middlewareJSON []byte
-	var timeout int64
+	if len(splitJSON) > 0 {
+		if err := json.Unmarshal(splitJSON, &config.Split); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal split: %w", err)
+		}
+	}
 
-	err := row.Scan(
-		&config.ID,
-		&config.Name,
-		&config.PathPrefix,
-		&config.TargetURL,
-		&config.StripPrefix,
-		&authJSON,
-		&middlewareJSON,
-		&timeout,
-		&config.CreatedAt,
-		&config.UpdatedAt,
-	)
-	if err != nil {
-		return nil, err
+	if len(mirrorJSON) > 0 {
+		if err := json.Unmarshal(mirrorJSON, &config.Mirror); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal mirror: %w", err)
+		}
 	}
 
-	config.Timeout = time.Duration(timeout)
+	if len(identityJSON) > 0 {
+		if err := json.Unmarshal(identityJSON, &config.Identity); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal identity: %w", err)
+		}
+	}
+
+	if len(rateLimitJSON) > 0 {
+		if err := json.Unmarshal(rateLimitJSON, &config.RateLimit); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal rate limit: %w", err)
+		}
+	}
+
+	if len(loadBalancerJSON) > 0 {
+		if err := json.Unmarshal(loadBalancerJSON, &config.LoadBalancer); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal load balancer: %w", err)
+		}
+	}
+
+	if len(healthCheckJSON) > 0 {
+		if err := json.Unmarshal(healthCheckJSON, &config.HealthCheck); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal health check: %w", err)
+		}
+	}
+
+	if len(circuitBreakerJSON) > 0 {
+		if err := json.Unmarshal(circuitBreakerJSON, &config.CircuitBreaker); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal circuit breaker: %w", err)
+		}
+	}
+
+	if len(retryPolicyJSON) > 0 {
+		if err := json.Unmarshal(retryPolicyJSON, &config.RetryPolicy); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal retry policy: %w", err)
+		}
+	}
+
+	if len(rewriteJSON) > 0 {
+		if err := json.Unmarshal(rewriteJSON, &config.Rewrite); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal rewrite: %w", err)
+		}
+	}
 
 	if len(authJSON) > 0 {
 		if err := json.Unmarshal(authJSON, &config.Authentication); err != nil {
@@ -331,6 +543,12 @@ func (r *Repository) scanConfigRow(row *sql.Row) (*models.Config, error) {
 		}
 	}
 
+	if len(middlewareConfigJSON) > 0 {
+		if err := json.Unmarshal(middlewareConfigJSON, &config.MiddlewareConfig); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal middleware config: %w", err)
+		}
+	}
+
 	return &config, nil
 }
 
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/opengate/api/opengate_v1"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/internal/service/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateAPIKey creates an API key, returning the key itself only this once
+func (s *Service) CreateAPIKey(ctx context.Context, req *opengate_v1.CreateAPIKeyRequest) (*opengate_v1.CreateAPIKeyResponse, error) {
+	// Check write permission
+	if err := s.checkPermission(ctx, constants.PERMISSION_API_KEYS_WRITE); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.GetExpiresAt() != 0 && req.GetExpiresAt() <= time.Now().Unix() {
+		return nil, fmt.Errorf("expires_at must be in the future")
+	}
+
+	rawKey, prefix, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	key := &models.APIKey{
+		Name:    req.GetName(),
+		Prefix:  prefix,
+		KeyHash: auth.HashAPIKey(rawKey),
+		Owner:   req.GetOwner(),
+		Scopes:  req.GetScopes(),
+	}
+	if req.GetExpiresAt() != 0 {
+		expiresAt := time.Unix(req.GetExpiresAt(), 0)
+		key.ExpiresAt = &expiresAt
+	}
+
+	created, err := s.repo.CreateAPIKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(ctx, "API key %s (%s) created for %s", created.Name, created.Prefix, created.Owner)
+
+	return &opengate_v1.CreateAPIKeyResponse{
+		ApiKey:  apiKeyToProto(created),
+		Key:     rawKey,
+		Message: "API key created successfully, store the key as it can't be retrieved again",
+	}, nil
+}
+
+// ListAPIKeys lists the API keys
+func (s *Service) ListAPIKeys(ctx context.Context, req *opengate_v1.ListAPIKeysRequest) (*opengate_v1.ListAPIKeysResponse, error) {
+	// Check read permission
+	if err := s.checkPermission(ctx, constants.PERMISSION_API_KEYS_READ); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.repo.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	protoKeys := make([]*opengate_v1.APIKey, len(keys))
+	for i, key := range keys {
+		protoKeys[i] = apiKeyToProto(key)
+	}
+	return &opengate_v1.ListAPIKeysResponse{
+		ApiKeys: protoKeys,
+		Message: "API keys retrieved successfully",
+	}, nil
+}
+
+// readOnlyAPIKeys is implemented by repositories whose API keys are managed outside the gateway, like in a file
+type readOnlyAPIKeys interface {
+	APIKeysReadOnly() bool
+}
+
+// checkAPIKeysWritable rejects changes to API keys the repository can't store
+func (s *Service) checkAPIKeysWritable() error {
+	if repo, ok := s.repo.(readOnlyAPIKeys); ok && repo.APIKeysReadOnly() {
+		return status.Error(codes.FailedPrecondition, "api keys of this repository are read-only, change them where they're stored")
+	}
+	return nil
+}
+
+// RotateAPIKey replaces the key of an API key, the previous key stops working
+func (s *Service) RotateAPIKey(ctx context.Context, req *opengate_v1.RotateAPIKeyRequest) (*opengate_v1.RotateAPIKeyResponse, error) {
+	// Check write permission
+	if err := s.checkPermission(ctx, constants.PERMISSION_API_KEYS_WRITE); err != nil {
+		return nil, err
+	}
+
+	if req.GetId() <= 0 {
+		return nil, fmt.Errorf("invalid api key id")
+	}
+	if err := s.checkAPIKeysWritable(); err != nil {
+		return nil, err
+	}
+
+	key, err := s.repo.GetAPIKeyByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if key.RevokedAt != nil {
+		return nil, fmt.Errorf("api key %s is revoked", key.Name)
+	}
+
+	rawKey, prefix, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	key.Prefix = prefix
+	key.KeyHash = auth.HashAPIKey(rawKey)
+
+	updated, err := s.repo.UpdateAPIKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(ctx, "API key %s rotated to %s", updated.Name, updated.Prefix)
+
+	return &opengate_v1.RotateAPIKeyResponse{
+		ApiKey:  apiKeyToProto(updated),
+		Key:     rawKey,
+		Message: "API key rotated successfully, store the key as it can't be retrieved again",
+	}, nil
+}
+
+// RevokeAPIKey revokes an API key
+func (s *Service) RevokeAPIKey(ctx context.Context, req *opengate_v1.RevokeAPIKeyRequest) (*opengate_v1.RevokeAPIKeyResponse, error) {
+	// Check write permission
+	if err := s.checkPermission(ctx, constants.PERMISSION_API_KEYS_WRITE); err != nil {
+		return nil, err
+	}
+
+	if req.GetId() <= 0 {
+		return nil, fmt.Errorf("invalid api key id")
+	}
+	if err := s.checkAPIKeysWritable(); err != nil {
+		return nil, err
+	}
+
+	key, err := s.repo.GetAPIKeyByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if key.RevokedAt == nil {
+		now := time.Now()
+		key.RevokedAt = &now
+		if key, err = s.repo.UpdateAPIKey(ctx, key); err != nil {
+			return nil, err
+		}
+		logger.Info(ctx, "API key %s (%s) revoked", key.Name, key.Prefix)
+	}
+
+	return &opengate_v1.RevokeAPIKeyResponse{
+		ApiKey:  apiKeyToProto(key),
+		Message: "API key revoked successfully",
+	}, nil
+}
+
+// apiKeyToProto converts an API key to proto, leaving its hash out
+func apiKeyToProto(key *models.APIKey) *opengate_v1.APIKey {
+	result := &opengate_v1.APIKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Owner:     key.Owner,
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt.Unix(),
+		UpdatedAt: key.UpdatedAt.Unix(),
+	}
+	if key.ExpiresAt != nil {
+		result.ExpiresAt = key.ExpiresAt.Unix()
+	}
+	if key.RevokedAt != nil {
+		result.RevokedAt = key.RevokedAt.Unix()
+	}
+	return result
+}
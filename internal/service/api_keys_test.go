@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/opengate/api/opengate_v1"
+	"github.com/gofreego/opengate/internal/repository/local"
+	"github.com/gofreego/opengate/internal/service/auth"
+)
+
+func TestReadOnlyAPIKeysAreNotChanged(t *testing.T) {
+	rawKey, _, err := auth.GenerateAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keysFile := filepath.Join(t.TempDir(), "keys.yaml")
+	os.WriteFile(keysFile, []byte("- ID: 1\n  Name: ci\n  KeyHash: "+auth.HashAPIKey(rawKey)+"\n"), 0o600)
+	repo, _ := local.NewRepository(context.Background(), &local.Config{APIKeysFilePath: keysFile})
+	s := &Service{repo: repo, cfg: &Config{}}
+
+	if _, err := s.RotateAPIKey(context.Background(), &opengate_v1.RotateAPIKeyRequest{Id: 1}); err == nil {
+		t.Fatal("expected rotating a key of the file to be rejected")
+	}
+	if _, err := s.RevokeAPIKey(context.Background(), &opengate_v1.RevokeAPIKeyRequest{Id: 1}); err == nil {
+		t.Fatal("expected revoking a key of the file to be rejected")
+	}
+
+	// changing a key returned by the repository leaves the keys of the file alone
+	key, err := repo.GetAPIKeyByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	key.KeyHash = auth.HashAPIKey("other")
+
+	strategy, _ := auth.NewAPIKeyStrategy(&auth.APIKeyConfig{}, repo)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx.Request.Header.Set("X-API-Key", rawKey)
+	if err := strategy.Authenticate(ctx); err != nil {
+		t.Fatalf("expected the original key to still authenticate, got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultAPIKeyHeader   = "X-API-Key"
+	defaultAPIKeyCacheTTL = 30 * time.Second
+
+	// apiKeyPrefix starts every generated key so leaked keys are easy to spot
+	apiKeyPrefix = "og_"
+	// apiKeyDisplayLength is the length of the start of a key kept to tell keys apart
+	apiKeyDisplayLength = len(apiKeyPrefix) + 8
+)
+
+// APIKeyConfig configures API key authentication
+type APIKeyConfig struct {
+	Header     string        `yaml:"Header"`     // header holding the key, default X-API-Key
+	QueryParam string        `yaml:"QueryParam"` // query parameter holding the key, keys aren't read from the query when empty
+	CacheTTL   time.Duration `yaml:"CacheTTL"`   // how long a looked up key is trusted, default 30s
+}
+
+// APIKeyStore looks API keys up by the hash of the key
+type APIKeyStore interface {
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+}
+
+// APIKeyStrategy authenticates machine clients by the API key of a header or query parameter.
+// The scopes of the key are sent upstream as its permissions.
+type APIKeyStrategy struct {
+	store      APIKeyStore
+	header     string
+	queryParam string
+	cacheTTL   time.Duration
+	cache      sync.Map // key hash -> cachedAPIKey
+}
+
+type cachedAPIKey struct {
+	key     *models.APIKey
+	expires time.Time
+}
+
+func NewAPIKeyStrategy(config *APIKeyConfig, store APIKeyStore) (Strategy, error) {
+	if store == nil {
+		return nil, fmt.Errorf("api key strategy requires a repository storing api keys")
+	}
+	s := &APIKeyStrategy{
+		store:      store,
+		header:     config.Header,
+		queryParam: config.QueryParam,
+		cacheTTL:   config.CacheTTL,
+	}
+	if s.header == "" {
+		s.header = defaultAPIKeyHeader
+	}
+	if s.cacheTTL <= 0 {
+		s.cacheTTL = defaultAPIKeyCacheTTL
+	}
+	return s, nil
+}
+
+func (s *APIKeyStrategy) Authenticate(ctx *gin.Context) error {
+	fromQuery := false
+	rawKey := ctx.GetHeader(s.header)
+	if rawKey == "" && s.queryParam != "" {
+		rawKey = ctx.Query(s.queryParam)
+		fromQuery = true
+	}
+	if rawKey == "" {
+		return fmt.Errorf("missing api key")
+	}
+
+	key, err := s.lookup(ctx.Request.Context(), HashAPIKey(rawKey))
+	if err != nil {
+		if errors.Is(err, models.ErrAPIKeyNotFound) {
+			return fmt.Errorf("unknown api key")
+		}
+		return err
+	}
+	if !key.Active(time.Now()) {
+		return fmt.Errorf("api key %s is expired or revoked", key.Prefix)
+	}
+
+	// Keep the key away from the upstream
+	if fromQuery {
+		query := ctx.Request.URL.Query()
+		query.Del(s.queryParam)
+		ctx.Request.URL.RawQuery = query.Encode()
+	} else {
+		ctx.Request.Header.Del(s.header)
+	}
+
+	// Store claims in gin context so the client and its scopes are sent upstream like for tokens
+	subject := key.Owner
+	if subject == "" {
+		subject = key.Name
+	}
+	ctx.Set(constants.JWT_CLAIMS, &jwtutils.JWTClaims{
+		Permissions: key.Scopes,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: subject,
+			ID:      strconv.FormatInt(key.ID, 10),
+		},
+	})
+	ctx.Set(constants.API_KEY_ID, key.ID)
+	return nil
+}
+
+// lookup returns the key with the hash, from the cache while it's fresh
+func (s *APIKeyStrategy) lookup(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	if cached, ok := s.cache.Load(keyHash); ok {
+		entry := cached.(cachedAPIKey)
+		if time.Now().Before(entry.expires) {
+			return entry.key, nil
+		}
+		s.cache.Delete(keyHash)
+	}
+
+	key, err := s.store.GetAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		return nil, err
+	}
+	// only known keys are cached, so unknown keys can't fill the cache
+	s.cache.Store(keyHash, cachedAPIKey{key: key, expires: time.Now().Add(s.cacheTTL)})
+	return key, nil
+}
+
+// GenerateAPIKey returns a new random API key along with the start of it kept to tell keys apart
+func GenerateAPIKey() (key, prefix string, err error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	key = apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return key, key[:apiKeyDisplayLength], nil
+}
+
+// HashAPIKey returns the hex encoded SHA-256 of the key, the only form keys are stored in
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
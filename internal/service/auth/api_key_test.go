@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/internal/models"
+)
+
+// fakeKeyStore holds API keys by hash, counting lookups
+type fakeKeyStore struct {
+	keys    map[string]*models.APIKey
+	lookups int
+}
+
+func (s *fakeKeyStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	s.lookups++
+	if key, ok := s.keys[keyHash]; ok {
+		return key, nil
+	}
+	return nil, models.ErrAPIKeyNotFound
+}
+
+func TestAPIKeyStrategy(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	store := &fakeKeyStore{keys: map[string]*models.APIKey{
+		HashAPIKey("og_active"):  {ID: 1, Name: "billing", Owner: "billing-team", Scopes: []string{"invoices:read"}},
+		HashAPIKey("og_expired"): {ID: 2, Name: "old", ExpiresAt: &past},
+		HashAPIKey("og_revoked"): {ID: 3, Name: "leaked", RevokedAt: &past},
+	}}
+	strategy, err := NewAPIKeyStrategy(&APIKeyConfig{QueryParam: "api_key"}, store)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		target  string
+		header  string
+		wantErr bool
+	}{
+		{"header", "/", "og_active", false},
+		{"query parameter", "/?api_key=og_active&page=2", "", false},
+		{"missing", "/", "", true},
+		{"unknown", "/", "og_unknown", true},
+		{"expired", "/", "og_expired", true},
+		{"revoked", "/?api_key=og_revoked", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.header != "" {
+				ctx.Request.Header.Set(defaultAPIKeyHeader, tt.header)
+			}
+			err := strategy.Authenticate(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			claims, _ := ctx.Get(constants.JWT_CLAIMS)
+			if c := claims.(*jwtutils.JWTClaims); c.Subject != "billing-team" || len(c.Permissions) != 1 || c.Permissions[0] != "invoices:read" {
+				t.Fatalf("expected the owner and scopes of the key as claims, got %+v", c)
+			}
+			if ctx.Request.Header.Get(defaultAPIKeyHeader) != "" || ctx.Request.URL.Query().Has("api_key") {
+				t.Fatalf("expected the key to be stripped from the request, got %s", ctx.Request.URL)
+			}
+		})
+	}
+
+	// the active key was looked up once and then served from the cache, the other keys once each
+	if store.lookups != 4 {
+		t.Fatalf("expected 4 lookups, got %d", store.lookups)
+	}
+}
@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/internal/models"
+)
+
+// Operators of claim requirements
+const (
+	ClaimExists    = "exists"     // the claim is set
+	ClaimEquals    = "equals"     // the claim is one of the values
+	ClaimNotEquals = "not_equals" // the claim is unset or none of the values
+	ClaimContains  = "contains"   // the claim, a list or space separated string, holds one of the values
+)
+
+// Authorize checks that the authenticated client meets the requirements. The error says what is missing.
+func Authorize(ctx *gin.Context, require *models.Requirements) error {
+	if require == nil {
+		return nil
+	}
+
+	claims := &jwtutils.JWTClaims{}
+	if c, exists := ctx.Get(constants.JWT_CLAIMS); exists {
+		if jwtClaims, ok := c.(*jwtutils.JWTClaims); ok {
+			claims = jwtClaims
+		}
+	}
+	for _, permission := range require.Permissions {
+		if !claims.HasPermission(permission) {
+			return fmt.Errorf("missing permission %s", permission)
+		}
+	}
+	if len(require.Profiles) > 0 && !slices.ContainsFunc(require.Profiles, claims.HasProfileId) {
+		return fmt.Errorf("missing one of the profiles %v", require.Profiles)
+	}
+
+	if len(require.Claims) > 0 {
+		tokenClaims := Claims(ctx)
+		for _, requirement := range require.Claims {
+			if !claimHolds(tokenClaims, requirement) {
+				return fmt.Errorf("claim %s does not satisfy %s %v", requirement.Name, operator(requirement), requirement.Values)
+			}
+		}
+	}
+	return nil
+}
+
+// Claims returns all the claims of the authenticated client. They're the claims of its token, or the
+// claims its strategy provided when it didn't authenticate with a token.
+func Claims(ctx *gin.Context) map[string]any {
+	if c, exists := ctx.Get(constants.TOKEN_CLAIMS); exists {
+		if claims, ok := c.(map[string]any); ok {
+			return claims
+		}
+	}
+	c, exists := ctx.Get(constants.JWT_CLAIMS)
+	if !exists {
+		return nil
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil
+	}
+	var claims map[string]any
+	if err := json.Unmarshal(data, &claims); err != nil {
+		return nil
+	}
+	ctx.Set(constants.TOKEN_CLAIMS, claims)
+	return claims
+}
+
+// LookupClaim returns the claim with the name, nested claims are looked up by their names joined by dots
+func LookupClaim(claims map[string]any, name string) (any, bool) {
+	// claim names may contain dots themselves, e.g. namespaced claims like https://example.com/roles
+	if value, ok := claims[name]; ok {
+		return value, value != nil
+	}
+	current := any(claims)
+	for _, part := range strings.Split(name, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, current != nil
+}
+
+// ClaimString returns the claim as a string when it's a string, number or boolean
+func ClaimString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case json.Number:
+		return v.String(), true
+	}
+	return "", false
+}
+
+// claimStrings returns the elements of a list claim, or the words of a space separated string like scope
+func claimStrings(value any) []string {
+	var elements []string
+	switch v := value.(type) {
+	case []any:
+		for _, element := range v {
+			if s, isScalar := ClaimString(element); isScalar {
+				elements = append(elements, s)
+			}
+		}
+	case string:
+		elements = strings.Fields(v)
+	}
+	return elements
+}
+
+func claimHolds(claims map[string]any, requirement models.ClaimRequirement) bool {
+	value, ok := LookupClaim(claims, requirement.Name)
+	switch operator(requirement) {
+	case ClaimExists:
+		return ok
+	case ClaimEquals:
+		s, isScalar := ClaimString(value)
+		return ok && isScalar && slices.Contains(requirement.Values, s)
+	case ClaimNotEquals:
+		s, isScalar := ClaimString(value)
+		return !ok || !isScalar || !slices.Contains(requirement.Values, s)
+	case ClaimContains:
+		if !ok {
+			return false
+		}
+		return slices.ContainsFunc(claimStrings(value), func(element string) bool {
+			return slices.Contains(requirement.Values, element)
+		})
+	}
+	return false
+}
+
+func operator(requirement models.ClaimRequirement) string {
+	if requirement.Operator == "" {
+		return ClaimEquals
+	}
+	return requirement.Operator
+}
+
+// ValidateRequirements checks the permission and claim requirements of a route and its exceptions
+func ValidateRequirements(auth *models.Authentication) error {
+	if auth == nil {
+		return nil
+	}
+	if err := validateRequirements(auth.Require); err != nil {
+		return err
+	}
+	for _, except := range auth.Except {
+		if err := validateRequirements(except.Require); err != nil {
+			return fmt.Errorf("except %s: %w", except.Path, err)
+		}
+	}
+	return nil
+}
+
+func validateRequirements(require *models.Requirements) error {
+	if require == nil {
+		return nil
+	}
+	for _, permission := range require.Permissions {
+		if strings.TrimSpace(permission) == "" {
+			return fmt.Errorf("required permissions must not be empty")
+		}
+	}
+	for _, requirement := range require.Claims {
+		if requirement.Name == "" {
+			return fmt.Errorf("name is required for every claim requirement")
+		}
+		switch operator(requirement) {
+		case ClaimExists:
+		case ClaimEquals, ClaimNotEquals, ClaimContains:
+			if len(requirement.Values) == 0 {
+				return fmt.Errorf("values are required for claim %s with operator %s", requirement.Name, operator(requirement))
+			}
+		default:
+			return fmt.Errorf("invalid operator %q for claim %s: must be one of %s, %s, %s, %s", requirement.Operator, requirement.Name, ClaimExists, ClaimEquals, ClaimNotEquals, ClaimContains)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/internal/models"
+)
+
+func TestAuthorize(t *testing.T) {
+	claims := &jwtutils.JWTClaims{
+		UserID:      7,
+		Permissions: []string{"orders.read", "orders.write"},
+		Profiles:    []jwtutils.Profile{{Id: 3}},
+	}
+	tokenClaims := map[string]any{
+		"scope":                     "read write",
+		"org":                       map[string]any{"tier": "gold", "seats": float64(25)},
+		"roles":                     []any{"support", "billing"},
+		"https://example.com/email": "ops@example.com",
+	}
+
+	tests := []struct {
+		name    string
+		require *models.Requirements
+		wantErr bool
+	}{
+		{"no requirements", nil, false},
+		{"all permissions", &models.Requirements{Permissions: []string{"orders.read", "orders.write"}}, false},
+		{"missing permission", &models.Requirements{Permissions: []string{"orders.read", "orders.delete"}}, true},
+		{"one of the profiles", &models.Requirements{Profiles: []int64{1, 3}}, false},
+		{"none of the profiles", &models.Requirements{Profiles: []int64{1, 2}}, true},
+		{"nested claim equals", &models.Requirements{Claims: []models.ClaimRequirement{{Name: "org.tier", Values: []string{"gold", "platinum"}}}}, false},
+		{"number claim equals", &models.Requirements{Claims: []models.ClaimRequirement{{Name: "org.seats", Operator: ClaimEquals, Values: []string{"25"}}}}, false},
+		{"claim not equal", &models.Requirements{Claims: []models.ClaimRequirement{{Name: "org.tier", Values: []string{"silver"}}}}, true},
+		{"not equals", &models.Requirements{Claims: []models.ClaimRequirement{{Name: "org.tier", Operator: ClaimNotEquals, Values: []string{"free"}}}}, false},
+		{"list contains", &models.Requirements{Claims: []models.ClaimRequirement{{Name: "roles", Operator: ClaimContains, Values: []string{"billing"}}}}, false},
+		{"space separated contains", &models.Requirements{Claims: []models.ClaimRequirement{{Name: "scope", Operator: ClaimContains, Values: []string{"write"}}}}, false},
+		{"does not contain", &models.Requirements{Claims: []models.ClaimRequirement{{Name: "roles", Operator: ClaimContains, Values: []string{"admin"}}}}, true},
+		{"dotted claim name exists", &models.Requirements{Claims: []models.ClaimRequirement{{Name: "https://example.com/email", Operator: ClaimExists}}}, false},
+		{"missing claim", &models.Requirements{Claims: []models.ClaimRequirement{{Name: "org.region", Operator: ClaimExists}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			ctx.Set(constants.JWT_CLAIMS, claims)
+			ctx.Set(constants.TOKEN_CLAIMS, tokenClaims)
+			if err := Authorize(ctx, tt.require); (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAuthenticationPolicy(t *testing.T) {
+	admin := &models.Requirements{Permissions: []string{"admin"}}
+	route := &models.Authentication{
+		Required: true,
+		Require:  &models.Requirements{Permissions: []string{"read"}},
+		Except: []models.AuthenticationException{
+			{Path: "/health"},
+			{Path: "/admin", Methods: []string{http.MethodDelete}, Require: admin},
+		},
+	}
+	tests := []struct {
+		path, method string
+		wantRequired bool
+		wantRequire  *models.Requirements
+	}{
+		{"/orders", http.MethodGet, true, route.Require},
+		{"/health", http.MethodGet, false, route.Require},
+		{"/admin/users", http.MethodDelete, true, admin},
+		{"/admin/users", http.MethodGet, true, route.Require},
+	}
+	for _, tt := range tests {
+		required, require := route.Policy(tt.path, tt.method)
+		if required != tt.wantRequired || require != tt.wantRequire {
+			t.Errorf("%s %s: expected %v %v, got %v %v", tt.method, tt.path, tt.wantRequired, tt.wantRequire, required, require)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const defaultBasicRealm = "opengate"
+
+// BasicConfig configures HTTP basic authentication
+type BasicConfig struct {
+	Realm string      `yaml:"Realm"` // realm sent in the WWW-Authenticate challenge, default opengate
+	Users []BasicUser `yaml:"Users"`
+}
+
+// BasicUser is a user allowed in with basic authentication
+type BasicUser struct {
+	Username     string   `yaml:"Username"`
+	PasswordHash string   `yaml:"PasswordHash"` // bcrypt hash of the password
+	UserID       int64    `yaml:"UserID"`       // sent upstream like the user id of a JWT
+	Permissions  []string `yaml:"Permissions"`
+}
+
+// BasicStrategy authenticates requests with the username and password of the Authorization header
+type BasicStrategy struct {
+	realm string
+	users map[string]BasicUser
+}
+
+func NewBasicStrategy(config *BasicConfig) (Strategy, error) {
+	s := &BasicStrategy{
+		realm: config.Realm,
+		users: make(map[string]BasicUser, len(config.Users)),
+	}
+	if s.realm == "" {
+		s.realm = defaultBasicRealm
+	}
+	for _, user := range config.Users {
+		if user.Username == "" {
+			return nil, fmt.Errorf("username is required for every basic auth user")
+		}
+		if _, err := bcrypt.Cost([]byte(user.PasswordHash)); err != nil {
+			return nil, fmt.Errorf("invalid bcrypt password hash for basic auth user %s: %w", user.Username, err)
+		}
+		s.users[user.Username] = user
+	}
+	return s, nil
+}
+
+func (s *BasicStrategy) Authenticate(ctx *gin.Context) error {
+	username, password, ok := ctx.Request.BasicAuth()
+	if !ok {
+		return s.challenge(fmt.Errorf("missing basic credentials"))
+	}
+	user, ok := s.users[username]
+	if !ok || bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil {
+		return s.challenge(fmt.Errorf("invalid basic credentials for user %s", username))
+	}
+
+	// Store claims in gin context so the user is sent upstream like for tokens
+	ctx.Set(constants.JWT_CLAIMS, &jwtutils.JWTClaims{
+		UserID:           user.UserID,
+		Permissions:      user.Permissions,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: user.Username},
+	})
+	return nil
+}
+
+// challenge rejects the request asking the client for basic credentials
+func (s *BasicStrategy) challenge(err error) error {
+	header := http.Header{}
+	header.Set("WWW-Authenticate", fmt.Sprintf("Basic realm=%q", s.realm))
+	return &StatusError{Status: http.StatusUnauthorized, Header: header, Err: err}
+}
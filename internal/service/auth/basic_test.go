@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestBasicStrategy(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	strategy, err := NewBasicStrategy(&BasicConfig{Realm: "orders", Users: []BasicUser{{Username: "alice", PasswordHash: string(hash), UserID: 7}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	authenticate := func(username, password string) (*gin.Context, error) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+		if username != "" {
+			ctx.Request.SetBasicAuth(username, password)
+		}
+		return ctx, strategy.Authenticate(ctx)
+	}
+
+	ctx, err := authenticate("alice", "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if claims, _ := ctx.Value(constants.JWT_CLAIMS).(*jwtutils.JWTClaims); claims == nil || claims.UserID != 7 {
+		t.Fatalf("expected the claims of the user, got %v", claims)
+	}
+
+	for _, credentials := range [][2]string{{"", ""}, {"alice", "wrong"}, {"bob", "secret"}} {
+		ctx, err := authenticate(credentials[0], credentials[1])
+		var statusErr *StatusError
+		if !errors.As(err, &statusErr) || statusErr.Header.Get("WWW-Authenticate") != `Basic realm="orders"` {
+			t.Fatalf("expected %v to be rejected with a challenge, got %v", credentials, err)
+		}
+		if len(ctx.Writer.Header()) != 0 {
+			t.Fatalf("expected the challenge to be left to the manager, got %v", ctx.Writer.Header())
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Headers the identity of a verified client certificate is sent upstream in
+const (
+	HeaderClientCertSubject     = "X-Client-Cert-Subject"
+	HeaderClientCertDNS         = "X-Client-Cert-DNS"
+	HeaderClientCertSPIFFEID    = "X-Client-Cert-SPIFFE-ID"
+	HeaderClientCertFingerprint = "X-Client-Cert-Fingerprint"
+)
+
+// ClientCertHeaders are removed from every request sent upstream so clients can't spoof them
+var ClientCertHeaders = []string{HeaderClientCertSubject, HeaderClientCertDNS, HeaderClientCertSPIFFEID, HeaderClientCertFingerprint}
+
+const spiffeScheme = "spiffe"
+
+// ClientCertStrategy authenticates requests by the client certificate the gateway listener verified
+// against its client CAs. Routes allow certificates with requirements on the certificate's claims.
+type ClientCertStrategy struct{}
+
+func NewClientCertStrategy() (Strategy, error) {
+	return &ClientCertStrategy{}, nil
+}
+
+func (s *ClientCertStrategy) Authenticate(ctx *gin.Context) error {
+	// only verified chains count, certificates merely sent by the client don't
+	state := ctx.Request.TLS
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return fmt.Errorf("missing verified client certificate")
+	}
+	cert := state.VerifiedChains[0][0]
+
+	claims, raw := certClaims(cert)
+	setClaims(ctx, claims, raw)
+
+	headers := http.Header{}
+	headers.Set(HeaderClientCertSubject, cert.Subject.String())
+	headers.Set(HeaderClientCertFingerprint, raw["fingerprint"].(string))
+	if len(cert.DNSNames) > 0 {
+		headers.Set(HeaderClientCertDNS, strings.Join(cert.DNSNames, ","))
+	}
+	if spiffeID, ok := raw["spiffe_id"].(string); ok {
+		headers.Set(HeaderClientCertSPIFFEID, spiffeID)
+	}
+	AddUpstreamHeaders(ctx, headers)
+	return nil
+}
+
+// certClaims returns the identity of the certificate, typed and as the claims route requirements check
+func certClaims(cert *x509.Certificate) (*jwtutils.JWTClaims, map[string]any) {
+	fingerprint := sha256.Sum256(cert.Raw)
+	raw := map[string]any{
+		"sub":         cert.Subject.CommonName,
+		"subject":     cert.Subject.String(),
+		"issuer":      cert.Issuer.String(),
+		"serial":      cert.SerialNumber.Text(16),
+		"fingerprint": hex.EncodeToString(fingerprint[:]),
+		"dns":         stringClaims(cert.DNSNames),
+		"email":       stringClaims(cert.EmailAddresses),
+		"ou":          stringClaims(cert.Subject.OrganizationalUnit),
+		"o":           stringClaims(cert.Subject.Organization),
+	}
+	uris := make([]string, 0, len(cert.URIs))
+	for _, u := range cert.URIs {
+		uris = append(uris, u.String())
+		if _, ok := raw["spiffe_id"]; !ok && u.Scheme == spiffeScheme {
+			raw["spiffe_id"] = u.String()
+		}
+	}
+	raw["uri"] = stringClaims(uris)
+
+	claims := &jwtutils.JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   cert.Subject.CommonName,
+			Issuer:    cert.Issuer.String(),
+			ExpiresAt: jwt.NewNumericDate(cert.NotAfter),
+		},
+	}
+	return claims, raw
+}
+
+// stringClaims converts a list to a list claim as decoded from JSON
+func stringClaims(values []string) []any {
+	claims := make([]any, len(values))
+	for i, value := range values {
+		claims[i] = value
+	}
+	return claims
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/internal/models"
+)
+
+func TestClientCertStrategy(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spiffeID, _ := url.Parse("spiffe://partner.example.com/billing")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(7),
+		Subject:      pkix.Name{CommonName: "billing", Organization: []string{"Partner"}},
+		DNSNames:     []string{"billing.partner.example.com"},
+		URIs:         []*url.URL{spiffeID},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	strategy, _ := NewClientCertStrategy()
+	request := func(state *tls.ConnectionState) *gin.Context {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+		ctx.Request.TLS = state
+		return ctx
+	}
+
+	if err := strategy.Authenticate(request(nil)); err == nil {
+		t.Fatal("expected plain HTTP requests to be rejected")
+	}
+	if err := strategy.Authenticate(request(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}})); err == nil {
+		t.Fatal("expected unverified certificates to be rejected")
+	}
+
+	ctx := request(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, VerifiedChains: [][]*x509.Certificate{{cert}}})
+	if err := strategy.Authenticate(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	headers := ctx.MustGet(constants.UPSTREAM_HEADERS).(http.Header)
+	if headers.Get(HeaderClientCertSPIFFEID) != spiffeID.String() || headers.Get(HeaderClientCertDNS) != "billing.partner.example.com" {
+		t.Fatalf("unexpected upstream headers %v", headers)
+	}
+	if headers.Get(HeaderClientCertSubject) != "CN=billing,O=Partner" {
+		t.Fatalf("unexpected subject %q", headers.Get(HeaderClientCertSubject))
+	}
+
+	allow := func(values ...string) *models.Requirements {
+		return &models.Requirements{Claims: []models.ClaimRequirement{{Name: "spiffe_id", Values: values}}}
+	}
+	if err := Authorize(ctx, allow(spiffeID.String())); err != nil {
+		t.Fatalf("expected the allowlisted SPIFFE ID to be authorized, got %v", err)
+	}
+	if err := Authorize(ctx, allow("spiffe://partner.example.com/shipping")); err == nil {
+		t.Fatal("expected a SPIFFE ID outside the allowlist to be denied")
+	}
+}
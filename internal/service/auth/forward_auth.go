@@ -0,0 +1,288 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/goutils/cache"
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+const (
+	defaultForwardAuthTimeout = 5 * time.Second
+
+	// forwardAuthCachePrefix keeps the cached answers apart from the other entries of the cache
+	forwardAuthCachePrefix = "forward_auth:"
+	// maxForwardAuthBodyBytes bounds how much of the answer's body is read before it is dropped
+	maxForwardAuthBodyBytes = 64 << 10
+)
+
+// Request attributes answers may be cached by, header:, cookie: and query: are followed by a name
+const (
+	CacheKeyMethod   = "method"
+	CacheKeyPath     = "path"
+	CacheKeyHost     = "host"
+	CacheKeyClientIP = "client_ip"
+	CacheKeyHeader   = "header:"
+	CacheKeyCookie   = "cookie:"
+	CacheKeyQuery    = "query:"
+)
+
+// defaultForwardAuthHeaders are the headers of the request sent to the authorization service
+var defaultForwardAuthHeaders = []string{"Authorization", "Cookie"}
+
+// challengeHeaders are the headers of a rejection sent back to the client
+var challengeHeaders = []string{"WWW-Authenticate", "Location"}
+
+// ForwardAuthConfig configures the authorization of requests by an external service
+type ForwardAuthConfig struct {
+	URL             string        `yaml:"URL"`             // endpoint asked whether a request is allowed
+	Timeout         time.Duration `yaml:"Timeout"`         // how long to wait for the answer, default 5s
+	RequestHeaders  []string      `yaml:"RequestHeaders"`  // headers of the request sent along, default Authorization and Cookie
+	ResponseHeaders []string      `yaml:"ResponseHeaders"` // headers of an allowing answer copied into the upstream request
+	CacheTTL        time.Duration `yaml:"CacheTTL"`        // how long answers are cached, not cached when 0 or without a cache
+	CacheKey        []string      `yaml:"CacheKey"`        // request attributes answers are cached by, default method, path and the RequestHeaders
+}
+
+// StatusError is an authentication failure answered with its own status instead of 401, or with headers
+// like the challenge of a 401 or the Location of a redirect
+type StatusError struct {
+	Status int
+	Header http.Header // sent along only when the request is rejected with Status
+	Err    error
+}
+
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// ForwardAuthStrategy asks an external service whether a request is allowed, like nginx's auth_request.
+// The service gets a GET with the request's method, URI and selected headers. A 2xx answer allows the
+// request and any other rejects it with the answered status.
+type ForwardAuthStrategy struct {
+	url             string
+	requestHeaders  []string
+	responseHeaders []string
+	cacheTTL        time.Duration
+	cacheKey        []string
+	cache           cache.Cache
+	client          *http.Client
+}
+
+// forwardAuthAnswer is the part of the service's answer the strategy acts on, and caches
+type forwardAuthAnswer struct {
+	Status  int                 `json:"status"`
+	Headers map[string][]string `json:"headers"`
+}
+
+func NewForwardAuthStrategy(config *ForwardAuthConfig, cache cache.Cache) (Strategy, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("forward auth strategy requires an absolute URL, got %q", config.URL)
+	}
+	s := &ForwardAuthStrategy{
+		url:             config.URL,
+		requestHeaders:  config.RequestHeaders,
+		responseHeaders: config.ResponseHeaders,
+		cacheTTL:        config.CacheTTL,
+		cacheKey:        config.CacheKey,
+		cache:           cache,
+		client:          &http.Client{Timeout: config.Timeout},
+	}
+	if len(s.requestHeaders) == 0 {
+		s.requestHeaders = defaultForwardAuthHeaders
+	}
+	if s.client.Timeout <= 0 {
+		s.client.Timeout = defaultForwardAuthTimeout
+	}
+	// don't follow redirects, they are answers for the client
+	s.client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	if len(s.cacheKey) == 0 {
+		s.cacheKey = []string{CacheKeyMethod, CacheKeyPath}
+		for _, header := range s.requestHeaders {
+			s.cacheKey = append(s.cacheKey, CacheKeyHeader+header)
+		}
+	}
+	for _, attr := range s.cacheKey {
+		if err := validateCacheKey(attr); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func validateCacheKey(attr string) error {
+	switch attr {
+	case CacheKeyMethod, CacheKeyPath, CacheKeyHost, CacheKeyClientIP:
+		return nil
+	}
+	for _, prefix := range []string{CacheKeyHeader, CacheKeyCookie, CacheKeyQuery} {
+		if name, ok := strings.CutPrefix(attr, prefix); ok && name != "" {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid forward auth cache key %q: must be %s, %s, %s, %s or %s, %s or %s followed by a name",
+		attr, CacheKeyMethod, CacheKeyPath, CacheKeyHost, CacheKeyClientIP, CacheKeyHeader, CacheKeyCookie, CacheKeyQuery)
+}
+
+func (s *ForwardAuthStrategy) Authenticate(ctx *gin.Context) error {
+	// the whitelisted headers only ever come from the service, never from the client
+	for _, name := range s.responseHeaders {
+		ctx.Request.Header.Del(name)
+	}
+
+	key := s.key(ctx.Request)
+	answer, cached := s.cached(ctx, key)
+	if !cached {
+		var err error
+		answer, err = s.ask(ctx.Request)
+		if err != nil {
+			return &StatusError{Status: http.StatusServiceUnavailable, Err: err}
+		}
+		// server errors are not cached so the next request asks again
+		if s.cache != nil && s.cacheTTL > 0 && answer.Status < http.StatusInternalServerError {
+			if err := s.cache.SetWithTimeout(ctx, key, answer, s.cacheTTL); err != nil {
+				logger.Warn(ctx, "Failed to cache forward auth answer: %v", err)
+			}
+		}
+	}
+
+	if answer.Status < 200 || answer.Status > 299 {
+		challenge := http.Header{}
+		for _, name := range challengeHeaders {
+			for _, value := range answer.Headers[http.CanonicalHeaderKey(name)] {
+				challenge.Add(name, value)
+			}
+		}
+		return &StatusError{Status: answer.Status, Header: challenge, Err: fmt.Errorf("forward auth rejected the request with status %d", answer.Status)}
+	}
+
+	headers := http.Header{}
+	for _, name := range s.responseHeaders {
+		for _, value := range answer.Headers[http.CanonicalHeaderKey(name)] {
+			headers.Add(name, value)
+		}
+	}
+	AddUpstreamHeaders(ctx, headers)
+	return nil
+}
+
+// ask sends the subrequest to the authorization service
+func (s *ForwardAuthStrategy) ask(r *http.Request) (*forwardAuthAnswer, error) {
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid forward auth request: %w", err)
+	}
+	for _, name := range s.requestHeaders {
+		for _, value := range r.Header.Values(name) {
+			req.Header.Add(name, value)
+		}
+	}
+	req.Header.Set("X-Original-Method", r.Method)
+	req.Header.Set("X-Original-URI", r.URL.RequestURI())
+	req.Header.Set("X-Forwarded-Host", r.Host)
+	req.Header.Set("X-Forwarded-For", utils.GetClientIP(r))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("forward auth request failed: %w", err)
+	}
+	// drain so the connection is reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxForwardAuthBodyBytes))
+	resp.Body.Close()
+
+	answer := &forwardAuthAnswer{Status: resp.StatusCode, Headers: make(map[string][]string)}
+	names := challengeHeaders
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		names = s.responseHeaders
+	}
+	for _, name := range names {
+		if values := resp.Header.Values(name); len(values) > 0 {
+			answer.Headers[http.CanonicalHeaderKey(name)] = values
+		}
+	}
+	return answer, nil
+}
+
+// cached returns the cached answer for the key, if any
+func (s *ForwardAuthStrategy) cached(ctx context.Context, key string) (*forwardAuthAnswer, bool) {
+	if s.cache == nil || s.cacheTTL <= 0 {
+		return nil, false
+	}
+	answer := &forwardAuthAnswer{}
+	if err := s.cache.GetV(ctx, key, answer); err != nil {
+		if !isCacheMiss(err) {
+			logger.Warn(ctx, "Failed to read cached forward auth answer: %v", err)
+		}
+		return nil, false
+	}
+	return answer, answer.Status != 0
+}
+
+// key hashes the request attributes answers are cached by, so credentials never show up in the cache
+func (s *ForwardAuthStrategy) key(r *http.Request) string {
+	hash := sha256.New()
+	for _, attr := range s.cacheKey {
+		var value string
+		switch {
+		case attr == CacheKeyMethod:
+			value = r.Method
+		case attr == CacheKeyPath:
+			value = r.URL.Path
+		case attr == CacheKeyHost:
+			value = r.Host
+		case attr == CacheKeyClientIP:
+			value = utils.GetClientIP(r)
+		case strings.HasPrefix(attr, CacheKeyHeader):
+			value = strings.Join(r.Header.Values(strings.TrimPrefix(attr, CacheKeyHeader)), ",")
+		case strings.HasPrefix(attr, CacheKeyCookie):
+			if cookie, err := r.Cookie(strings.TrimPrefix(attr, CacheKeyCookie)); err == nil {
+				value = cookie.Value
+			}
+		case strings.HasPrefix(attr, CacheKeyQuery):
+			value = r.URL.Query().Get(strings.TrimPrefix(attr, CacheKeyQuery))
+		}
+		fmt.Fprintf(hash, "%s=%d:%s\n", attr, len(value), value)
+	}
+	return forwardAuthCachePrefix + s.url + ":" + hex.EncodeToString(hash.Sum(nil))
+}
+
+// AddUpstreamHeaders adds headers set on the request once it is sent upstream, after the gateway
+// removed the client's user headers
+func AddUpstreamHeaders(ctx *gin.Context, headers http.Header) {
+	if len(headers) == 0 {
+		return
+	}
+	existing, _ := ctx.Get(constants.UPSTREAM_HEADERS)
+	merged, _ := existing.(http.Header)
+	if merged == nil {
+		merged = http.Header{}
+	}
+	for name, values := range headers {
+		for _, value := range values {
+			merged.Add(name, value)
+		}
+	}
+	ctx.Set(constants.UPSTREAM_HEADERS, merged)
+}
+
+// isCacheMiss reports whether the cache error only means the key isn't cached
+func isCacheMiss(err error) bool {
+	return err.Error() == "redis: nil" || err.Error() == "not found"
+}
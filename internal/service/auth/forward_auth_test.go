@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/opengate/internal/constants"
+)
+
+func TestForwardAuthStrategy(t *testing.T) {
+	authService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Original-Method") != http.MethodPost || r.Header.Get("X-Original-URI") != "/orders?id=1" {
+			t.Errorf("unexpected original request %s %s", r.Header.Get("X-Original-Method"), r.Header.Get("X-Original-URI"))
+		}
+		if r.Header.Get("Cookie") != "" {
+			t.Errorf("expected only the configured headers to be sent, got cookie %q", r.Header.Get("Cookie"))
+		}
+		switch r.Header.Get("Authorization") {
+		case "Bearer good":
+			w.Header().Set("X-Auth-User", "alice")
+			w.Header().Set("X-Internal", "secret")
+			w.WriteHeader(http.StatusNoContent)
+		case "Bearer forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.Header().Set("WWW-Authenticate", `Bearer realm="orders"`)
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer authService.Close()
+
+	strategy, err := NewForwardAuthStrategy(&ForwardAuthConfig{
+		URL:             authService.URL,
+		RequestHeaders:  []string{"Authorization"},
+		ResponseHeaders: []string{"X-Auth-User"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		token         string
+		wantStatus    int
+		wantUser      string
+		wantChallenge string
+	}{
+		{"allowed", "good", 0, "alice", ""},
+		{"forbidden", "forbidden", http.StatusForbidden, "", ""},
+		{"unauthenticated", "bad", http.StatusUnauthorized, "", `Bearer realm="orders"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/orders?id=1", nil)
+			ctx.Request.Header.Set("Authorization", "Bearer "+tt.token)
+			ctx.Request.Header.Set("Cookie", "session=1")
+			ctx.Request.Header.Set("X-Auth-User", "spoofed")
+
+			err := strategy.Authenticate(ctx)
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else {
+				var statusErr *StatusError
+				if !errors.As(err, &statusErr) || statusErr.Status != tt.wantStatus {
+					t.Fatalf("expected status %d, got %v", tt.wantStatus, err)
+				}
+				if challenge := statusErr.Header.Get("WWW-Authenticate"); challenge != tt.wantChallenge {
+					t.Fatalf("expected challenge %q, got %q", tt.wantChallenge, challenge)
+				}
+			}
+			if user := ctx.Request.Header.Get("X-Auth-User"); user != "" {
+				t.Fatalf("expected the client's X-Auth-User to be removed, got %q", user)
+			}
+			headers, _ := ctx.Value(constants.UPSTREAM_HEADERS).(http.Header)
+			if user := headers.Get("X-Auth-User"); user != tt.wantUser {
+				t.Fatalf("expected upstream user %q, got %q", tt.wantUser, user)
+			}
+			if headers.Get("X-Internal") != "" {
+				t.Fatal("expected headers outside the whitelist not to be copied")
+			}
+			if len(ctx.Writer.Header()) != 0 {
+				t.Fatalf("expected the challenge to be left to the manager, got %v", ctx.Writer.Header())
+			}
+		})
+	}
+}
+
+func TestForwardAuthCacheKey(t *testing.T) {
+	if _, err := NewForwardAuthStrategy(&ForwardAuthConfig{URL: "http://auth", CacheKey: []string{"header:"}}, nil); err == nil {
+		t.Fatal("expected a header cache key without a name to be rejected")
+	}
+
+	strategy, err := NewForwardAuthStrategy(&ForwardAuthConfig{URL: "http://auth", CacheKey: []string{"method", "cookie:session"}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s := strategy.(*ForwardAuthStrategy)
+	request := func(method, path, session string) *http.Request {
+		r := httptest.NewRequest(method, path, nil)
+		r.AddCookie(&http.Cookie{Name: "session", Value: session})
+		return r
+	}
+	if s.key(request(http.MethodGet, "/a", "1")) != s.key(request(http.MethodGet, "/b", "1")) {
+		t.Fatal("expected attributes outside the cache key to be ignored")
+	}
+	if s.key(request(http.MethodGet, "/a", "1")) == s.key(request(http.MethodGet, "/a", "2")) {
+		t.Fatal("expected different sessions to be cached apart")
+	}
+	if s.key(request(http.MethodGet, "/a", "1")) == s.key(request(http.MethodPost, "/a", "1")) {
+		t.Fatal("expected different methods to be cached apart")
+	}
+}
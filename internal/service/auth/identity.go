@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+// reservedHeaders can't be set by the identity of a route as the proxy owns them
+var reservedHeaders = []string{"Host", "Content-Length", "Transfer-Encoding", "Connection", "Upgrade", "Te", "Trailer"}
+
+// ClaimHeaderValue formats a claim as a header value: lists are joined by commas and objects sent as JSON
+func ClaimHeaderValue(value any) (string, bool) {
+	if s, ok := ClaimString(value); ok {
+		return s, s != ""
+	}
+	if list, ok := value.([]any); ok {
+		elements := make([]string, 0, len(list))
+		for _, element := range list {
+			if s, ok := ClaimString(element); ok {
+				elements = append(elements, s)
+			}
+		}
+		return strings.Join(elements, ","), len(elements) > 0
+	}
+	if value == nil {
+		return "", false
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// ValidateIdentity checks the claim and static headers of a route's identity
+func ValidateIdentity(identity *models.Identity) error {
+	if identity == nil {
+		return nil
+	}
+	for _, claimHeader := range identity.ClaimHeaders {
+		if claimHeader.Claim == "" {
+			return fmt.Errorf("claim is required for every identity claim header")
+		}
+		if err := validateHeaderName(claimHeader.Header); err != nil {
+			return err
+		}
+	}
+	for name := range identity.StaticHeaders {
+		if err := validateHeaderName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateHeaderName(name string) error {
+	if name == "" {
+		return fmt.Errorf("header name is required for every identity header")
+	}
+	for _, c := range name {
+		if c > 0x7e || c <= ' ' || strings.ContainsRune(`"(),/:;<=>?@[\]{}`, c) {
+			return fmt.Errorf("invalid identity header name %q", name)
+		}
+	}
+	if slices.Contains(reservedHeaders, http.CanonicalHeaderKey(name)) {
+		return fmt.Errorf("identity header %q is reserved", name)
+	}
+	return nil
+}
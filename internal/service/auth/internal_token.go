@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultInternalTokenTTL    = time.Minute
+	defaultInternalTokenIssuer = "opengate"
+	defaultInternalTokenHeader = "X-Gateway-Token"
+)
+
+// InternalTokenConfig configures the JWTs the gateway mints for the upstreams of the routes asking for them
+type InternalTokenConfig struct {
+	KeyFile        string        `yaml:"KeyFile"`        // PEM encoded RSA, P-256 or Ed25519 private key, generated at startup when empty
+	PublicKeyFiles []string      `yaml:"PublicKeyFiles"` // more PEM public keys published in the JWKS, like the previous key while rotating
+	Issuer         string        `yaml:"Issuer"`         // iss of the tokens, default opengate
+	Audience       string        `yaml:"Audience"`       // aud of the tokens, the name of the route when empty
+	TTL            time.Duration `yaml:"TTL"`            // lifetime of the tokens, default 1m
+	Header         string        `yaml:"Header"`         // header the token is sent in, default X-Gateway-Token
+}
+
+// TokenMinter signs short-lived JWTs holding the verified claims of a request, so upstreams can check
+// the identity of the client cryptographically instead of trusting the X-User-* headers. The public
+// keys are published as a JWK set, the key id of a key being its RFC 7638 thumbprint.
+type TokenMinter struct {
+	key      crypto.Signer
+	method   jwt.SigningMethod
+	kid      string
+	jwks     []byte
+	issuer   string
+	audience string
+	ttl      time.Duration
+	header   string
+}
+
+func NewTokenMinter(ctx context.Context, config *InternalTokenConfig) (*TokenMinter, error) {
+	m := &TokenMinter{
+		issuer:   config.Issuer,
+		audience: config.Audience,
+		ttl:      config.TTL,
+		header:   config.Header,
+	}
+	if m.issuer == "" {
+		m.issuer = defaultInternalTokenIssuer
+	}
+	if m.ttl <= 0 {
+		m.ttl = defaultInternalTokenTTL
+	}
+	if m.header == "" {
+		m.header = defaultInternalTokenHeader
+	}
+
+	if config.KeyFile != "" {
+		key, err := loadPrivateKey(config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		m.key = key
+	} else {
+		// tokens minted by other instances can't be verified with this key, set KeyFile when running several
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate internal token key: %w", err)
+		}
+		m.key = key
+		logger.Debug(ctx, "No internal token KeyFile, signing internal tokens with a key generated at startup")
+	}
+	m.method = signingMethod(m.key)
+	if m.method == nil {
+		return nil, fmt.Errorf("unsupported internal token key type %T: must be RSA, P-256 or Ed25519", m.key)
+	}
+
+	signing, err := publicJWK(m.key.Public())
+	if err != nil {
+		return nil, err
+	}
+	m.kid = signing.Kid
+	keys := []jwk{signing}
+	for _, file := range config.PublicKeyFiles {
+		key, err := loadPublicKey(file)
+		if err != nil {
+			return nil, err
+		}
+		published, err := publicJWK(key)
+		if err != nil {
+			return nil, err
+		}
+		if published.Kid != m.kid {
+			keys = append(keys, published)
+		}
+	}
+	m.jwks, err = json.Marshal(map[string][]jwk{"keys": keys})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JWKS: %w", err)
+	}
+	return m, nil
+}
+
+// Header returns the header upstreams get the token in
+func (m *TokenMinter) Header() string {
+	return m.header
+}
+
+// JWKS returns the JWK set of the public keys tokens are verified with
+func (m *TokenMinter) JWKS() []byte {
+	return m.jwks
+}
+
+// Mint signs a token holding the claims for the route, its registered claims are set by the gateway
+func (m *TokenMinter) Mint(claims map[string]any, route string) (string, error) {
+	token := make(jwt.MapClaims, len(claims)+6)
+	for name, value := range claims {
+		token[name] = value
+	}
+	if _, ok := token["sub"]; !ok {
+		if subject, ok := ClaimString(token["userUUID"]); ok && subject != "" {
+			token["sub"] = subject
+		} else if subject, ok := ClaimString(token["userId"]); ok && subject != "0" {
+			token["sub"] = subject
+		}
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate token id: %w", err)
+	}
+	now := time.Now()
+	token["iss"] = m.issuer
+	token["aud"] = m.audience
+	if m.audience == "" {
+		token["aud"] = route
+	}
+	token["iat"] = now.Unix()
+	token["nbf"] = now.Unix()
+	token["exp"] = now.Add(m.ttl).Unix()
+	token["jti"] = hex.EncodeToString(id)
+
+	t := jwt.NewWithClaims(m.method, token)
+	t.Header["kid"] = m.kid
+	return t.SignedString(m.key)
+}
+
+// signingMethod returns the algorithm tokens are signed with by the key, nil when it isn't supported
+func signingMethod(key crypto.Signer) jwt.SigningMethod {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return jwt.SigningMethodRS256
+	case *ecdsa.PrivateKey:
+		if k.Curve == elliptic.P256() {
+			return jwt.SigningMethodES256
+		}
+	case ed25519.PrivateKey:
+		return jwt.SigningMethodEdDSA
+	}
+	return nil
+}
+
+// publicJWK encodes a public key as a JWK, with its thumbprint as key id
+func publicJWK(key any) (jwk, error) {
+	var k jwk
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		k = jwk{Kty: "RSA", Alg: jwt.SigningMethodRS256.Alg(), N: encodeSegment(pub.N.Bytes()), E: encodeSegment(big.NewInt(int64(pub.E)).Bytes())}
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return jwk{}, fmt.Errorf("unsupported curve %s: must be P-256", pub.Curve.Params().Name)
+		}
+		k = jwk{Kty: "EC", Alg: jwt.SigningMethodES256.Alg(), Crv: "P-256", X: encodeSegment(pub.X.FillBytes(make([]byte, 32))), Y: encodeSegment(pub.Y.FillBytes(make([]byte, 32)))}
+	case ed25519.PublicKey:
+		k = jwk{Kty: "OKP", Alg: jwt.SigningMethodEdDSA.Alg(), Crv: "Ed25519", X: encodeSegment(pub)}
+	default:
+		return jwk{}, fmt.Errorf("unsupported public key type %T", key)
+	}
+	k.Use = "sig"
+
+	// the thumbprint hashes the required members of the key in lexicographic order
+	var members string
+	switch k.Kty {
+	case "RSA":
+		members = fmt.Sprintf(`{"e":%q,"kty":%q,"n":%q}`, k.E, k.Kty, k.N)
+	case "EC":
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q,"y":%q}`, k.Crv, k.Kty, k.X, k.Y)
+	case "OKP":
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, k.Crv, k.Kty, k.X)
+	}
+	thumbprint := sha256.Sum256([]byte(members))
+	k.Kid = encodeSegment(thumbprint[:])
+	return k, nil
+}
+
+func encodeSegment(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// loadPrivateKey reads a PEM encoded PKCS #8, PKCS #1 or SEC 1 private key
+func loadPrivateKey(path string) (crypto.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("private key file %s is not PEM encoded", path)
+	}
+
+	var key any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
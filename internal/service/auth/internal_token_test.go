@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTokenMinterTokensVerifyWithItsJWKS(t *testing.T) {
+	dir := t.TempDir()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(dir, "key.pem")
+	os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}), 0o600)
+
+	// the previous key of a rotation stays published
+	previous, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKIXPublicKey(&previous.PublicKey)
+	previousFile := filepath.Join(dir, "previous.pem")
+	os.WriteFile(previousFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	minter, err := NewTokenMinter(ctx, &InternalTokenConfig{KeyFile: keyFile, PublicKeyFiles: []string{previousFile}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keys, err := parseJWKS(minter.JWKS())
+	if err != nil || len(keys) != 2 {
+		t.Fatalf("expected both keys to be published, got %v %v", keys, err)
+	}
+
+	token, err := minter.Mint(map[string]any{"userId": float64(42), "userUUID": "u-42", "org": map[string]any{"tenant": "acme"}}, "orders")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// upstreams verify it like any JWT, with the published keys
+	jwksFile := filepath.Join(dir, "jwks.json")
+	os.WriteFile(jwksFile, minter.JWKS(), 0o600)
+	strategy, err := NewJWTStrategy(ctx, &JWTConfig{JWKSFile: jwksFile, Issuer: "opengate", Audience: []string{"orders"}}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	authed, err := authenticate(strategy, token)
+	if err != nil {
+		t.Fatalf("expected the minted token to verify, got %v", err)
+	}
+	claims := Claims(authed)
+	if tenant, _ := LookupClaim(claims, "org.tenant"); tenant != "acme" || claims["sub"] != "u-42" {
+		t.Fatalf("expected the verified claims in the token, got %v", claims)
+	}
+
+	// a token minted for another route is refused
+	other, _ := minter.Mint(map[string]any{"userId": float64(42)}, "billing")
+	if _, err := authenticate(strategy, other); err == nil {
+		t.Fatal("expected a token minted for another route to be rejected")
+	}
+}
+
+func TestTokenMinterGeneratesAKey(t *testing.T) {
+	minter, err := NewTokenMinter(context.Background(), &InternalTokenConfig{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keys, err := parseJWKS(minter.JWKS())
+	if err != nil || len(keys) != 1 || keys[0].kid != minter.kid || keys[0].alg != "ES256" {
+		t.Fatalf("expected the generated key to be published, got %v %v", keys, err)
+	}
+	if minter.Header() != defaultInternalTokenHeader {
+		t.Fatalf("expected the default header, got %s", minter.Header())
+	}
+}
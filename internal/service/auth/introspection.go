@@ -0,0 +1,283 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/goutils/cache"
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultIntrospectionTimeout          = 5 * time.Second
+	defaultIntrospectionInactiveCacheTTL = time.Minute
+	defaultIntrospectionSubjectField     = "sub"
+	defaultIntrospectionScopeField       = "scope"
+
+	// introspectionCachePrefix keeps the cached answers apart from the other entries of the cache
+	introspectionCachePrefix = "introspection:"
+	// maxIntrospectionBodyBytes bounds the size of an introspection answer
+	maxIntrospectionBodyBytes = 1 << 20
+)
+
+// Ways the gateway authenticates to the introspection endpoint
+const (
+	ClientSecretBasic = "client_secret_basic"
+	ClientSecretPost  = "client_secret_post"
+)
+
+// IntrospectionConfig configures the validation of opaque tokens with an OAuth2 introspection endpoint (RFC 7662)
+type IntrospectionConfig struct {
+	URL              string        `yaml:"URL"`              // introspection endpoint
+	ClientID         string        `yaml:"ClientID"`         // client the gateway calls the endpoint as
+	ClientSecret     string        `yaml:"ClientSecret"`     // secret of the client
+	AuthMethod       string        `yaml:"AuthMethod"`       // client_secret_basic (default) or client_secret_post
+	TokenTypeHint    string        `yaml:"TokenTypeHint"`    // sent as token_type_hint when set
+	Timeout          time.Duration `yaml:"Timeout"`          // how long to wait for the endpoint, default 5s
+	MaxCacheTTL      time.Duration `yaml:"MaxCacheTTL"`      // caps how long active tokens are cached, until their exp when 0
+	InactiveCacheTTL time.Duration `yaml:"InactiveCacheTTL"` // how long inactive tokens are cached, default 1m
+	SubjectField     string        `yaml:"SubjectField"`     // field sent as the user UUID, default sub
+	ScopeField       string        `yaml:"ScopeField"`       // field sent as the permissions, default scope
+	UserIDField      string        `yaml:"UserIDField"`      // numeric field sent as the user id, none when empty
+	ProfileIDsField  string        `yaml:"ProfileIDsField"`  // field listing the profile ids, none when empty
+}
+
+// IntrospectionStrategy authenticates opaque access tokens by asking the identity provider that
+// issued them. Answers are cached, active tokens until they expire.
+type IntrospectionStrategy struct {
+	url              string
+	clientID         string
+	clientSecret     string
+	authMethod       string
+	tokenTypeHint    string
+	maxCacheTTL      time.Duration
+	inactiveCacheTTL time.Duration
+	subjectField     string
+	scopeField       string
+	userIDField      string
+	profileIDsField  string
+	cache            cache.Cache
+	client           *http.Client
+}
+
+func NewIntrospectionStrategy(config *IntrospectionConfig, cache cache.Cache) (Strategy, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("introspection strategy requires an absolute URL, got %q", config.URL)
+	}
+	s := &IntrospectionStrategy{
+		url:              config.URL,
+		clientID:         config.ClientID,
+		clientSecret:     config.ClientSecret,
+		authMethod:       config.AuthMethod,
+		tokenTypeHint:    config.TokenTypeHint,
+		maxCacheTTL:      config.MaxCacheTTL,
+		inactiveCacheTTL: config.InactiveCacheTTL,
+		subjectField:     config.SubjectField,
+		scopeField:       config.ScopeField,
+		userIDField:      config.UserIDField,
+		profileIDsField:  config.ProfileIDsField,
+		cache:            cache,
+		client:           &http.Client{Timeout: config.Timeout},
+	}
+	switch s.authMethod {
+	case "":
+		s.authMethod = ClientSecretBasic
+	case ClientSecretBasic, ClientSecretPost:
+	default:
+		return nil, fmt.Errorf("invalid introspection auth method %q: must be %s or %s", s.authMethod, ClientSecretBasic, ClientSecretPost)
+	}
+	if s.client.Timeout <= 0 {
+		s.client.Timeout = defaultIntrospectionTimeout
+	}
+	if s.inactiveCacheTTL <= 0 {
+		s.inactiveCacheTTL = defaultIntrospectionInactiveCacheTTL
+	}
+	if s.subjectField == "" {
+		s.subjectField = defaultIntrospectionSubjectField
+	}
+	if s.scopeField == "" {
+		s.scopeField = defaultIntrospectionScopeField
+	}
+	return s, nil
+}
+
+func (s *IntrospectionStrategy) Authenticate(ctx *gin.Context) error {
+	token := strings.TrimPrefix(requestToken(ctx), "Bearer ")
+	if token == "" {
+		return fmt.Errorf("missing token")
+	}
+
+	key := introspectionCachePrefix + hashToken(token)
+	answer, cached := s.cached(ctx, key)
+	if !cached {
+		var err error
+		answer, err = s.introspect(ctx.Request.Context(), token)
+		if err != nil {
+			return &StatusError{Status: http.StatusServiceUnavailable, Err: err}
+		}
+		s.store(ctx, key, answer)
+	}
+
+	if active, _ := answer["active"].(bool); !active {
+		return fmt.Errorf("token is not active")
+	}
+	expiresAt, hasExpiry := claimTime(answer["exp"])
+	if hasExpiry && expiresAt.Before(time.Now()) {
+		return fmt.Errorf("token is expired")
+	}
+
+	// Store the identity the endpoint answered with in gin context, like the claims of a JWT
+	claims := &jwtutils.JWTClaims{}
+	if sub, ok := LookupClaim(answer, s.subjectField); ok {
+		claims.UserUUID, _ = ClaimString(sub)
+		claims.Subject = claims.UserUUID
+	}
+	if scope, ok := LookupClaim(answer, s.scopeField); ok {
+		claims.Permissions = claimStrings(scope)
+	}
+	if s.userIDField != "" {
+		if userID, ok := LookupClaim(answer, s.userIDField); ok {
+			claims.UserID, _ = claimInt(userID)
+		}
+	}
+	if s.profileIDsField != "" {
+		if profileIDs, ok := LookupClaim(answer, s.profileIDsField); ok {
+			for _, id := range claimStrings(profileIDs) {
+				if profileID, err := strconv.ParseInt(id, 10, 64); err == nil {
+					claims.Profiles = append(claims.Profiles, jwtutils.Profile{Id: profileID})
+				}
+			}
+		}
+	}
+	if hasExpiry {
+		claims.ExpiresAt = jwt.NewNumericDate(expiresAt)
+	}
+	setClaims(ctx, claims, answer)
+	return nil
+}
+
+// introspect asks the endpoint about the token
+func (s *IntrospectionStrategy) introspect(ctx context.Context, token string) (map[string]any, error) {
+	form := url.Values{"token": {token}}
+	if s.tokenTypeHint != "" {
+		form.Set("token_type_hint", s.tokenTypeHint)
+	}
+	if s.authMethod == ClientSecretPost {
+		form.Set("client_id", s.clientID)
+		form.Set("client_secret", s.clientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("invalid introspection request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if s.authMethod == ClientSecretBasic && s.clientID != "" {
+		// RFC 6749 form-encodes the credentials before they're put in the header
+		req.SetBasicAuth(url.QueryEscape(s.clientID), url.QueryEscape(s.clientSecret))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("introspection request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, maxIntrospectionBodyBytes))
+		return nil, fmt.Errorf("introspection endpoint responded %s", resp.Status)
+	}
+
+	var answer map[string]any
+	decoder := json.NewDecoder(io.LimitReader(resp.Body, maxIntrospectionBodyBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&answer); err != nil {
+		return nil, fmt.Errorf("failed to parse introspection answer: %w", err)
+	}
+	if _, ok := answer["active"].(bool); !ok {
+		return nil, fmt.Errorf("introspection answer has no active field")
+	}
+	return answer, nil
+}
+
+// cached returns the cached answer for the key, if any
+func (s *IntrospectionStrategy) cached(ctx context.Context, key string) (map[string]any, bool) {
+	if s.cache == nil {
+		return nil, false
+	}
+	var answer map[string]any
+	if err := s.cache.GetV(ctx, key, &answer); err != nil {
+		if !isCacheMiss(err) {
+			logger.Warn(ctx, "Failed to read cached introspection answer: %v", err)
+		}
+		return nil, false
+	}
+	_, ok := answer["active"].(bool)
+	return answer, ok
+}
+
+// store caches an active answer until the token expires and an inactive one for the inactive TTL
+func (s *IntrospectionStrategy) store(ctx context.Context, key string, answer map[string]any) {
+	if s.cache == nil {
+		return
+	}
+	ttl := s.inactiveCacheTTL
+	if active, _ := answer["active"].(bool); active {
+		ttl = s.maxCacheTTL
+		if expiresAt, ok := claimTime(answer["exp"]); ok && (ttl <= 0 || time.Until(expiresAt) < ttl) {
+			ttl = time.Until(expiresAt)
+		}
+	} else {
+		// nothing but the verdict is kept for inactive tokens
+		answer = map[string]any{"active": false}
+	}
+	if ttl <= 0 {
+		return
+	}
+	if err := s.cache.SetWithTimeout(ctx, key, answer, ttl); err != nil {
+		logger.Warn(ctx, "Failed to cache introspection answer: %v", err)
+	}
+}
+
+// hashToken keeps tokens out of the cache keys
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// claimInt returns the integer of a numeric claim, or of a string holding one
+func claimInt(value any) (int64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), v == math.Trunc(v)
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// claimTime returns the time of a NumericDate claim like exp
+func claimTime(value any) (time.Time, bool) {
+	seconds, ok := claimInt(value)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0), true
+}
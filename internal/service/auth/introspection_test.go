@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+)
+
+func TestIntrospectionStrategy(t *testing.T) {
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, ok := r.BasicAuth(); !ok || id != "gateway" || secret != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		answer := map[string]any{"active": false}
+		switch r.PostFormValue("token") {
+		case "opaque-good":
+			answer = map[string]any{
+				"active":  true,
+				"sub":     "alice",
+				"scope":   "orders.read orders.write",
+				"user_id": "42",
+				"org":     map[string]any{"profiles": []any{3, 4}},
+				"exp":     time.Now().Add(time.Hour).Unix(),
+			}
+		case "opaque-expired":
+			answer = map[string]any{"active": true, "exp": time.Now().Add(-time.Minute).Unix()}
+		}
+		json.NewEncoder(w).Encode(answer)
+	}))
+	defer endpoint.Close()
+
+	strategy, err := NewIntrospectionStrategy(&IntrospectionConfig{
+		URL:             endpoint.URL,
+		ClientID:        "gateway",
+		ClientSecret:    "s3cret",
+		UserIDField:     "user_id",
+		ProfileIDsField: "org.profiles",
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, err := authenticate(strategy, "opaque-good")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	claims := ctx.MustGet(constants.JWT_CLAIMS).(*jwtutils.JWTClaims)
+	if claims.UserUUID != "alice" || claims.UserID != 42 {
+		t.Fatalf("expected user 42 alice, got %d %s", claims.UserID, claims.UserUUID)
+	}
+	if !slices.Equal(claims.Permissions, []string{"orders.read", "orders.write"}) {
+		t.Fatalf("expected the scopes as permissions, got %v", claims.Permissions)
+	}
+	if !claims.HasProfileId(3) || !claims.HasProfileId(4) {
+		t.Fatalf("expected profiles 3 and 4, got %v", claims.Profiles)
+	}
+
+	for _, token := range []string{"opaque-revoked", "opaque-expired"} {
+		if _, err := authenticate(strategy, token); err == nil {
+			t.Fatalf("expected %s to be rejected", token)
+		}
+	}
+
+	wrongClient, _ := NewIntrospectionStrategy(&IntrospectionConfig{URL: endpoint.URL, ClientID: "gateway"}, nil)
+	if _, err := authenticate(wrongClient, "opaque-good"); err == nil {
+		t.Fatal("expected the token to be rejected when the endpoint refuses the client")
+	}
+}
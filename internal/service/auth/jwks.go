@@ -0,0 +1,321 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultJWKSRefreshInterval = 10 * time.Minute
+	jwksFetchTimeout           = 5 * time.Second
+
+	// minJWKSRefreshInterval bounds how often a token signed with an unknown kid reloads the keys
+	minJWKSRefreshInterval = 30 * time.Second
+)
+
+// verificationKey is a public key, or an HMAC secret, tokens may be signed with
+type verificationKey struct {
+	kid string
+	alg string // empty when the key doesn't restrict its algorithm
+	key any    // *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey or []byte
+}
+
+// keySet holds the keys tokens are verified with. Keys loaded from a JWKS file or URL are reloaded
+// periodically and whenever a token names a kid the set doesn't know, so keys can be rotated.
+type keySet struct {
+	file   string
+	url    string
+	static []verificationKey // keys configured inline, always kept
+	client *http.Client
+
+	keys        atomic.Pointer[[]verificationKey]
+	mu          sync.Mutex // serializes reloads
+	lastRefresh time.Time
+}
+
+func newKeySet(ctx context.Context, cfg *JWTConfig) (*keySet, error) {
+	s := &keySet{
+		file:   cfg.JWKSFile,
+		url:    cfg.JWKSURL,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+	}
+	if cfg.Secret != "" {
+		s.static = append(s.static, verificationKey{alg: jwt.SigningMethodHS256.Alg(), key: []byte(cfg.Secret)})
+	}
+	if cfg.PublicKeyFile != "" {
+		key, err := loadPublicKey(cfg.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		s.static = append(s.static, verificationKey{key: key})
+	}
+	if s.file == "" && s.url == "" && len(s.static) == 0 {
+		return nil, fmt.Errorf("jwt strategy requires a JWKSFile, JWKSURL, PublicKeyFile or Secret")
+	}
+
+	s.keys.Store(&s.static)
+	if err := s.refresh(ctx); err != nil {
+		// an unreachable JWKS endpoint is retried in the background rather than failing the gateway
+		if s.url == "" {
+			return nil, err
+		}
+		logger.Warn(ctx, "Failed to load JWKS, it will be reloaded in the background: %v", err)
+	}
+	if s.file != "" || s.url != "" {
+		interval := cfg.RefreshInterval
+		if interval <= 0 {
+			interval = defaultJWKSRefreshInterval
+		}
+		go s.refreshEvery(ctx, interval)
+	}
+	return s, nil
+}
+
+// lookup returns the keys a token with the kid and algorithm may have been signed with. An unknown
+// kid reloads the keys, at most once every minJWKSRefreshInterval.
+func (s *keySet) lookup(ctx context.Context, kid, alg string) []verificationKey {
+	keys := matchingKeys(*s.keys.Load(), kid, alg)
+	if len(keys) > 0 || kid == "" || (s.file == "" && s.url == "") {
+		return keys
+	}
+
+	s.mu.Lock()
+	if time.Since(s.lastRefresh) >= minJWKSRefreshInterval {
+		if err := s.reload(ctx); err != nil {
+			logger.Warn(ctx, "Failed to reload JWKS for unknown kid %s: %v", kid, err)
+		}
+	}
+	s.mu.Unlock()
+	return matchingKeys(*s.keys.Load(), kid, alg)
+}
+
+func matchingKeys(keys []verificationKey, kid, alg string) []verificationKey {
+	var matched []verificationKey
+	for _, key := range keys {
+		if kid != "" && key.kid != "" && key.kid != kid {
+			continue
+		}
+		if key.alg != "" && key.alg != alg {
+			continue
+		}
+		if !keyFitsAlg(key.key, alg) {
+			continue
+		}
+		matched = append(matched, key)
+	}
+	return matched
+}
+
+// keyFitsAlg reports whether the key can verify signatures of the algorithm
+func keyFitsAlg(key any, alg string) bool {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return alg == jwt.SigningMethodRS256.Alg()
+	case *ecdsa.PublicKey:
+		return alg == jwt.SigningMethodES256.Alg() && k.Curve == elliptic.P256()
+	case ed25519.PublicKey:
+		return alg == jwt.SigningMethodEdDSA.Alg()
+	case []byte:
+		return alg == jwt.SigningMethodHS256.Alg()
+	}
+	return false
+}
+
+func (s *keySet) refreshEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.refresh(ctx); err != nil {
+				logger.Warn(ctx, "Failed to reload JWKS, keeping the previous keys: %v", err)
+			}
+		}
+	}
+}
+
+// refresh reloads the JWKS, keeping the previous keys when it fails
+func (s *keySet) refresh(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reload(ctx)
+}
+
+// reload is refresh with mu held
+func (s *keySet) reload(ctx context.Context) error {
+	s.lastRefresh = time.Now()
+
+	keys := append([]verificationKey(nil), s.static...)
+	if s.file != "" || s.url != "" {
+		data, err := s.read(ctx)
+		if err != nil {
+			return err
+		}
+		jwks, err := parseJWKS(data)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, jwks...)
+	}
+	s.keys.Store(&keys)
+	return nil
+}
+
+func (s *keySet) read(ctx context.Context) ([]byte, error) {
+	if s.file != "" {
+		data, err := os.ReadFile(s.file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+		}
+		return data, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWKS URL: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// jwk is a JSON Web Key as defined by RFC 7517
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	K   string `json:"k,omitempty"`
+}
+
+// parseJWKS parses the signature keys of a JWK set, skipping the keys of unsupported types
+func parseJWKS(data []byte) ([]verificationKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	keys := make([]verificationKey, 0, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWKS key %q: %w", k.Kid, err)
+		}
+		if key != nil {
+			keys = append(keys, verificationKey{kid: k.Kid, alg: k.Alg, key: key})
+		}
+	}
+	return keys, nil
+}
+
+// publicKey decodes the key, nil if its type isn't supported
+func (k *jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeSegment(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeSegment(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, nil
+		}
+		x, err := decodeSegment(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeSegment(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("point is not on curve P-256")
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, nil
+		}
+		x, err := decodeSegment(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key size %d", len(x))
+		}
+		return ed25519.PublicKey(x), nil
+	case "oct":
+		return decodeSegment(k.K)
+	}
+	return nil, nil
+}
+
+func decodeSegment(s string) ([]byte, error) {
+	if s == "" {
+		return nil, fmt.Errorf("missing key parameter")
+	}
+	return base64.RawURLEncoding.DecodeString(s)
+}
+
+// loadPublicKey reads a PEM encoded RSA, P-256 or Ed25519 public key
+func loadPublicKey(path string) (any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key file: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("public key file %s is not PEM encoded", path)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported public key type %T", key)
+}
@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/goutils/cache"
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/openauth/pkg/clients/openauth"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultRevocationTimeout = time.Second
+
+// supportedAlgorithms are the signature algorithms the JWT strategy verifies
+var supportedAlgorithms = []string{
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodES256.Alg(),
+	jwt.SigningMethodEdDSA.Alg(),
+	jwt.SigningMethodHS256.Alg(),
+}
+
+// JWTConfig configures the local verification of JWTs
+type JWTConfig struct {
+	JWKSFile          string        `yaml:"JWKSFile"`          // JWK set read from disk
+	JWKSURL           string        `yaml:"JWKSURL"`           // JWK set fetched over HTTP
+	RefreshInterval   time.Duration `yaml:"RefreshInterval"`   // how often the JWK set is reloaded, default 10m
+	PublicKeyFile     string        `yaml:"PublicKeyFile"`     // PEM encoded RSA, P-256 or Ed25519 public key
+	Secret            string        `yaml:"Secret"`            // HS256 shared secret
+	Algorithms        []string      `yaml:"Algorithms"`        // accepted algorithms, default RS256, ES256, EdDSA and HS256
+	Issuer            string        `yaml:"Issuer"`            // required iss, not checked when empty
+	Audience          []string      `yaml:"Audience"`          // aud must hold one of them, not checked when empty
+	Leeway            time.Duration `yaml:"Leeway"`            // clock skew allowed on exp and nbf
+	CheckRevocation   bool          `yaml:"CheckRevocation"`   // also ask OpenAuth whether the token was revoked
+	RevocationTimeout time.Duration `yaml:"RevocationTimeout"` // how long to wait for OpenAuth, default 1s
+}
+
+// JWTStrategy verifies the signature and claims of JWTs locally, so requests are authenticated
+// without a round trip to OpenAuth. OpenAuth is only asked about revocation when enabled, and a
+// slow or unavailable OpenAuth doesn't fail the request.
+type JWTStrategy struct {
+	keys              *keySet
+	parser            *jwt.Parser
+	revocation        *OpenAuthStrategy
+	revocationTimeout time.Duration
+}
+
+func NewJWTStrategy(ctx context.Context, config *JWTConfig, openAuthConfig *openauth.ClientConfig, cache cache.Cache) (Strategy, error) {
+	algorithms := config.Algorithms
+	if len(algorithms) == 0 {
+		algorithms = supportedAlgorithms
+	}
+	for _, alg := range algorithms {
+		if !slices.Contains(supportedAlgorithms, alg) {
+			return nil, fmt.Errorf("unsupported jwt algorithm %q: must be one of %s", alg, strings.Join(supportedAlgorithms, ", "))
+		}
+	}
+
+	keys, err := newKeySet(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(algorithms),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(config.Leeway),
+	}
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+	if len(config.Audience) > 0 {
+		options = append(options, jwt.WithAudience(config.Audience...))
+	}
+
+	s := &JWTStrategy{
+		keys:              keys,
+		parser:            jwt.NewParser(options...),
+		revocationTimeout: config.RevocationTimeout,
+	}
+	if s.revocationTimeout <= 0 {
+		s.revocationTimeout = defaultRevocationTimeout
+	}
+	if config.CheckRevocation {
+		revocation, err := NewOpenAuthStrategy(ctx, openAuthConfig, cache)
+		if err != nil {
+			return nil, err
+		}
+		s.revocation = revocation.(*OpenAuthStrategy)
+		s.revocation.cachePrefix = jwtRevocationCachePrefix
+	}
+	return s, nil
+}
+
+func (s *JWTStrategy) Authenticate(ctx *gin.Context) error {
+	token := requestToken(ctx)
+	if token == "" {
+		return fmt.Errorf("missing token")
+	}
+
+	claims := &jwtutils.JWTClaims{}
+	if _, err := s.parser.ParseWithClaims(strings.TrimPrefix(token, "Bearer "), claims, s.keyFunc(ctx.Request.Context())); err != nil {
+		return fmt.Errorf("invalid token: %w", err)
+	}
+
+	if s.revocation != nil {
+		if err := s.checkRevocation(ctx, token, claims.ExpiresAt.Time); err != nil {
+			return err
+		}
+	}
+
+	// Store claims in gin context, the token was verified so decoding it again can't fail
+	_, raw, _ := decodeClaims(token)
+	setClaims(ctx, claims, raw)
+	return nil
+}
+
+// keyFunc returns the keys matching the kid and algorithm of the token
+func (s *JWTStrategy) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		keys := s.keys.lookup(ctx, kid, token.Method.Alg())
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("no %s key found for kid %q", token.Method.Alg(), kid)
+		}
+		set := jwt.VerificationKeySet{Keys: make([]jwt.VerificationKey, 0, len(keys))}
+		for _, key := range keys {
+			set.Keys = append(set.Keys, key.key)
+		}
+		return set, nil
+	}
+}
+
+// checkRevocation asks OpenAuth whether the verified token is still valid. A valid token is cached until
+// it expires and a revoked one for a minute, and the token is accepted when OpenAuth doesn't answer in time.
+func (s *JWTStrategy) checkRevocation(ctx *gin.Context, token string, expiresAt time.Time) error {
+	if authenticated, err := s.revocation.isAuthenticatedInCache(token); err == nil {
+		if !authenticated {
+			return fmt.Errorf("token revoked")
+		}
+		return nil
+	}
+
+	reqContext, cancel := context.WithTimeout(ctx.Request.Context(), s.revocationTimeout)
+	defer cancel()
+	err := s.revocation.verify(reqContext, token, expiresAt)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.Unavailable, codes.DeadlineExceeded:
+		logger.Warn(ctx, "OpenAuth revocation check unavailable, accepting locally verified token: %v", err)
+		return nil
+	}
+	return fmt.Errorf("token revoked: %w", err)
+}
+
+func (s *JWTStrategy) Close() error {
+	if s.revocation != nil {
+		return s.revocation.Close()
+	}
+	return nil
+}
@@ -0,0 +1,253 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/goutils/cache"
+	"github.com/gofreego/openauth/api/openauth_v1"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func writeJWKS(t *testing.T, path string, keys ...map[string]string) {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"kid": kid,
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "EC",
+		"kid": kid,
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key any, claims *jwtutils.JWTClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func authenticate(strategy Strategy, token string) (*gin.Context, error) {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx.Request.Header.Set("Authorization", "Bearer "+token)
+	return ctx, strategy.Authenticate(ctx)
+}
+
+func TestJWTStrategyVerifiesLocally(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksFile, rsaJWK("rsa-1", &rsaKey.PublicKey), ecJWK("ec-1", &ecKey.PublicKey))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	strategy, err := NewJWTStrategy(ctx, &JWTConfig{
+		JWKSFile: jwksFile,
+		Secret:   "shared-secret",
+		Issuer:   "https://auth.example.com",
+		Audience: []string{"api", "admin"},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	claims := func(mutate func(*jwtutils.JWTClaims)) *jwtutils.JWTClaims {
+		c := &jwtutils.JWTClaims{
+			UserID: 42,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "https://auth.example.com",
+				Audience:  jwt.ClaimStrings{"api"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		}
+		if mutate != nil {
+			mutate(c)
+		}
+		return c
+	}
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"RS256 from JWKS", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claims(nil)), false},
+		{"ES256 from JWKS", signToken(t, jwt.SigningMethodES256, "ec-1", ecKey, claims(nil)), false},
+		{"HS256 secret", signToken(t, jwt.SigningMethodHS256, "", []byte("shared-secret"), claims(nil)), false},
+		{"unknown signer", signToken(t, jwt.SigningMethodRS256, "rsa-1", otherKey, claims(nil)), true},
+		{"unknown kid", signToken(t, jwt.SigningMethodRS256, "rsa-2", rsaKey, claims(nil)), true},
+		{"expired", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claims(func(c *jwtutils.JWTClaims) {
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+		})), true},
+		{"not yet valid", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claims(func(c *jwtutils.JWTClaims) {
+			c.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Minute))
+		})), true},
+		{"without expiry", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claims(func(c *jwtutils.JWTClaims) {
+			c.ExpiresAt = nil
+		})), true},
+		{"wrong issuer", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claims(func(c *jwtutils.JWTClaims) {
+			c.Issuer = "https://evil.example.com"
+		})), true},
+		{"wrong audience", signToken(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claims(func(c *jwtutils.JWTClaims) {
+			c.Audience = jwt.ClaimStrings{"billing"}
+		})), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := authenticate(strategy, tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil {
+				if claims, ok := ctx.Get(constants.JWT_CLAIMS); !ok || claims.(*jwtutils.JWTClaims).UserID != 42 {
+					t.Fatalf("expected the claims of user 42, got %v", claims)
+				}
+			}
+		})
+	}
+}
+
+func TestJWTStrategyPicksUpRotatedKeys(t *testing.T) {
+	oldKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	newKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksFile, rsaJWK("old", &oldKey.PublicKey))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	strategy, err := NewJWTStrategy(ctx, &JWTConfig{JWKSFile: jwksFile}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the key set was just loaded, so the unknown kid doesn't reload it yet
+	writeJWKS(t, jwksFile, rsaJWK("old", &oldKey.PublicKey), rsaJWK("new", &newKey.PublicKey))
+	claims := &jwtutils.JWTClaims{RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))}}
+	token := signToken(t, jwt.SigningMethodRS256, "new", newKey, claims)
+	if _, err := authenticate(strategy, token); err == nil {
+		t.Fatal("expected the unknown kid to be rejected before the reload interval")
+	}
+
+	strategy.(*JWTStrategy).keys.lastRefresh = time.Now().Add(-minJWKSRefreshInterval)
+	if _, err := authenticate(strategy, token); err != nil {
+		t.Fatalf("expected the rotated key to be loaded, got %v", err)
+	}
+}
+
+// memoryCache is a cache keeping the values in a map, ignoring their timeout
+type memoryCache struct {
+	cache.Cache
+	values map[string][]byte
+}
+
+func (c *memoryCache) GetV(ctx context.Context, key string, v any) error {
+	value, ok := c.values[key]
+	if !ok {
+		return errors.New("not found")
+	}
+	return json.Unmarshal(value, v)
+}
+
+func (c *memoryCache) SetWithTimeout(ctx context.Context, key string, v any, d time.Duration) error {
+	value, err := json.Marshal(v)
+	c.values[key] = value
+	return err
+}
+
+// revokingOpenAuth is an OpenAuth client answering every IsAuthenticated call with err
+type revokingOpenAuth struct {
+	openauth_v1.OpenAuthClient
+	err   error
+	calls int
+}
+
+func (c *revokingOpenAuth) IsAuthenticated(ctx context.Context, in *openauth_v1.IsAuthenticatedRequest, opts ...grpc.CallOption) (*openauth_v1.IsAuthenticatedResponse, error) {
+	c.calls++
+	return nil, c.err
+}
+
+func TestJWTStrategyCachesRevocation(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, jwksFile, rsaJWK("k1", &key.PublicKey))
+	strategy, err := NewJWTStrategy(context.Background(), &JWTConfig{JWKSFile: jwksFile}, nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := &revokingOpenAuth{err: status.Error(codes.Unavailable, "connection refused")}
+	strategy.(*JWTStrategy).revocation = &OpenAuthStrategy{client: client, cache: &memoryCache{values: map[string][]byte{}}, cachePrefix: jwtRevocationCachePrefix}
+	claims := &jwtutils.JWTClaims{RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))}}
+	token := signToken(t, jwt.SigningMethodRS256, "k1", key, claims)
+
+	// an unavailable OpenAuth neither rejects the token nor gets its failure cached
+	for i := 0; i < 2; i++ {
+		if _, err := authenticate(strategy, token); err != nil {
+			t.Fatalf("expected the token to be accepted while OpenAuth is unavailable, got %v", err)
+		}
+	}
+	if client.calls != 2 {
+		t.Fatalf("expected OpenAuth to be asked again, got %d calls", client.calls)
+	}
+
+	client.err = status.Error(codes.Unauthenticated, "token revoked")
+	for i := 0; i < 2; i++ {
+		if _, err := authenticate(strategy, token); err == nil {
+			t.Fatal("expected the revoked token to be rejected")
+		}
+	}
+	if client.calls != 3 {
+		t.Fatalf("expected the revocation to be answered from the cache, got %d calls", client.calls)
+	}
+}
@@ -2,35 +2,237 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofreego/goutils/cache"
 	"github.com/gofreego/openauth/pkg/clients/openauth"
+	"github.com/gofreego/opengate/internal/constants"
 )
 
+// Strategy names selectable with Config.Name, Config.Strategies and the strategies of a route
+const (
+	StrategyOpenAuth      = "OpenAuth"
+	StrategyJWT           = "JWT"
+	StrategyBasic         = "Basic"
+	StrategyAPIKey        = "APIKey"
+	StrategyForwardAuth   = "ForwardAuth"
+	StrategyIntrospection = "Introspection"
+	StrategyClientCert    = "ClientCert"
+	StrategyNone          = "None"
+)
+
+// Modes combining the strategies of a route
+const (
+	ModeAny = "any" // the first strategy that authenticates the request wins
+	ModeAll = "all" // every strategy must authenticate the request
+)
+
+// factory builds a strategy from the auth config
+type factory func(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (Strategy, error)
+
+// registry holds the strategies by name
+var registry = map[string]factory{
+	StrategyOpenAuth: func(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (Strategy, error) {
+		return NewOpenAuthStrategy(ctx, &config.OpenAuth, cache)
+	},
+	StrategyJWT: func(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (Strategy, error) {
+		return NewJWTStrategy(ctx, &config.JWT, &config.OpenAuth, cache)
+	},
+	StrategyBasic: func(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (Strategy, error) {
+		return NewBasicStrategy(&config.Basic)
+	},
+	StrategyAPIKey: func(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (Strategy, error) {
+		return NewAPIKeyStrategy(&config.APIKey, keys)
+	},
+	StrategyForwardAuth: func(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (Strategy, error) {
+		return NewForwardAuthStrategy(&config.ForwardAuth, cache)
+	},
+	StrategyIntrospection: func(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (Strategy, error) {
+		return NewIntrospectionStrategy(&config.Introspection, cache)
+	},
+	StrategyClientCert: func(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (Strategy, error) {
+		return NewClientCertStrategy()
+	},
+	StrategyNone: func(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (Strategy, error) {
+		return noneStrategy{}, nil
+	},
+}
+
 type Config struct {
-	Name     string                `yaml:"Name"`
-	OpenAuth openauth.ClientConfig `yaml:"OpenAuth"`
+	Name          string                `yaml:"Name"`       // default strategy of the routes, OpenAuth when empty
+	Strategies    []string              `yaml:"Strategies"` // other strategies routes may pick, None is always available
+	OpenAuth      openauth.ClientConfig `yaml:"OpenAuth"`
+	JWT           JWTConfig             `yaml:"JWT"`
+	Basic         BasicConfig           `yaml:"Basic"`
+	APIKey        APIKeyConfig          `yaml:"APIKey"`
+	ForwardAuth   ForwardAuthConfig     `yaml:"ForwardAuth"`
+	Introspection IntrospectionConfig   `yaml:"Introspection"`
 }
 
 type AuthManager interface {
-	Authenticate(ctx *gin.Context) error
+	// Authenticate authenticates the request with the strategies combined by mode, the default strategy when none are given
+	Authenticate(ctx *gin.Context, strategies []string, mode string) error
+	// Validate checks that the strategies are enabled and the mode is known
+	Validate(strategies []string, mode string) error
 }
 
 type manager struct {
-	strategy Strategy
+	defaultStrategy string
+	strategies      map[string]Strategy
+}
+
+// NewAuthManager builds the enabled strategies, keys is where the APIKey strategy looks keys up
+func NewAuthManager(ctx context.Context, config *Config, cache cache.Cache, keys APIKeyStore) (AuthManager, error) {
+	m := &manager{
+		defaultStrategy: config.Name,
+		strategies:      make(map[string]Strategy),
+	}
+	if m.defaultStrategy == "" {
+		m.defaultStrategy = StrategyOpenAuth
+	}
+
+	names := append([]string{m.defaultStrategy, StrategyNone}, config.Strategies...)
+	for _, name := range names {
+		if _, ok := m.strategies[name]; ok {
+			continue
+		}
+		newStrategy, ok := registry[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown auth strategy %q: must be one of %s", name, strings.Join(strategyNames(), ", "))
+		}
+		strategy, err := newStrategy(ctx, config, cache, keys)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s auth strategy: %w", name, err)
+		}
+		m.strategies[name] = strategy
+	}
+	return m, nil
 }
 
-func NewAuthManager(ctx context.Context, config *Config, cache cache.Cache) (AuthManager, error) {
-	strategy, err := NewOpenAuthStrategy(ctx, &config.OpenAuth, cache)
-	if err != nil {
-		return nil, err
+func (m *manager) Authenticate(ctx *gin.Context, strategies []string, mode string) error {
+	if len(strategies) == 0 {
+		strategies = []string{m.defaultStrategy}
 	}
-	return &manager{
-		strategy: strategy,
-	}, nil
+
+	var errs []error
+	var claims, tokenClaims any
+	for _, name := range strategies {
+		strategy, ok := m.strategies[name]
+		if !ok {
+			return fmt.Errorf("auth strategy %s is not enabled", name)
+		}
+		// only the claims of strategies that authenticated the request are kept
+		clearClaims(ctx)
+		if err := strategy.Authenticate(ctx); err != nil {
+			clearClaims(ctx)
+			if mode == ModeAll {
+				return reject(ctx, fmt.Errorf("%s: %w", name, err))
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		if mode != ModeAll {
+			return nil
+		}
+		// keep the claims of the first strategy providing them
+		if c, exists := ctx.Get(constants.JWT_CLAIMS); exists && claims == nil {
+			claims = c
+			tokenClaims, _ = ctx.Get(constants.TOKEN_CLAIMS)
+		}
+	}
+	if mode == ModeAll {
+		clearClaims(ctx)
+		if claims != nil {
+			ctx.Set(constants.JWT_CLAIMS, claims)
+		}
+		if tokenClaims != nil {
+			ctx.Set(constants.TOKEN_CLAIMS, tokenClaims)
+		}
+		return nil
+	}
+	return reject(ctx, errors.Join(errs...))
 }
 
-func (m *manager) Authenticate(ctx *gin.Context) error {
-	return m.strategy.Authenticate(ctx)
+// RejectionStatus returns the status a failed authentication is answered with: the first status other than
+// 401 a strategy asked for, 401 otherwise
+func RejectionStatus(err error) int {
+	for _, statusErr := range statusErrors(err) {
+		if statusErr.Status != http.StatusUnauthorized {
+			return statusErr.Status
+		}
+	}
+	return http.StatusUnauthorized
+}
+
+// reject sets the headers of the strategies asking for the status the request is rejected with, so the
+// challenge of a strategy never reaches the response of a request another strategy let through
+func reject(ctx *gin.Context, err error) error {
+	status := RejectionStatus(err)
+	for _, statusErr := range statusErrors(err) {
+		if statusErr.Status != status {
+			continue
+		}
+		for name, values := range statusErr.Header {
+			for _, value := range values {
+				ctx.Writer.Header().Add(name, value)
+			}
+		}
+	}
+	return err
+}
+
+// statusErrors returns the status errors of the failed strategies in order
+func statusErrors(err error) []*StatusError {
+	switch e := err.(type) {
+	case *StatusError:
+		return []*StatusError{e}
+	case interface{ Unwrap() []error }:
+		var statusErrs []*StatusError
+		for _, err := range e.Unwrap() {
+			statusErrs = append(statusErrs, statusErrors(err)...)
+		}
+		return statusErrs
+	case interface{ Unwrap() error }:
+		return statusErrors(e.Unwrap())
+	}
+	return nil
+}
+
+// clearClaims removes the claims a strategy stored in the gin context
+func clearClaims(ctx *gin.Context) {
+	delete(ctx.Keys, constants.JWT_CLAIMS)
+	delete(ctx.Keys, constants.TOKEN_CLAIMS)
+}
+
+func (m *manager) Validate(strategies []string, mode string) error {
+	if mode != "" && mode != ModeAny && mode != ModeAll {
+		return fmt.Errorf("invalid authentication mode %q: must be %s or %s", mode, ModeAny, ModeAll)
+	}
+	for _, name := range strategies {
+		if _, ok := m.strategies[name]; !ok {
+			return fmt.Errorf("auth strategy %q is not enabled on the gateway", name)
+		}
+	}
+	return nil
+}
+
+func strategyNames() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// noneStrategy lets every request through, for routes that opt out of the gateway's authentication
+type noneStrategy struct{}
+
+func (noneStrategy) Authenticate(ctx *gin.Context) error {
+	return nil
 }
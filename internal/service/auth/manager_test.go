@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/opengate/internal/constants"
+)
+
+// fakeStrategy authenticates when ok, setting its name as the claims
+type fakeStrategy struct {
+	name string
+	ok   bool
+}
+
+func (s fakeStrategy) Authenticate(ctx *gin.Context) error {
+	if !s.ok {
+		return fmt.Errorf("rejected")
+	}
+	ctx.Set(constants.JWT_CLAIMS, s.name)
+	return nil
+}
+
+func TestManagerCombinesStrategies(t *testing.T) {
+	m := &manager{
+		defaultStrategy: "accept",
+		strategies: map[string]Strategy{
+			"accept": fakeStrategy{name: "accept", ok: true},
+			"other":  fakeStrategy{name: "other", ok: true},
+			"reject": fakeStrategy{name: "reject"},
+		},
+	}
+	tests := []struct {
+		name       string
+		strategies []string
+		mode       string
+		wantErr    bool
+		wantClaims string
+	}{
+		{"default strategy", nil, "", false, "accept"},
+		{"any falls through to the next", []string{"reject", "other"}, ModeAny, false, "other"},
+		{"any of rejecting strategies", []string{"reject", "reject"}, "", true, ""},
+		{"all keeps the first claims", []string{"accept", "other"}, ModeAll, false, "accept"},
+		{"all with a rejecting strategy", []string{"accept", "reject"}, ModeAll, true, ""},
+		{"strategy not enabled", []string{"JWT"}, "", true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			err := m.Authenticate(ctx, tt.strategies, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if claims, _ := ctx.Get(constants.JWT_CLAIMS); !tt.wantErr && claims != tt.wantClaims {
+				t.Fatalf("expected claims %q, got %v", tt.wantClaims, claims)
+			}
+		})
+	}
+}
+
+// statusStrategy rejects every request with its status error
+type statusStrategy struct{ err *StatusError }
+
+func (s statusStrategy) Authenticate(ctx *gin.Context) error {
+	return s.err
+}
+
+func TestManagerSendsChallengesOnlyWithTheRejection(t *testing.T) {
+	m := &manager{
+		strategies: map[string]Strategy{
+			"accept": fakeStrategy{name: "accept", ok: true},
+			"basic":  statusStrategy{&StatusError{Status: http.StatusUnauthorized, Header: http.Header{"Www-Authenticate": {`Basic realm="opengate"`}}, Err: fmt.Errorf("missing basic credentials")}},
+			"login":  statusStrategy{&StatusError{Status: http.StatusFound, Header: http.Header{"Location": {"https://login.local"}}, Err: fmt.Errorf("redirected to login")}},
+		},
+	}
+	tests := []struct {
+		name       string
+		strategies []string
+		mode       string
+		wantStatus int
+		wantHeader http.Header
+	}{
+		{"accepted by a later strategy", []string{"basic", "login", "accept"}, ModeAny, 0, http.Header{}},
+		{"challenge of the rejection", []string{"basic"}, ModeAny, http.StatusUnauthorized, http.Header{"Www-Authenticate": {`Basic realm="opengate"`}}},
+		{"redirect wins over the challenge", []string{"basic", "login"}, ModeAny, http.StatusFound, http.Header{"Location": {"https://login.local"}}},
+		{"all stops at the first rejection", []string{"accept", "login", "basic"}, ModeAll, http.StatusFound, http.Header{"Location": {"https://login.local"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(recorder)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			err := m.Authenticate(ctx, tt.strategies, tt.mode)
+			if tt.wantStatus == 0 && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantStatus != 0 && (err == nil || RejectionStatus(err) != tt.wantStatus) {
+				t.Fatalf("expected the rejection status %d, got %v", tt.wantStatus, err)
+			}
+			if header := ctx.Writer.Header(); fmt.Sprint(header) != fmt.Sprint(tt.wantHeader) {
+				t.Fatalf("expected the response headers %v, got %v", tt.wantHeader, header)
+			}
+		})
+	}
+}
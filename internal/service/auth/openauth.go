@@ -18,13 +18,23 @@ import (
 
 	goutilsConsts "github.com/gofreego/goutils/constants"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// openAuthCachePrefix keeps the cached answers apart from the other entries of the cache
+	openAuthCachePrefix = "openauth:"
+	// jwtRevocationCachePrefix keeps the answers of the JWT revocation check apart from the OpenAuth strategy
+	jwtRevocationCachePrefix = "jwt_revocation:"
 )
 
 type OpenAuthStrategy struct {
-	client openauth_v1.OpenAuthClient
-	conn   *grpc.ClientConn
-	cache  cache.Cache
+	client      openauth_v1.OpenAuthClient
+	conn        *grpc.ClientConn
+	cache       cache.Cache
+	cachePrefix string
 }
 
 func NewOpenAuthStrategy(ctx context.Context, config *openauth.ClientConfig, cache cache.Cache) (Strategy, error) {
@@ -33,69 +43,106 @@ func NewOpenAuthStrategy(ctx context.Context, config *openauth.ClientConfig, cac
 		return nil, err
 	}
 	return &OpenAuthStrategy{
-		client: client,
-		conn:   conn,
-		cache:  cache,
+		client:      client,
+		conn:        conn,
+		cache:       cache,
+		cachePrefix: openAuthCachePrefix,
 	}, nil
 }
 
 func (s *OpenAuthStrategy) Authenticate(ctx *gin.Context) error {
-	reqContext := ctx.Request.Context()
-	token := ctx.GetHeader(goutilsConsts.HEADER_AUTHORIZATION)
-	if token == "" {
-		// Fallback to cookie if header is not present
-		if cookie, err := ctx.Cookie(constants.COOKIE_AUTHORIZATION); err == nil {
-			token = cookie
+	token := requestToken(ctx)
+	if authenticated, err := s.isAuthenticatedInCache(token); err == nil {
+		if !authenticated {
+			return fmt.Errorf("token rejected by OpenAuth")
 		}
-	}
-	if authenticated, err := s.isAuthenticatedInCache(token); err == nil && authenticated {
 		// Even for cached auth, we need claims for headers
-		_, claims, err := s.getJWTDetails(token)
+		claims, raw, err := decodeClaims(token)
 		if err != nil {
 			logger.Error(ctx, "Failed to get JWT details from cache: %v", err)
 			return err
 		}
-		ctx.Set(constants.JWT_CLAIMS, claims)
+		setClaims(ctx, claims, raw)
 		return nil
 	}
-	expiresAt, claims, err := s.getJWTDetails(token)
+	claims, raw, err := decodeClaims(token)
 	if err != nil {
 		logger.Error(ctx, "Failed to get JWT details: %v", err)
 		return err
 	}
 
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("token has no expiry")
+	}
+	expiresAt := claims.ExpiresAt.Time
 	if expiresAt.Before(time.Now()) {
 		return fmt.Errorf("token is expired")
 	}
 
-	// Store claims in gin context
-	ctx.Set(constants.JWT_CLAIMS, claims)
+	if err := s.verify(ctx.Request.Context(), token, expiresAt); err != nil {
+		return err
+	}
+	// Store claims in gin context only once OpenAuth accepted the token
+	setClaims(ctx, claims, raw)
+	return nil
+}
 
+// verify asks OpenAuth whether the token is authenticated and caches the answer
+func (s *OpenAuthStrategy) verify(ctx context.Context, token string, expiresAt time.Time) error {
 	// Set additional headers for the authentication request
 	authRequest := &openauth_v1.IsAuthenticatedRequest{
 		AccessToken: token,
 	}
 
-	reqContext = metadata.AppendToOutgoingContext(reqContext, goutilsConsts.HEADER_AUTHORIZATION, token)
+	reqContext := metadata.AppendToOutgoingContext(ctx, goutilsConsts.HEADER_AUTHORIZATION, token)
 
-	_, err = s.client.IsAuthenticated(reqContext, authRequest)
+	_, err := s.client.IsAuthenticated(reqContext, authRequest)
 	if err != nil {
-		s.setCache(token, false, time.Minute)
+		// only a rejection by OpenAuth is remembered, not a call that got no answer
+		if !unanswered(err) {
+			s.setCache(token, false, time.Minute)
+		}
 		logger.Error(ctx, "Authentication error: %v", err)
 		return err
 	}
-	s.setCache(token, true, time.Until(*expiresAt))
+	s.setCache(token, true, time.Until(expiresAt))
 	return nil
 }
 
+// unanswered reports whether the OpenAuth call failed without OpenAuth answering it
+func unanswered(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return true
+	}
+	return false
+}
+
+// requestToken returns the token of the request, from the Authorization header or else the cookie
+func requestToken(ctx *gin.Context) string {
+	token := ctx.GetHeader(goutilsConsts.HEADER_AUTHORIZATION)
+	if token == "" {
+		// Fallback to cookie if header is not present
+		if cookie, err := ctx.Cookie(constants.COOKIE_AUTHORIZATION); err == nil {
+			token = cookie
+		}
+	}
+	return token
+}
+
+// cacheKey returns the cache key of the token, hashed so the cache never holds the token itself
+func (s *OpenAuthStrategy) cacheKey(token string) string {
+	return s.cachePrefix + hashToken(token)
+}
+
 func (s *OpenAuthStrategy) isAuthenticatedInCache(authToken string) (bool, error) {
 	var isAuthenticated bool
 	if s.cache != nil {
-		err := s.cache.GetV(context.Background(), authToken, &isAuthenticated)
+		err := s.cache.GetV(context.Background(), s.cacheKey(authToken), &isAuthenticated)
 		if err == nil {
 			return isAuthenticated, nil
 		}
-		if err.Error() != "redis: nil" && err.Error() != "not found" {
+		if !isCacheMiss(err) {
 			logger.Error(context.Background(), "Cache get error: %v", err)
 			return false, err
 		}
@@ -105,14 +152,15 @@ func (s *OpenAuthStrategy) isAuthenticatedInCache(authToken string) (bool, error
 
 func (s *OpenAuthStrategy) setCache(authToken string, isAuthenticated bool, duration time.Duration) {
 	if s.cache != nil {
-		err := s.cache.SetWithTimeout(context.Background(), authToken, isAuthenticated, duration)
+		err := s.cache.SetWithTimeout(context.Background(), s.cacheKey(authToken), isAuthenticated, duration)
 		if err != nil {
 			logger.Error(context.Background(), "Cache set error: %v", err)
 		}
 	}
 }
 
-func (s *OpenAuthStrategy) getJWTDetails(token string) (*time.Time, *jwtutils.JWTClaims, error) {
+// decodeClaims decodes the claims of the token without verifying it, typed and as a map of all of them
+func decodeClaims(token string) (*jwtutils.JWTClaims, map[string]any, error) {
 	// Remove "Bearer " prefix if present
 	token = strings.TrimPrefix(token, "Bearer ")
 
@@ -141,7 +189,17 @@ func (s *OpenAuthStrategy) getJWTDetails(token string) (*time.Time, *jwtutils.JW
 	if err := json.Unmarshal(decoded, &claims); err != nil {
 		return nil, nil, fmt.Errorf("failed to parse JWT claims: %w", err)
 	}
-	return &claims.RegisteredClaims.ExpiresAt.Time, &claims, nil
+	var raw map[string]any
+	if err := json.Unmarshal(decoded, &raw); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse JWT claims: %w", err)
+	}
+	return &claims, raw, nil
+}
+
+// setClaims stores the claims of the authenticated token in the gin context
+func setClaims(ctx *gin.Context, claims *jwtutils.JWTClaims, raw map[string]any) {
+	ctx.Set(constants.JWT_CLAIMS, claims)
+	ctx.Set(constants.TOKEN_CLAIMS, raw)
 }
 
 func (s *OpenAuthStrategy) Close() error {
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOpenAuthStrategyCachesRejection(t *testing.T) {
+	client := &revokingOpenAuth{err: status.Error(codes.Unauthenticated, "token revoked")}
+	values := map[string][]byte{}
+	strategy := &OpenAuthStrategy{client: client, cache: &memoryCache{values: values}, cachePrefix: openAuthCachePrefix}
+	claims := &jwtutils.JWTClaims{UserID: 42, RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))}}
+	token := signToken(t, jwt.SigningMethodHS256, "", []byte("secret"), claims)
+
+	for i := 0; i < 3; i++ {
+		ctx, err := authenticate(strategy, token)
+		if err == nil {
+			t.Fatal("expected the revoked token to be rejected")
+		}
+		if _, exists := ctx.Get(constants.JWT_CLAIMS); exists {
+			t.Fatal("expected the claims of the rejected token to be left out of the context")
+		}
+	}
+	if client.calls != 1 {
+		t.Fatalf("expected OpenAuth to be asked once, got %d calls", client.calls)
+	}
+	for key := range values {
+		if key != openAuthCachePrefix+hashToken("Bearer "+token) {
+			t.Fatalf("expected the token to be cached under its namespaced hash, got %s", key)
+		}
+	}
+}
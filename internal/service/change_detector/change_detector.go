@@ -2,6 +2,8 @@ package changedetector
 
 import (
 	"context"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/gofreego/goutils/logger"
@@ -11,8 +13,24 @@ import (
 
 type ChangeDetector interface {
 	DetectChanges(ctx context.Context) error
+	Subscribe(listener Listener)
 }
 
+// RoutesChanged is the event emitted when a new routing table is swapped in
+type RoutesChanged struct {
+	Added   []string // names of the new routes
+	Updated []string // names of the routes whose config changed
+	Removed []string // names of the routes no longer served, a rename removes the old name and adds the new one
+}
+
+// Empty reports whether the event carries no change
+func (e *RoutesChanged) Empty() bool {
+	return len(e.Added) == 0 && len(e.Updated) == 0 && len(e.Removed) == 0
+}
+
+// Listener is notified of every applied route change
+type Listener func(ctx context.Context, event *RoutesChanged)
+
 type Repository interface {
 	GetRoutes(ctx context.Context) ([]*models.ServiceRoute, error)
 }
@@ -25,6 +43,9 @@ type changeDetector struct {
 	repo         Repository
 	routeManager routemanager.Manager
 	cfg          *Config
+
+	mu        sync.RWMutex
+	listeners []Listener
 }
 
 func New(repo Repository, routeManager routemanager.Manager, cfg *Config) ChangeDetector {
@@ -35,6 +56,13 @@ func New(repo Repository, routeManager routemanager.Manager, cfg *Config) Change
 	}
 }
 
+// Subscribe registers a listener notified after every applied route change
+func (cd *changeDetector) Subscribe(listener Listener) {
+	cd.mu.Lock()
+	defer cd.mu.Unlock()
+	cd.listeners = append(cd.listeners, listener)
+}
+
 // detectChanges periodically checks for changes in the routes and updates the route manager accordingly
 func (cd *changeDetector) DetectChanges(ctx context.Context) error {
 	logger.Info(ctx, "Route change detector started")
@@ -68,7 +96,7 @@ func (cd *changeDetector) DetectChanges(ctx context.Context) error {
 	}
 }
 
-// checkAndUpdateRoutes checks for changes and updates routes if necessary
+// checkAndUpdateRoutes builds the routing table from the latest routes and swaps it in when anything changed
 func (cd *changeDetector) checkAndUpdateRoutes(ctx context.Context) error {
 	logger.Info(ctx, "Checking for route changes")
 	// Fetch latest routes from repository
@@ -77,41 +105,47 @@ func (cd *changeDetector) checkAndUpdateRoutes(ctx context.Context) error {
 		return err
 	}
 
-	if cd.isDeletedAny(latestRoutes) {
-		logger.Info(ctx, "Route deletion detected, reloading all routes")
-
+	event := diffRoutes(cd.routeManager.GetRoutes(), latestRoutes)
+	if event.Empty() {
 		return nil
 	}
 
-	for _, route := range latestRoutes {
-		existingRoute := cd.routeManager.GetRouteByName(route.Name)
-		if existingRoute == nil {
-			// New route added
-			cd.routeManager.AddRoute(route)
-			logger.Info(ctx, "New route added: %s, value: %+v", route.Name, route)
-			continue
-		}
+	// Replacing the whole table applies deletions, renames and path prefix changes along with the updates
+	cd.routeManager.ReplaceRoutes(latestRoutes)
+	logger.Info(ctx, "Routes reloaded, added: %v, updated: %v, removed: %v", event.Added, event.Updated, event.Removed)
 
-		if route.UpdatedAt > existingRoute.UpdatedAt {
-			// Route updated
-			cd.routeManager.AddRoute(route)
-			logger.Info(ctx, "Route updated: %s, value: %v", route.Name, route)
-		}
+	cd.mu.RLock()
+	listeners := slices.Clone(cd.listeners)
+	cd.mu.RUnlock()
+	for _, listener := range listeners {
+		listener(ctx, event)
 	}
 	return nil
 }
 
-func (cd *changeDetector) isDeletedAny(latestRoutes []*models.ServiceRoute) bool {
-	existingRoutes := cd.routeManager.GetRoutes()
-	latestRouteNames := make(map[string]struct{})
-	for _, route := range latestRoutes {
-		latestRouteNames[route.Name] = struct{}{}
+// diffRoutes compares the served routes with the latest ones by name
+func diffRoutes(existingRoutes, latestRoutes []*models.ServiceRoute) *RoutesChanged {
+	existing := make(map[string]*models.ServiceRoute, len(existingRoutes))
+	for _, route := range existingRoutes {
+		existing[route.Name] = route
 	}
 
-	for _, existingRoute := range existingRoutes {
-		if _, exists := latestRouteNames[existingRoute.Name]; !exists {
-			return true
+	event := &RoutesChanged{}
+	latest := make(map[string]struct{}, len(latestRoutes))
+	for _, route := range latestRoutes {
+		latest[route.Name] = struct{}{}
+		existingRoute, ok := existing[route.Name]
+		switch {
+		case !ok:
+			event.Added = append(event.Added, route.Name)
+		case route.UpdatedAt != existingRoute.UpdatedAt:
+			event.Updated = append(event.Updated, route.Name)
+		}
+	}
+	for _, route := range existingRoutes {
+		if _, ok := latest[route.Name]; !ok {
+			event.Removed = append(event.Removed, route.Name)
 		}
 	}
-	return false
+	return event
 }
@@ -0,0 +1,61 @@
+package changedetector
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/gofreego/opengate/internal/models"
+	routemanager "github.com/gofreego/opengate/internal/service/route_manager"
+)
+
+func route(name string, updatedAt int64) *models.ServiceRoute {
+	return &models.ServiceRoute{Name: name, PathPrefix: "/" + name, TargetURL: "http://" + name + ".local", UpdatedAt: updatedAt}
+}
+
+func TestDiffRoutes(t *testing.T) {
+	existing := []*models.ServiceRoute{route("orders", 1), route("users", 1), route("carts", 1), route("payments", 1)}
+	tests := []struct {
+		name   string
+		latest []*models.ServiceRoute
+		want   RoutesChanged
+	}{
+		{"unchanged", []*models.ServiceRoute{route("orders", 1), route("users", 1), route("carts", 1), route("payments", 1)}, RoutesChanged{}},
+		{"added", []*models.ServiceRoute{route("orders", 1), route("users", 1), route("carts", 1), route("payments", 1), route("invoices", 1)}, RoutesChanged{Added: []string{"invoices"}}},
+		{"updated", []*models.ServiceRoute{route("orders", 2), route("users", 1), route("carts", 1), route("payments", 1)}, RoutesChanged{Updated: []string{"orders"}}},
+		{"removed", []*models.ServiceRoute{route("orders", 1), route("users", 1), route("payments", 1)}, RoutesChanged{Removed: []string{"carts"}}},
+		{"renamed", []*models.ServiceRoute{route("orders", 1), route("users", 1), route("carts", 1), route("billing", 2)}, RoutesChanged{Added: []string{"billing"}, Removed: []string{"payments"}}},
+	}
+	for _, test := range tests {
+		got := diffRoutes(existing, test.latest)
+		if !slices.Equal(got.Added, test.want.Added) || !slices.Equal(got.Updated, test.want.Updated) || !slices.Equal(got.Removed, test.want.Removed) {
+			t.Fatalf("%s: expected %+v, got %+v", test.name, test.want, *got)
+		}
+		if got.Empty() != (test.name == "unchanged") {
+			t.Fatalf("%s: expected the event to be empty only without changes", test.name)
+		}
+	}
+}
+
+// failingRepository fails to load the routes
+type failingRepository struct{}
+
+func (failingRepository) GetRoutes(ctx context.Context) ([]*models.ServiceRoute, error) {
+	return nil, errors.New("failed to scan config row")
+}
+
+func TestCheckAndUpdateRoutesKeepsRoutesOnError(t *testing.T) {
+	manager := routemanager.New(&routemanager.Config{})
+	manager.ReplaceRoutes([]*models.ServiceRoute{route("orders", 1)})
+	cd := New(failingRepository{}, manager, &Config{}).(*changeDetector)
+	notified := false
+	cd.Subscribe(func(ctx context.Context, event *RoutesChanged) { notified = true })
+
+	if err := cd.checkAndUpdateRoutes(context.Background()); err == nil {
+		t.Fatal("expected the repository error")
+	}
+	if routes := manager.GetRoutes(); len(routes) != 1 || routes[0].Name != "orders" || notified {
+		t.Fatalf("expected the served routes to be kept, got %d routes", len(routes))
+	}
+}
@@ -0,0 +1,212 @@
+package circuitbreaker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+// Breaker states
+const (
+	StateClosed   = "closed"
+	StateOpen     = "open"
+	StateHalfOpen = "half_open"
+)
+
+const (
+	defaultMinRequests      = 20
+	defaultWindow           = 10 * time.Second
+	defaultEjectionDuration = 30 * time.Second
+	defaultHalfOpenRequests = 1
+)
+
+// Breaker is the circuit breaker of a single upstream target. It opens after too many
+// consecutive failures or a too high error rate, rejects requests while open and lets a
+// few probe requests through once the ejection duration elapsed to decide whether to close again.
+type Breaker struct {
+	consecutiveFailures int
+	errorRateThreshold  int
+	minRequests         int
+	window              time.Duration
+	ejectionDuration    time.Duration
+	halfOpenRequests    int
+
+	mu                sync.Mutex
+	state             string
+	failureStreak     int
+	windowStart       time.Time
+	requests          int
+	failures          int
+	openedAt          time.Time
+	halfOpenAdmitted  int
+	halfOpenSucceeded int
+	now               func() time.Time
+}
+
+// Snapshot is the current state of a breaker
+type Snapshot struct {
+	State               string
+	ConsecutiveFailures int
+	Requests            int // requests seen in the current error rate window
+	Failures            int // failed requests seen in the current error rate window
+	OpenedAt            time.Time
+}
+
+// New creates a closed breaker. Zero values of the config fall back to the defaults.
+func New(cfg *models.CircuitBreaker) *Breaker {
+	return &Breaker{
+		consecutiveFailures: cfg.ConsecutiveFailures,
+		errorRateThreshold:  cfg.ErrorRateThreshold,
+		minRequests:         utils.ValueOrDefault(cfg.MinRequests, defaultMinRequests),
+		window:              utils.ValueOrDefault(cfg.Window, defaultWindow),
+		ejectionDuration:    utils.ValueOrDefault(cfg.EjectionDuration, defaultEjectionDuration),
+		halfOpenRequests:    utils.ValueOrDefault(cfg.HalfOpenRequests, defaultHalfOpenRequests),
+		state:               StateClosed,
+		now:                 time.Now,
+	}
+}
+
+// Ready reports whether the breaker may let a request through, without reserving it.
+// Load balancers use it to skip ejected targets.
+func (b *Breaker) Ready() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.currentState(b.now()) {
+	case StateOpen:
+		return false
+	case StateHalfOpen:
+		return b.halfOpenAdmitted < b.halfOpenRequests
+	default:
+		return true
+	}
+}
+
+// Allow reports whether a request may be sent to the target. Every allowed request must be
+// followed by a Record of its outcome.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.currentState(b.now()) {
+	case StateOpen:
+		return false
+	case StateHalfOpen:
+		if b.halfOpenAdmitted >= b.halfOpenRequests {
+			return false
+		}
+		b.halfOpenAdmitted++
+		return true
+	default:
+		return true
+	}
+}
+
+// Record records the outcome of a request allowed by the breaker. It reports whether the breaker changed state.
+func (b *Breaker) Record(success bool) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	switch b.currentState(now) {
+	case StateHalfOpen:
+		if !success {
+			b.open(now)
+			return true
+		}
+		b.halfOpenSucceeded++
+		if b.halfOpenSucceeded >= b.halfOpenRequests {
+			b.close(now)
+			return true
+		}
+		return false
+	case StateOpen:
+		// requests that were in flight when the breaker opened
+		return false
+	}
+
+	if now.Sub(b.windowStart) >= b.window {
+		b.windowStart, b.requests, b.failures = now, 0, 0
+	}
+	b.requests++
+	if success {
+		b.failureStreak = 0
+		return false
+	}
+	b.failures++
+	b.failureStreak++
+
+	if b.consecutiveFailures > 0 && b.failureStreak >= b.consecutiveFailures {
+		b.open(now)
+		return true
+	}
+	if b.errorRateThreshold > 0 && b.requests >= b.minRequests && b.failures*100 >= b.errorRateThreshold*b.requests {
+		b.open(now)
+		return true
+	}
+	return false
+}
+
+// Cancel gives back the admission of an allowed request whose outcome is unknown, like when the client
+// went away, so a half open breaker lets another probe through instead of waiting for it forever.
+func (b *Breaker) Cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.currentState(b.now()) == StateHalfOpen && b.halfOpenAdmitted > b.halfOpenSucceeded {
+		b.halfOpenAdmitted--
+	}
+}
+
+// Snapshot returns the current state of the breaker
+func (b *Breaker) Snapshot() Snapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return Snapshot{
+		State:               b.currentState(b.now()),
+		ConsecutiveFailures: b.failureStreak,
+		Requests:            b.requests,
+		Failures:            b.failures,
+		OpenedAt:            b.openedAt,
+	}
+}
+
+// currentState moves an open breaker to half open once its ejection duration elapsed
+func (b *Breaker) currentState(now time.Time) string {
+	if b.state == StateOpen && now.Sub(b.openedAt) >= b.ejectionDuration {
+		b.state = StateHalfOpen
+		b.halfOpenAdmitted, b.halfOpenSucceeded = 0, 0
+	}
+	return b.state
+}
+
+func (b *Breaker) open(now time.Time) {
+	b.state = StateOpen
+	b.openedAt = now
+}
+
+func (b *Breaker) close(now time.Time) {
+	b.state = StateClosed
+	b.failureStreak = 0
+	b.windowStart, b.requests, b.failures = now, 0, 0
+}
+
+// Validate checks the circuit breaker settings of a route
+func Validate(cfg *models.CircuitBreaker) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.ConsecutiveFailures <= 0 && cfg.ErrorRateThreshold <= 0 {
+		return fmt.Errorf("circuit breaker requires consecutive_failures or error_rate_threshold")
+	}
+	if cfg.ConsecutiveFailures < 0 || cfg.MinRequests < 0 || cfg.HalfOpenRequests < 0 {
+		return fmt.Errorf("circuit breaker counts must not be negative")
+	}
+	if cfg.ErrorRateThreshold < 0 || cfg.ErrorRateThreshold > 100 {
+		return fmt.Errorf("invalid circuit breaker error_rate_threshold %d: must be a percentage between 1 and 100", cfg.ErrorRateThreshold)
+	}
+	if cfg.Window < 0 || cfg.EjectionDuration < 0 {
+		return fmt.Errorf("circuit breaker window and ejection duration must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+// clock is a fake time source moved forward by the tests
+type clock struct{ now time.Time }
+
+func (c *clock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+// step moves the clock forward, then sends op to the breaker and checks the state it's in afterwards
+type step struct {
+	advance time.Duration
+	op      string // allow, success, failure or cancel
+	allowed bool   // expected answer of allow
+	state   string
+}
+
+func TestTransitions(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   models.CircuitBreaker
+		steps []step
+	}{
+		{
+			name: "consecutive failures open the breaker",
+			cfg:  models.CircuitBreaker{ConsecutiveFailures: 3},
+			steps: []step{
+				{op: "failure", state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{op: "success", state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{op: "failure", state: StateOpen},
+				{op: "allow", allowed: false, state: StateOpen},
+			},
+		},
+		{
+			name: "error rate opens the breaker once enough requests were seen",
+			cfg:  models.CircuitBreaker{ErrorRateThreshold: 50, MinRequests: 4},
+			steps: []step{
+				{op: "success", state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{op: "success", state: StateClosed},
+				{op: "failure", state: StateOpen},
+			},
+		},
+		{
+			name: "error rate window starts over once it elapsed",
+			cfg:  models.CircuitBreaker{ErrorRateThreshold: 50, MinRequests: 4, Window: 10 * time.Second},
+			steps: []step{
+				{op: "failure", state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{op: "failure", state: StateClosed},
+				{advance: 10 * time.Second, op: "failure", state: StateClosed},
+				{op: "success", state: StateClosed},
+				{op: "success", state: StateClosed},
+				{op: "success", state: StateClosed},
+			},
+		},
+		{
+			name: "successful probe closes the breaker after the ejection",
+			cfg:  models.CircuitBreaker{ConsecutiveFailures: 1, EjectionDuration: 30 * time.Second},
+			steps: []step{
+				{op: "failure", state: StateOpen},
+				{advance: 29 * time.Second, op: "allow", allowed: false, state: StateOpen},
+				{advance: time.Second, op: "allow", allowed: true, state: StateHalfOpen},
+				{op: "allow", allowed: false, state: StateHalfOpen},
+				{op: "success", state: StateClosed},
+				{op: "allow", allowed: true, state: StateClosed},
+			},
+		},
+		{
+			name: "failed probe opens the breaker for another ejection",
+			cfg:  models.CircuitBreaker{ConsecutiveFailures: 1, EjectionDuration: 30 * time.Second},
+			steps: []step{
+				{op: "failure", state: StateOpen},
+				{advance: 30 * time.Second, op: "allow", allowed: true, state: StateHalfOpen},
+				{op: "failure", state: StateOpen},
+				{advance: 29 * time.Second, op: "allow", allowed: false, state: StateOpen},
+				{advance: time.Second, op: "allow", allowed: true, state: StateHalfOpen},
+			},
+		},
+		{
+			name: "every probe has to succeed to close the breaker",
+			cfg:  models.CircuitBreaker{ConsecutiveFailures: 1, HalfOpenRequests: 2},
+			steps: []step{
+				{op: "failure", state: StateOpen},
+				{advance: defaultEjectionDuration, op: "allow", allowed: true, state: StateHalfOpen},
+				{op: "allow", allowed: true, state: StateHalfOpen},
+				{op: "allow", allowed: false, state: StateHalfOpen},
+				{op: "success", state: StateHalfOpen},
+				{op: "success", state: StateClosed},
+			},
+		},
+		{
+			name: "canceled probe lets another one through",
+			cfg:  models.CircuitBreaker{ConsecutiveFailures: 1},
+			steps: []step{
+				{op: "failure", state: StateOpen},
+				{advance: defaultEjectionDuration, op: "allow", allowed: true, state: StateHalfOpen},
+				{op: "cancel", state: StateHalfOpen},
+				{op: "allow", allowed: true, state: StateHalfOpen},
+				{op: "allow", allowed: false, state: StateHalfOpen},
+			},
+		},
+		{
+			name: "outcomes of requests in flight when the breaker opened are ignored",
+			cfg:  models.CircuitBreaker{ConsecutiveFailures: 1},
+			steps: []step{
+				{op: "failure", state: StateOpen},
+				{op: "success", state: StateOpen},
+				{advance: defaultEjectionDuration - time.Second, op: "success", state: StateOpen},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+			b := New(&test.cfg)
+			b.now = func() time.Time { return c.now }
+
+			for i, s := range test.steps {
+				c.advance(s.advance)
+				switch s.op {
+				case "allow":
+					if allowed := b.Allow(); allowed != s.allowed {
+						t.Fatalf("step %d: expected allow to be %v", i+1, s.allowed)
+					}
+				case "success", "failure":
+					b.Record(s.op == "success")
+				case "cancel":
+					b.Cancel()
+				}
+				if state := b.Snapshot().State; state != s.state {
+					t.Fatalf("step %d: expected the breaker to be %s, got %s", i+1, s.state, state)
+				}
+			}
+		})
+	}
+}
+
+func TestReadyDoesNotTakeTheProbe(t *testing.T) {
+	c := &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	b := New(&models.CircuitBreaker{ConsecutiveFailures: 1})
+	b.now = func() time.Time { return c.now }
+
+	b.Record(false)
+	if b.Ready() {
+		t.Fatal("expected an open breaker not to be ready")
+	}
+	c.advance(defaultEjectionDuration)
+	if !b.Ready() || !b.Ready() {
+		t.Fatal("expected a half open breaker to stay ready until the probe is allowed")
+	}
+	b.Allow()
+	if b.Ready() {
+		t.Fatal("expected the breaker not to be ready once the probe is in flight")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []*models.CircuitBreaker{
+		{},
+		{ConsecutiveFailures: -1, ErrorRateThreshold: 50},
+		{ErrorRateThreshold: 101},
+		{ConsecutiveFailures: 5, MinRequests: -1},
+		{ConsecutiveFailures: 5, Window: -time.Second},
+	}
+	for _, cfg := range invalid {
+		if err := Validate(cfg); err == nil {
+			t.Fatalf("expected %+v to be invalid", cfg)
+		}
+	}
+	if err := Validate(&models.CircuitBreaker{ErrorRateThreshold: 50}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -4,14 +4,27 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/gofreego/goutils/logger"
 	"github.com/gofreego/goutils/utils"
 	"github.com/gofreego/opengate/api/opengate_v1"
 	"github.com/gofreego/opengate/internal/constants"
 	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/internal/service/auth"
+	circuitbreaker "github.com/gofreego/opengate/internal/service/circuit_breaker"
+	healthchecker "github.com/gofreego/opengate/internal/service/health_checker"
+	loadbalancer "github.com/gofreego/opengate/internal/service/load_balancer"
+	"github.com/gofreego/opengate/internal/service/mirror"
+	ratelimiter "github.com/gofreego/opengate/internal/service/rate_limiter"
+	retrypolicy "github.com/gofreego/opengate/internal/service/retry_policy"
+	"github.com/gofreego/opengate/internal/service/rewrite"
+	routemanager "github.com/gofreego/opengate/internal/service/route_manager"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const (
@@ -42,6 +55,9 @@ func (s *Service) CreateConfig(ctx context.Context, req *opengate_v1.CreateConfi
 	if err := validateCreateConfigRequest(req); err != nil {
 		return nil, err
 	}
+	if err := s.authManager.Validate(req.GetAuthentication().GetStrategies(), req.GetAuthentication().GetMode()); err != nil {
+		return nil, err
+	}
 
 	// Convert proto to model
 	config := protoToModel(req)
@@ -138,6 +154,9 @@ func (s *Service) UpdateConfig(ctx context.Context, req *opengate_v1.UpdateConfi
 	if err := validateUpdateConfigRequest(req); err != nil {
 		return nil, err
 	}
+	if err := s.authManager.Validate(req.GetAuthentication().GetStrategies(), req.GetAuthentication().GetMode()); err != nil {
+		return nil, err
+	}
 
 	// Convert proto to model
 	config := updateProtoToModel(req)
@@ -154,6 +173,56 @@ func (s *Service) UpdateConfig(ctx context.Context, req *opengate_v1.UpdateConfi
 	}, nil
 }
 
+// SetRouteWeights sets the weights of the versions of a split route. Versions left out of the
+// request keep their weight, the gateways pick the change up with the next route refresh.
+func (s *Service) SetRouteWeights(ctx context.Context, req *opengate_v1.SetRouteWeightsRequest) (*opengate_v1.SetRouteWeightsResponse, error) {
+	// Check write permission
+	if err := s.checkPermission(ctx, constants.PERMISSION_ROUTES_WRITE); err != nil {
+		return nil, err
+	}
+
+	if req.GetId() <= 0 {
+		return nil, fmt.Errorf("invalid config id")
+	}
+	if len(req.GetWeights()) == 0 {
+		return nil, fmt.Errorf("weights are required")
+	}
+
+	config, err := s.repo.GetConfigByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if config.Split == nil {
+		return nil, fmt.Errorf("route %s has no split versions", config.Name)
+	}
+
+	weights := make([]string, 0, len(req.GetWeights()))
+	for _, weight := range req.GetWeights() {
+		i := slices.IndexFunc(config.Split.Versions, func(version models.RouteVersion) bool {
+			return version.Name == weight.GetVersion()
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("route %s has no version %q", config.Name, weight.GetVersion())
+		}
+		config.Split.Versions[i].Weight = int(weight.GetWeight())
+		weights = append(weights, fmt.Sprintf("%s=%d", weight.GetVersion(), weight.GetWeight()))
+	}
+	if err := loadbalancer.ValidateSplit(config.LoadBalancer, config.Split); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.UpdateConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(ctx, "Weights of route %s set to %s", config.Name, strings.Join(weights, ", "))
+
+	return &opengate_v1.SetRouteWeightsResponse{
+		Config:  modelToProto(updated),
+		Message: "Route weights updated successfully",
+	}, nil
+}
+
 // DeleteConfig deletes a config by ID
 func (s *Service) DeleteConfig(ctx context.Context, req *opengate_v1.DeleteConfigRequest) (*opengate_v1.DeleteConfigResponse, error) {
 	// Check write permission
@@ -214,10 +283,26 @@ func (s *Service) GetStats(ctx context.Context, req *opengate_v1.GetStatsRequest
 		return nil, err
 	}
 
-	return &opengate_v1.GetStatsResponse{
+	stats := &opengate_v1.GetStatsResponse{
 		TotalRoutes: int32(total),
 		Message:     "Stats retrieved successfully",
-	}, nil
+	}
+
+	// Count the targets of health checked routes by their current health
+	for _, route := range s.routesHealth(ctx) {
+		if !route.GetHealthCheckEnabled() {
+			continue
+		}
+		for _, target := range route.GetTargets() {
+			if target.GetHealthy() {
+				stats.HealthyTargets++
+			} else {
+				stats.UnhealthyTargets++
+			}
+		}
+	}
+
+	return stats, nil
 }
 
 // validateCreateConfigRequest validates the create config request
@@ -228,8 +313,8 @@ func validateCreateConfigRequest(req *opengate_v1.CreateConfigRequest) error {
 	if req.GetPathPrefix() == "" {
 		return fmt.Errorf("path_prefix is required")
 	}
-	if req.GetTargetUrl() == "" {
-		return fmt.Errorf("target_url is required")
+	if req.GetTargetUrl() == "" && len(req.GetTargets()) == 0 && req.GetSplit() == nil {
+		return fmt.Errorf("target_url, targets or split is required")
 	}
 
 	// Validate target URL format
@@ -237,6 +322,75 @@ func validateCreateConfigRequest(req *opengate_v1.CreateConfigRequest) error {
 		return fmt.Errorf("invalid target_url format: %w", err)
 	}
 
+	// Validate upstream targets, traffic split and load balancing policy
+	if err := validateUpstreams(req.GetTargetUrl(), req.GetTargets(), req.GetSplit(), req.GetLoadBalancer()); err != nil {
+		return err
+	}
+
+	// Validate active health check settings
+	if err := healthchecker.Validate(protoHealthCheckToModel(req.GetHealthCheck())); err != nil {
+		return err
+	}
+
+	// Validate circuit breaker settings
+	if err := circuitbreaker.Validate(protoCircuitBreakerToModel(req.GetCircuitBreaker())); err != nil {
+		return err
+	}
+
+	// Validate retry policy settings
+	if err := retrypolicy.Validate(protoRetryPolicyToModel(req.GetRetryPolicy())); err != nil {
+		return err
+	}
+
+	// Validate the path pattern of the route
+	if err := routemanager.ValidatePathPrefix(req.GetPathPrefix()); err != nil {
+		return err
+	}
+
+	// Validate the hosts the route is served on
+	if err := routemanager.ValidateHosts(req.GetHosts()); err != nil {
+		return err
+	}
+
+	// Validate the match conditions of the route
+	if err := routemanager.ValidateMatch(protoRouteMatchToModel(req.GetMatch())); err != nil {
+		return err
+	}
+
+	// Validate the path and host rewrite
+	if err := rewrite.Validate(protoRewriteToModel(req.GetRewrite()), req.GetPathPrefix(), req.GetStripPrefix()); err != nil {
+		return err
+	}
+
+	// Validate the traffic mirror
+	if err := mirror.Validate(protoMirrorToModel(req.GetMirror())); err != nil {
+		return err
+	}
+
+	// Validate the permission and claim requirements
+	if err := auth.ValidateRequirements(protoAuthToModel(req.GetAuthentication())); err != nil {
+		return err
+	}
+
+	// Validate the claim and static headers sent upstream
+	if err := auth.ValidateIdentity(protoIdentityToModel(req.GetIdentity())); err != nil {
+		return err
+	}
+
+	// Validate the rate limit
+	if err := ratelimiter.Validate(protoRateLimitToModel(req.GetRateLimit())); err != nil {
+		return err
+	}
+
+	// Validate middleware names and their config
+	middlewareConfig, err := protoMiddlewareConfigToModel(req.GetMiddlewareConfig())
+	if err != nil {
+		return err
+	}
+	if err := validateMiddleware(req.GetMiddleware(), middlewareConfig); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -248,8 +402,8 @@ func validateUpdateConfigRequest(req *opengate_v1.UpdateConfigRequest) error {
 	if req.GetPathPrefix() == "" {
 		return fmt.Errorf("path_prefix is required")
 	}
-	if req.GetTargetUrl() == "" {
-		return fmt.Errorf("target_url is required")
+	if req.GetTargetUrl() == "" && len(req.GetTargets()) == 0 && req.GetSplit() == nil {
+		return fmt.Errorf("target_url, targets or split is required")
 	}
 
 	// Validate target URL format
@@ -257,9 +411,87 @@ func validateUpdateConfigRequest(req *opengate_v1.UpdateConfigRequest) error {
 		return fmt.Errorf("invalid target_url format: %w", err)
 	}
 
+	// Validate upstream targets, traffic split and load balancing policy
+	if err := validateUpstreams(req.GetTargetUrl(), req.GetTargets(), req.GetSplit(), req.GetLoadBalancer()); err != nil {
+		return err
+	}
+
+	// Validate active health check settings
+	if err := healthchecker.Validate(protoHealthCheckToModel(req.GetHealthCheck())); err != nil {
+		return err
+	}
+
+	// Validate circuit breaker settings
+	if err := circuitbreaker.Validate(protoCircuitBreakerToModel(req.GetCircuitBreaker())); err != nil {
+		return err
+	}
+
+	// Validate retry policy settings
+	if err := retrypolicy.Validate(protoRetryPolicyToModel(req.GetRetryPolicy())); err != nil {
+		return err
+	}
+
+	// Validate the path pattern of the route
+	if err := routemanager.ValidatePathPrefix(req.GetPathPrefix()); err != nil {
+		return err
+	}
+
+	// Validate the hosts the route is served on
+	if err := routemanager.ValidateHosts(req.GetHosts()); err != nil {
+		return err
+	}
+
+	// Validate the match conditions of the route
+	if err := routemanager.ValidateMatch(protoRouteMatchToModel(req.GetMatch())); err != nil {
+		return err
+	}
+
+	// Validate the path and host rewrite
+	if err := rewrite.Validate(protoRewriteToModel(req.GetRewrite()), req.GetPathPrefix(), req.GetStripPrefix()); err != nil {
+		return err
+	}
+
+	// Validate the traffic mirror
+	if err := mirror.Validate(protoMirrorToModel(req.GetMirror())); err != nil {
+		return err
+	}
+
+	// Validate the permission and claim requirements
+	if err := auth.ValidateRequirements(protoAuthToModel(req.GetAuthentication())); err != nil {
+		return err
+	}
+
+	// Validate the claim and static headers sent upstream
+	if err := auth.ValidateIdentity(protoIdentityToModel(req.GetIdentity())); err != nil {
+		return err
+	}
+
+	// Validate the rate limit
+	if err := ratelimiter.Validate(protoRateLimitToModel(req.GetRateLimit())); err != nil {
+		return err
+	}
+
+	// Validate middleware names and their config
+	middlewareConfig, err := protoMiddlewareConfigToModel(req.GetMiddlewareConfig())
+	if err != nil {
+		return err
+	}
+	if err := validateMiddleware(req.GetMiddleware(), middlewareConfig); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// validateUpstreams validates the traffic split of a route if it has one, its targets otherwise
+func validateUpstreams(targetURL string, targets []*opengate_v1.Target, split *opengate_v1.TrafficSplit, lb *opengate_v1.LoadBalancer) error {
+	if split != nil {
+		return loadbalancer.ValidateSplit(protoLoadBalancerToModel(lb), protoTrafficSplitToModel(split))
+	}
+	route := &models.ServiceRoute{TargetURL: targetURL, Targets: protoTargetsToModel(targets)}
+	return loadbalancer.Validate(protoLoadBalancerToModel(lb), route.GetTargets())
+}
+
 // protoToModel converts a CreateConfigRequest to a Config model
 func protoToModel(req *opengate_v1.CreateConfigRequest) *models.Config {
 	timeout := time.Duration(req.GetTimeout())
@@ -267,13 +499,30 @@ func protoToModel(req *opengate_v1.CreateConfigRequest) *models.Config {
 		timeout = DefaultTimeout
 	}
 
+	// already checked by the request validation
+	middlewareConfig, _ := protoMiddlewareConfigToModel(req.GetMiddlewareConfig())
+
 	config := &models.Config{
-		Name:        req.GetName(),
-		PathPrefix:  req.GetPathPrefix(),
-		TargetURL:   req.GetTargetUrl(),
-		StripPrefix: req.GetStripPrefix(),
-		Middleware:  req.GetMiddleware(),
-		Timeout:     timeout,
+		Name:             req.GetName(),
+		PathPrefix:       req.GetPathPrefix(),
+		Hosts:            req.GetHosts(),
+		Match:            protoRouteMatchToModel(req.GetMatch()),
+		Priority:         int(req.GetPriority()),
+		TargetURL:        req.GetTargetUrl(),
+		Targets:          protoTargetsToModel(req.GetTargets()),
+		Split:            protoTrafficSplitToModel(req.GetSplit()),
+		Mirror:           protoMirrorToModel(req.GetMirror()),
+		Identity:         protoIdentityToModel(req.GetIdentity()),
+		RateLimit:        protoRateLimitToModel(req.GetRateLimit()),
+		LoadBalancer:     protoLoadBalancerToModel(req.GetLoadBalancer()),
+		HealthCheck:      protoHealthCheckToModel(req.GetHealthCheck()),
+		CircuitBreaker:   protoCircuitBreakerToModel(req.GetCircuitBreaker()),
+		RetryPolicy:      protoRetryPolicyToModel(req.GetRetryPolicy()),
+		StripPrefix:      req.GetStripPrefix(),
+		Rewrite:          protoRewriteToModel(req.GetRewrite()),
+		Middleware:       req.GetMiddleware(),
+		MiddlewareConfig: middlewareConfig,
+		Timeout:          timeout,
 	}
 
 	if req.GetAuthentication() != nil {
@@ -290,14 +539,31 @@ func updateProtoToModel(req *opengate_v1.UpdateConfigRequest) *models.Config {
 		timeout = DefaultTimeout
 	}
 
+	// already checked by the request validation
+	middlewareConfig, _ := protoMiddlewareConfigToModel(req.GetMiddlewareConfig())
+
 	config := &models.Config{
-		ID:          req.GetId(),
-		Name:        req.GetName(),
-		PathPrefix:  req.GetPathPrefix(),
-		TargetURL:   req.GetTargetUrl(),
-		StripPrefix: req.GetStripPrefix(),
-		Middleware:  req.GetMiddleware(),
-		Timeout:     timeout,
+		ID:               req.GetId(),
+		Name:             req.GetName(),
+		PathPrefix:       req.GetPathPrefix(),
+		Hosts:            req.GetHosts(),
+		Match:            protoRouteMatchToModel(req.GetMatch()),
+		Priority:         int(req.GetPriority()),
+		TargetURL:        req.GetTargetUrl(),
+		Targets:          protoTargetsToModel(req.GetTargets()),
+		Split:            protoTrafficSplitToModel(req.GetSplit()),
+		Mirror:           protoMirrorToModel(req.GetMirror()),
+		Identity:         protoIdentityToModel(req.GetIdentity()),
+		RateLimit:        protoRateLimitToModel(req.GetRateLimit()),
+		LoadBalancer:     protoLoadBalancerToModel(req.GetLoadBalancer()),
+		HealthCheck:      protoHealthCheckToModel(req.GetHealthCheck()),
+		CircuitBreaker:   protoCircuitBreakerToModel(req.GetCircuitBreaker()),
+		RetryPolicy:      protoRetryPolicyToModel(req.GetRetryPolicy()),
+		StripPrefix:      req.GetStripPrefix(),
+		Rewrite:          protoRewriteToModel(req.GetRewrite()),
+		Middleware:       req.GetMiddleware(),
+		MiddlewareConfig: middlewareConfig,
+		Timeout:          timeout,
 	}
 
 	if req.GetAuthentication() != nil {
@@ -314,15 +580,29 @@ func modelToProto(config *models.Config) *opengate_v1.Config {
 	}
 
 	protoConfig := &opengate_v1.Config{
-		Id:          config.ID,
-		Name:        config.Name,
-		PathPrefix:  config.PathPrefix,
-		TargetUrl:   config.TargetURL,
-		StripPrefix: config.StripPrefix,
-		Middleware:  config.Middleware,
-		Timeout:     int64(config.Timeout),
-		CreatedAt:   config.CreatedAt.Unix(),
-		UpdatedAt:   config.UpdatedAt.Unix(),
+		Id:               config.ID,
+		Name:             config.Name,
+		PathPrefix:       config.PathPrefix,
+		Hosts:            config.Hosts,
+		Match:            modelRouteMatchToProto(config.Match),
+		Priority:         int32(config.Priority),
+		TargetUrl:        config.TargetURL,
+		Targets:          modelTargetsToProto(config.Targets),
+		Split:            modelTrafficSplitToProto(config.Split),
+		Mirror:           modelMirrorToProto(config.Mirror),
+		Identity:         modelIdentityToProto(config.Identity),
+		RateLimit:        modelRateLimitToProto(config.RateLimit),
+		LoadBalancer:     modelLoadBalancerToProto(config.LoadBalancer),
+		HealthCheck:      modelHealthCheckToProto(config.HealthCheck),
+		CircuitBreaker:   modelCircuitBreakerToProto(config.CircuitBreaker),
+		RetryPolicy:      modelRetryPolicyToProto(config.RetryPolicy),
+		StripPrefix:      config.StripPrefix,
+		Rewrite:          modelRewriteToProto(config.Rewrite),
+		Middleware:       config.Middleware,
+		MiddlewareConfig: modelMiddlewareConfigToProto(config.MiddlewareConfig),
+		Timeout:          int64(config.Timeout),
+		CreatedAt:        config.CreatedAt.Unix(),
+		UpdatedAt:        config.UpdatedAt.Unix(),
 	}
 
 	if config.Authentication != nil {
@@ -339,13 +619,27 @@ func serviceRouteToProto(route *models.ServiceRoute) *opengate_v1.Route {
 	}
 
 	protoRoute := &opengate_v1.Route{
-		Name:        route.Name,
-		PathPrefix:  route.PathPrefix,
-		TargetUrl:   route.TargetURL,
-		StripPrefix: route.StripPrefix,
-		Middleware:  route.Middleware,
-		Timeout:     int64(route.Timeout),
-		UpdatedAt:   route.UpdatedAt,
+		Name:             route.Name,
+		PathPrefix:       route.PathPrefix,
+		Hosts:            route.Hosts,
+		Match:            modelRouteMatchToProto(route.Match),
+		Priority:         int32(route.Priority),
+		TargetUrl:        route.TargetURL,
+		Targets:          modelTargetsToProto(route.Targets),
+		Split:            modelTrafficSplitToProto(route.Split),
+		Mirror:           modelMirrorToProto(route.Mirror),
+		Identity:         modelIdentityToProto(route.Identity),
+		RateLimit:        modelRateLimitToProto(route.RateLimit),
+		LoadBalancer:     modelLoadBalancerToProto(route.LoadBalancer),
+		HealthCheck:      modelHealthCheckToProto(route.HealthCheck),
+		CircuitBreaker:   modelCircuitBreakerToProto(route.CircuitBreaker),
+		RetryPolicy:      modelRetryPolicyToProto(route.RetryPolicy),
+		StripPrefix:      route.StripPrefix,
+		Rewrite:          modelRewriteToProto(route.Rewrite),
+		Middleware:       route.Middleware,
+		MiddlewareConfig: modelMiddlewareConfigToProto(route.MiddlewareConfig),
+		Timeout:          int64(route.Timeout),
+		UpdatedAt:        route.UpdatedAt,
 	}
 
 	if route.Authentication != nil {
@@ -362,22 +656,42 @@ func protoAuthToModel(auth *opengate_v1.Authentication) *models.Authentication {
 	}
 
 	modelAuth := &models.Authentication{
-		Required: auth.GetRequired(),
+		Required:   auth.GetRequired(),
+		Strategies: auth.GetStrategies(),
+		Mode:       auth.GetMode(),
+		Require:    protoRequirementsToModel(auth.GetRequire()),
 	}
 
 	for _, except := range auth.GetExcept() {
-		modelAuth.Except = append(modelAuth.Except, struct {
-			Path    string   `json:"path" yaml:"Path"`
-			Methods []string `json:"methods" yaml:"Methods"`
-		}{
+		modelAuth.Except = append(modelAuth.Except, models.AuthenticationException{
 			Path:    except.GetPath(),
 			Methods: except.GetMethods(),
+			Require: protoRequirementsToModel(except.GetRequire()),
 		})
 	}
 
 	return modelAuth
 }
 
+// protoRequirementsToModel converts proto Requirements to model Requirements
+func protoRequirementsToModel(require *opengate_v1.Requirements) *models.Requirements {
+	if require == nil {
+		return nil
+	}
+	modelRequire := &models.Requirements{
+		Permissions: require.GetPermissions(),
+		Profiles:    require.GetProfiles(),
+	}
+	for _, claim := range require.GetClaims() {
+		modelRequire.Claims = append(modelRequire.Claims, models.ClaimRequirement{
+			Name:     claim.GetName(),
+			Operator: claim.GetOperator(),
+			Values:   claim.GetValues(),
+		})
+	}
+	return modelRequire
+}
+
 // modelAuthToProto converts model Authentication to proto Authentication
 func modelAuthToProto(auth *models.Authentication) *opengate_v1.Authentication {
 	if auth == nil {
@@ -385,15 +699,471 @@ func modelAuthToProto(auth *models.Authentication) *opengate_v1.Authentication {
 	}
 
 	protoAuth := &opengate_v1.Authentication{
-		Required: auth.Required,
+		Required:   auth.Required,
+		Strategies: auth.Strategies,
+		Mode:       auth.Mode,
+		Require:    modelRequirementsToProto(auth.Require),
 	}
 
 	for _, except := range auth.Except {
 		protoAuth.Except = append(protoAuth.Except, &opengate_v1.AuthenticationException{
 			Path:    except.Path,
 			Methods: except.Methods,
+			Require: modelRequirementsToProto(except.Require),
 		})
 	}
 
 	return protoAuth
 }
+
+// modelRequirementsToProto converts model Requirements to proto Requirements
+func modelRequirementsToProto(require *models.Requirements) *opengate_v1.Requirements {
+	if require == nil {
+		return nil
+	}
+	protoRequire := &opengate_v1.Requirements{
+		Permissions: require.Permissions,
+		Profiles:    require.Profiles,
+	}
+	for _, claim := range require.Claims {
+		protoRequire.Claims = append(protoRequire.Claims, &opengate_v1.ClaimRequirement{
+			Name:     claim.Name,
+			Operator: claim.Operator,
+			Values:   claim.Values,
+		})
+	}
+	return protoRequire
+}
+
+// protoTargetsToModel converts proto Targets to model Targets
+func protoTargetsToModel(targets []*opengate_v1.Target) []models.Target {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	modelTargets := make([]models.Target, len(targets))
+	for i, target := range targets {
+		modelTargets[i] = models.Target{
+			URL:    target.GetUrl(),
+			Weight: int(target.GetWeight()),
+		}
+	}
+
+	return modelTargets
+}
+
+// modelTargetsToProto converts model Targets to proto Targets
+func modelTargetsToProto(targets []models.Target) []*opengate_v1.Target {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	protoTargets := make([]*opengate_v1.Target, len(targets))
+	for i, target := range targets {
+		protoTargets[i] = &opengate_v1.Target{
+			Url:    target.URL,
+			Weight: int32(target.Weight),
+		}
+	}
+
+	return protoTargets
+}
+
+// protoTrafficSplitToModel converts proto TrafficSplit to model TrafficSplit
+func protoTrafficSplitToModel(split *opengate_v1.TrafficSplit) *models.TrafficSplit {
+	if split == nil {
+		return nil
+	}
+
+	versions := make([]models.RouteVersion, len(split.GetVersions()))
+	for i, version := range split.GetVersions() {
+		versions[i] = models.RouteVersion{
+			Name:    version.GetName(),
+			Weight:  int(version.GetWeight()),
+			Targets: protoTargetsToModel(version.GetTargets()),
+		}
+	}
+	return &models.TrafficSplit{
+		Versions:  versions,
+		StickyOn:  split.GetStickyOn(),
+		StickyKey: split.GetStickyKey(),
+	}
+}
+
+// modelTrafficSplitToProto converts model TrafficSplit to proto TrafficSplit
+func modelTrafficSplitToProto(split *models.TrafficSplit) *opengate_v1.TrafficSplit {
+	if split == nil {
+		return nil
+	}
+
+	versions := make([]*opengate_v1.RouteVersion, len(split.Versions))
+	for i, version := range split.Versions {
+		versions[i] = &opengate_v1.RouteVersion{
+			Name:    version.Name,
+			Weight:  int32(version.Weight),
+			Targets: modelTargetsToProto(version.Targets),
+		}
+	}
+	return &opengate_v1.TrafficSplit{
+		Versions:  versions,
+		StickyOn:  split.StickyOn,
+		StickyKey: split.StickyKey,
+	}
+}
+
+// protoLoadBalancerToModel converts proto LoadBalancer to model LoadBalancer
+func protoLoadBalancerToModel(lb *opengate_v1.LoadBalancer) *models.LoadBalancer {
+	if lb == nil {
+		return nil
+	}
+
+	return &models.LoadBalancer{
+		Policy:  lb.GetPolicy(),
+		HashOn:  lb.GetHashOn(),
+		HashKey: lb.GetHashKey(),
+	}
+}
+
+// modelLoadBalancerToProto converts model LoadBalancer to proto LoadBalancer
+func modelLoadBalancerToProto(lb *models.LoadBalancer) *opengate_v1.LoadBalancer {
+	if lb == nil {
+		return nil
+	}
+
+	return &opengate_v1.LoadBalancer{
+		Policy:  lb.Policy,
+		HashOn:  lb.HashOn,
+		HashKey: lb.HashKey,
+	}
+}
+
+// protoHealthCheckToModel converts proto HealthCheck to model HealthCheck
+func protoHealthCheckToModel(check *opengate_v1.HealthCheck) *models.HealthCheck {
+	if check == nil {
+		return nil
+	}
+
+	return &models.HealthCheck{
+		Path:               check.GetPath(),
+		Interval:           time.Duration(check.GetInterval()),
+		Timeout:            time.Duration(check.GetTimeout()),
+		HealthyThreshold:   int(check.GetHealthyThreshold()),
+		UnhealthyThreshold: int(check.GetUnhealthyThreshold()),
+		ExpectedStatus:     int(check.GetExpectedStatus()),
+	}
+}
+
+// modelHealthCheckToProto converts model HealthCheck to proto HealthCheck
+func modelHealthCheckToProto(check *models.HealthCheck) *opengate_v1.HealthCheck {
+	if check == nil {
+		return nil
+	}
+
+	return &opengate_v1.HealthCheck{
+		Path:               check.Path,
+		Interval:           int64(check.Interval),
+		Timeout:            int64(check.Timeout),
+		HealthyThreshold:   int32(check.HealthyThreshold),
+		UnhealthyThreshold: int32(check.UnhealthyThreshold),
+		ExpectedStatus:     int32(check.ExpectedStatus),
+	}
+}
+
+// protoCircuitBreakerToModel converts proto CircuitBreaker to model CircuitBreaker
+func protoCircuitBreakerToModel(cb *opengate_v1.CircuitBreaker) *models.CircuitBreaker {
+	if cb == nil {
+		return nil
+	}
+
+	return &models.CircuitBreaker{
+		ConsecutiveFailures: int(cb.GetConsecutiveFailures()),
+		ErrorRateThreshold:  int(cb.GetErrorRateThreshold()),
+		MinRequests:         int(cb.GetMinRequests()),
+		Window:              time.Duration(cb.GetWindow()),
+		EjectionDuration:    time.Duration(cb.GetEjectionDuration()),
+		HalfOpenRequests:    int(cb.GetHalfOpenRequests()),
+	}
+}
+
+// modelCircuitBreakerToProto converts model CircuitBreaker to proto CircuitBreaker
+func modelCircuitBreakerToProto(cb *models.CircuitBreaker) *opengate_v1.CircuitBreaker {
+	if cb == nil {
+		return nil
+	}
+
+	return &opengate_v1.CircuitBreaker{
+		ConsecutiveFailures: int32(cb.ConsecutiveFailures),
+		ErrorRateThreshold:  int32(cb.ErrorRateThreshold),
+		MinRequests:         int32(cb.MinRequests),
+		Window:              int64(cb.Window),
+		EjectionDuration:    int64(cb.EjectionDuration),
+		HalfOpenRequests:    int32(cb.HalfOpenRequests),
+	}
+}
+
+// protoRetryPolicyToModel converts proto RetryPolicy to model RetryPolicy
+func protoRetryPolicyToModel(policy *opengate_v1.RetryPolicy) *models.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &models.RetryPolicy{
+		Attempts:          int(policy.GetAttempts()),
+		RetryOn:           policy.GetRetryOn(),
+		PerTryTimeout:     time.Duration(policy.GetPerTryTimeout()),
+		BackoffBase:       time.Duration(policy.GetBackoffBase()),
+		BackoffMax:        time.Duration(policy.GetBackoffMax()),
+		Budget:            int(policy.GetBudget()),
+		MaxBodyBytes:      policy.GetMaxBodyBytes(),
+		IdempotentMethods: policy.GetIdempotentMethods(),
+	}
+}
+
+// modelRetryPolicyToProto converts model RetryPolicy to proto RetryPolicy
+func modelRetryPolicyToProto(policy *models.RetryPolicy) *opengate_v1.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &opengate_v1.RetryPolicy{
+		Attempts:          int32(policy.Attempts),
+		RetryOn:           policy.RetryOn,
+		PerTryTimeout:     int64(policy.PerTryTimeout),
+		BackoffBase:       int64(policy.BackoffBase),
+		BackoffMax:        int64(policy.BackoffMax),
+		Budget:            int32(policy.Budget),
+		MaxBodyBytes:      policy.MaxBodyBytes,
+		IdempotentMethods: policy.IdempotentMethods,
+	}
+}
+
+// protoRouteMatchToModel converts proto RouteMatch to model RouteMatch
+func protoRouteMatchToModel(match *opengate_v1.RouteMatch) *models.RouteMatch {
+	if match == nil {
+		return nil
+	}
+
+	modelMatch := &models.RouteMatch{Methods: match.GetMethods()}
+	for _, header := range match.GetHeaders() {
+		modelMatch.Headers = append(modelMatch.Headers, models.HeaderMatch{
+			Name:    header.GetName(),
+			Exact:   header.GetExact(),
+			Regex:   header.GetRegex(),
+			Present: header.GetPresent(),
+		})
+	}
+	for _, param := range match.GetQueryParams() {
+		modelMatch.QueryParams = append(modelMatch.QueryParams, models.QueryParamMatch{
+			Name:    param.GetName(),
+			Exact:   param.GetExact(),
+			Present: param.GetPresent(),
+		})
+	}
+	return modelMatch
+}
+
+// modelRouteMatchToProto converts model RouteMatch to proto RouteMatch
+func modelRouteMatchToProto(match *models.RouteMatch) *opengate_v1.RouteMatch {
+	if match == nil {
+		return nil
+	}
+
+	protoMatch := &opengate_v1.RouteMatch{Methods: match.Methods}
+	for _, header := range match.Headers {
+		protoMatch.Headers = append(protoMatch.Headers, &opengate_v1.HeaderMatch{
+			Name:    header.Name,
+			Exact:   header.Exact,
+			Regex:   header.Regex,
+			Present: header.Present,
+		})
+	}
+	for _, param := range match.QueryParams {
+		protoMatch.QueryParams = append(protoMatch.QueryParams, &opengate_v1.QueryParamMatch{
+			Name:    param.Name,
+			Exact:   param.Exact,
+			Present: param.Present,
+		})
+	}
+	return protoMatch
+}
+
+// protoRewriteToModel converts proto Rewrite to model Rewrite
+func protoRewriteToModel(rewrite *opengate_v1.Rewrite) *models.Rewrite {
+	if rewrite == nil {
+		return nil
+	}
+
+	return &models.Rewrite{
+		Prefix:      rewrite.GetPrefix(),
+		Regex:       rewrite.GetRegex(),
+		Replacement: rewrite.GetReplacement(),
+		Path:        rewrite.GetPath(),
+		Host:        rewrite.GetHost(),
+	}
+}
+
+// modelRewriteToProto converts model Rewrite to proto Rewrite
+func modelRewriteToProto(rewrite *models.Rewrite) *opengate_v1.Rewrite {
+	if rewrite == nil {
+		return nil
+	}
+
+	return &opengate_v1.Rewrite{
+		Prefix:      rewrite.Prefix,
+		Regex:       rewrite.Regex,
+		Replacement: rewrite.Replacement,
+		Path:        rewrite.Path,
+		Host:        rewrite.Host,
+	}
+}
+
+// protoMirrorToModel converts proto Mirror to model Mirror
+func protoMirrorToModel(m *opengate_v1.Mirror) *models.Mirror {
+	if m == nil {
+		return nil
+	}
+
+	return &models.Mirror{
+		TargetURL:    m.GetTargetUrl(),
+		Percentage:   m.GetPercentage(),
+		MaxBodyBytes: m.GetMaxBodyBytes(),
+		Timeout:      time.Duration(m.GetTimeout()),
+	}
+}
+
+// modelMirrorToProto converts model Mirror to proto Mirror
+func modelMirrorToProto(m *models.Mirror) *opengate_v1.Mirror {
+	if m == nil {
+		return nil
+	}
+
+	return &opengate_v1.Mirror{
+		TargetUrl:    m.TargetURL,
+		Percentage:   m.Percentage,
+		MaxBodyBytes: m.MaxBodyBytes,
+		Timeout:      int64(m.Timeout),
+	}
+}
+
+// protoRateLimitToModel converts proto RateLimit to model RateLimit
+func protoRateLimitToModel(rl *opengate_v1.RateLimit) *models.RateLimit {
+	if rl == nil {
+		return nil
+	}
+
+	return &models.RateLimit{
+		Algorithm: rl.GetAlgorithm(),
+		Requests:  rl.GetRequests(),
+		Window:    time.Duration(rl.GetWindow()),
+		Burst:     rl.GetBurst(),
+		Key:       rl.GetKey(),
+		Header:    rl.GetHeader(),
+	}
+}
+
+// modelRateLimitToProto converts model RateLimit to proto RateLimit
+func modelRateLimitToProto(rl *models.RateLimit) *opengate_v1.RateLimit {
+	if rl == nil {
+		return nil
+	}
+
+	return &opengate_v1.RateLimit{
+		Algorithm: rl.Algorithm,
+		Requests:  rl.Requests,
+		Window:    int64(rl.Window),
+		Burst:     rl.Burst,
+		Key:       rl.Key,
+		Header:    rl.Header,
+	}
+}
+
+// protoIdentityToModel converts proto Identity to model Identity
+func protoIdentityToModel(identity *opengate_v1.Identity) *models.Identity {
+	if identity == nil {
+		return nil
+	}
+
+	result := &models.Identity{
+		OmitDefaultHeaders: identity.GetOmitDefaultHeaders(),
+		ForwardToken:       identity.GetForwardToken(),
+		InternalToken:      identity.GetInternalToken(),
+	}
+	for _, claimHeader := range identity.GetClaimHeaders() {
+		result.ClaimHeaders = append(result.ClaimHeaders, models.ClaimHeader{
+			Claim:  claimHeader.GetClaim(),
+			Header: claimHeader.GetHeader(),
+		})
+	}
+	if len(identity.GetStaticHeaders()) > 0 {
+		result.StaticHeaders = make(map[string]string, len(identity.GetStaticHeaders()))
+		for _, header := range identity.GetStaticHeaders() {
+			result.StaticHeaders[header.GetKey()] = header.GetValue()
+		}
+	}
+	return result
+}
+
+// modelIdentityToProto converts model Identity to proto Identity
+func modelIdentityToProto(identity *models.Identity) *opengate_v1.Identity {
+	if identity == nil {
+		return nil
+	}
+
+	result := &opengate_v1.Identity{
+		OmitDefaultHeaders: identity.OmitDefaultHeaders,
+		ForwardToken:       identity.ForwardToken,
+		InternalToken:      identity.InternalToken,
+	}
+	for _, claimHeader := range identity.ClaimHeaders {
+		result.ClaimHeaders = append(result.ClaimHeaders, &opengate_v1.ClaimHeader{
+			Claim:  claimHeader.Claim,
+			Header: claimHeader.Header,
+		})
+	}
+	// sorted so the headers are listed in a stable order
+	names := make([]string, 0, len(identity.StaticHeaders))
+	for name := range identity.StaticHeaders {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		result.StaticHeaders = append(result.StaticHeaders, &opengate_v1.KeyValue{Key: name, Value: identity.StaticHeaders[name]})
+	}
+	return result
+}
+
+// protoMiddlewareConfigToModel converts the proto middleware config to the config of each middleware by name
+func protoMiddlewareConfigToModel(config *structpb.Struct) (map[string]map[string]any, error) {
+	if len(config.GetFields()) == 0 {
+		return nil, nil
+	}
+
+	modelConfig := make(map[string]map[string]any, len(config.GetFields()))
+	for name, value := range config.GetFields() {
+		middlewareConfig := value.GetStructValue()
+		if middlewareConfig == nil {
+			return nil, fmt.Errorf("config of middleware %q must be an object", name)
+		}
+		modelConfig[name] = middlewareConfig.AsMap()
+	}
+
+	return modelConfig, nil
+}
+
+// modelMiddlewareConfigToProto converts the config of each middleware by name to the proto middleware config
+func modelMiddlewareConfigToProto(config map[string]map[string]any) *structpb.Struct {
+	if len(config) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]any, len(config))
+	for name, middlewareConfig := range config {
+		fields[name] = middlewareConfig
+	}
+	protoConfig, err := structpb.NewStruct(fields)
+	if err != nil {
+		return nil
+	}
+
+	return protoConfig
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/opengate/api/opengate_v1"
+	"github.com/gofreego/opengate/internal/constants"
+)
+
+// GetHealth implements the gRPC OpenGateServiceServer interface.
+func (s *Service) GetHealth(ctx context.Context, req *opengate_v1.GetHealthRequest) (*opengate_v1.GetHealthResponse, error) {
+	// Check read permission
+	if err := s.checkPermission(ctx, constants.PERMISSION_ROUTES_READ); err != nil {
+		return nil, err
+	}
+
+	return &opengate_v1.GetHealthResponse{
+		Routes:  s.routesHealth(ctx),
+		Message: "Health retrieved successfully",
+	}, nil
+}
+
+// routesHealth returns the health and circuit breaker state of the upstream targets of every active route
+// along with the stats of its mirror
+func (s *Service) routesHealth(ctx context.Context) []*opengate_v1.RouteHealth {
+	routes := s.routeManager.GetRoutes()
+	routesHealth := make([]*opengate_v1.RouteHealth, 0, len(routes))
+	for _, route := range routes {
+		balancer, err := s.routeManager.GetBalancer(route)
+		if err != nil {
+			logger.Warn(ctx, "Invalid upstream targets for route %s: %v", route.Name, err)
+			continue
+		}
+
+		routeHealth := &opengate_v1.RouteHealth{
+			Name:                  route.Name,
+			HealthCheckEnabled:    route.HealthCheck != nil,
+			CircuitBreakerEnabled: route.CircuitBreaker != nil,
+		}
+		for _, target := range balancer.Targets() {
+			health := target.Health()
+			targetHealth := &opengate_v1.TargetHealth{
+				Url:                  target.URL.String(),
+				Healthy:              health.Healthy,
+				ConsecutiveSuccesses: int32(health.ConsecutiveSuccesses),
+				ConsecutiveFailures:  int32(health.ConsecutiveFailures),
+				LastError:            health.LastError,
+				ActiveRequests:       target.ActiveRequests(),
+			}
+			if !health.LastCheckedAt.IsZero() {
+				targetHealth.LastCheckedAt = health.LastCheckedAt.UnixMilli()
+			}
+			if target.Breaker != nil {
+				breaker := target.Breaker.Snapshot()
+				targetHealth.CircuitState = breaker.State
+				targetHealth.CircuitFailures = int32(breaker.ConsecutiveFailures)
+				if !breaker.OpenedAt.IsZero() {
+					targetHealth.CircuitOpenedAt = breaker.OpenedAt.UnixMilli()
+				}
+			}
+			routeHealth.Targets = append(routeHealth.Targets, targetHealth)
+		}
+		if route.Mirror != nil {
+			if m, err := s.routeManager.GetMirror(route); err == nil {
+				stats := m.Stats()
+				routeHealth.Mirror = &opengate_v1.MirrorHealth{
+					Target:       stats.Target,
+					Requests:     stats.Requests,
+					Errors:       stats.Errors,
+					Dropped:      stats.Dropped,
+					AvgLatencyMs: stats.AvgLatency.Milliseconds(),
+					MaxLatencyMs: stats.MaxLatency.Milliseconds(),
+					LastError:    stats.LastError,
+				}
+			}
+		}
+		routesHealth = append(routesHealth, routeHealth)
+	}
+	return routesHealth
+}
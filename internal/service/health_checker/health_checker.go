@@ -0,0 +1,218 @@
+package healthchecker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/opengate/internal/models"
+	loadbalancer "github.com/gofreego/opengate/internal/service/load_balancer"
+	routemanager "github.com/gofreego/opengate/internal/service/route_manager"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+const (
+	defaultCheckInterval      = time.Second
+	defaultInterval           = 10 * time.Second
+	defaultTimeout            = 2 * time.Second
+	defaultHealthyThreshold   = 2
+	defaultUnhealthyThreshold = 3
+
+	// maxDrainBytes bounds how much of a probe response body is read so the connection can be reused
+	maxDrainBytes = 4 << 10
+)
+
+type HealthChecker interface {
+	Start(ctx context.Context)
+}
+
+type Config struct {
+	// CheckInterval is how often the checker looks for routes whose health check is due.
+	// Routes are still probed at their own Interval.
+	CheckInterval time.Duration `yaml:"CheckInterval"`
+}
+
+type healthChecker struct {
+	routeManager routemanager.Manager
+	cfg          *Config
+
+	mu       sync.Mutex
+	lastRun  map[string]time.Time // route name -> last probe start
+	inFlight map[string]bool      // route name -> probes still running
+}
+
+func New(routeManager routemanager.Manager, cfg *Config) HealthChecker {
+	return &healthChecker{
+		routeManager: routeManager,
+		cfg:          cfg,
+		lastRun:      make(map[string]time.Time),
+		inFlight:     make(map[string]bool),
+	}
+}
+
+// Start probes the targets of every route with a health check until the context is cancelled
+func (hc *healthChecker) Start(ctx context.Context) {
+	logger.Info(ctx, "Health checker started")
+
+	interval := defaultCheckInterval
+	if hc.cfg != nil && hc.cfg.CheckInterval > 0 {
+		interval = hc.cfg.CheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Health checker stopped")
+			return
+		case now := <-ticker.C:
+			hc.runDueChecks(ctx, now)
+		}
+	}
+}
+
+// runDueChecks starts the probes of every route whose health check interval elapsed
+func (hc *healthChecker) runDueChecks(ctx context.Context, now time.Time) {
+	routes := hc.routeManager.GetRoutes()
+	names := make(map[string]struct{}, len(routes))
+
+	for _, route := range routes {
+		names[route.Name] = struct{}{}
+		if route.HealthCheck == nil || !hc.markDue(route, now) {
+			continue
+		}
+
+		balancer, err := hc.routeManager.GetBalancer(route)
+		if err != nil {
+			logger.Error(ctx, "Skipping health check of route %s: %v", route.Name, err)
+			hc.markDone(route.Name)
+			continue
+		}
+		go hc.checkRoute(ctx, route, balancer)
+	}
+
+	// forget routes that no longer exist
+	hc.mu.Lock()
+	for name := range hc.lastRun {
+		if _, ok := names[name]; !ok {
+			delete(hc.lastRun, name)
+		}
+	}
+	hc.mu.Unlock()
+}
+
+// markDue reports whether the route should be probed now and, if so, marks its probes as running
+func (hc *healthChecker) markDue(route *models.ServiceRoute, now time.Time) bool {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	if hc.inFlight[route.Name] {
+		return false
+	}
+	if last, ok := hc.lastRun[route.Name]; ok && now.Sub(last) < utils.ValueOrDefault(route.HealthCheck.Interval, defaultInterval) {
+		return false
+	}
+	hc.lastRun[route.Name] = now
+	hc.inFlight[route.Name] = true
+	return true
+}
+
+func (hc *healthChecker) markDone(name string) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	delete(hc.inFlight, name)
+}
+
+// checkRoute probes all targets of the route concurrently and updates their health
+func (hc *healthChecker) checkRoute(ctx context.Context, route *models.ServiceRoute, balancer loadbalancer.Balancer) {
+	defer hc.markDone(route.Name)
+
+	check := route.HealthCheck
+	client := &http.Client{
+		Transport: hc.routeManager.GetTransport(route),
+		Timeout:   utils.ValueOrDefault(check.Timeout, defaultTimeout),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	healthyThreshold := utils.ValueOrDefault(check.HealthyThreshold, defaultHealthyThreshold)
+	unhealthyThreshold := utils.ValueOrDefault(check.UnhealthyThreshold, defaultUnhealthyThreshold)
+
+	var wg sync.WaitGroup
+	for _, target := range balancer.Targets() {
+		wg.Add(1)
+		go func(target *loadbalancer.Target) {
+			defer wg.Done()
+			err := probe(ctx, client, target, check)
+			if !target.RecordCheck(err, healthyThreshold, unhealthyThreshold) {
+				return
+			}
+			if target.Healthy() {
+				logger.Info(ctx, "Target %s of route %s is healthy again", target.URL, route.Name)
+			} else {
+				logger.Warn(ctx, "Target %s of route %s is unhealthy, removed from rotation: %v", target.URL, route.Name, err)
+			}
+		}(target)
+	}
+	wg.Wait()
+}
+
+// probe sends a GET request to the health check path of the target
+func probe(ctx context.Context, client *http.Client, target *loadbalancer.Target, check *models.HealthCheck) error {
+	u := *target.URL
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + strings.TrimPrefix(check.Path, "/")
+	u.RawPath = ""
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes))
+
+	if !isExpectedStatus(resp.StatusCode, check.ExpectedStatus) {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// isExpectedStatus accepts any 2xx when no status is configured
+func isExpectedStatus(status, expected int) bool {
+	if expected == 0 {
+		return status >= 200 && status < 300
+	}
+	return status == expected
+}
+
+// Validate checks the health check settings of a route
+func Validate(check *models.HealthCheck) error {
+	if check == nil {
+		return nil
+	}
+	if !strings.HasPrefix(check.Path, "/") {
+		return fmt.Errorf("health check path must start with /")
+	}
+	if check.Interval < 0 || check.Timeout < 0 {
+		return fmt.Errorf("health check interval and timeout must not be negative")
+	}
+	if check.Timeout > 0 && check.Interval > 0 && check.Timeout > check.Interval {
+		return fmt.Errorf("health check timeout must not exceed its interval")
+	}
+	if check.HealthyThreshold < 0 || check.UnhealthyThreshold < 0 {
+		return fmt.Errorf("health check thresholds must not be negative")
+	}
+	if check.ExpectedStatus != 0 && (check.ExpectedStatus < 100 || check.ExpectedStatus > 599) {
+		return fmt.Errorf("invalid health check expected status %d", check.ExpectedStatus)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package healthchecker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+	routemanager "github.com/gofreego/opengate/internal/service/route_manager"
+)
+
+// upstream is a test server answering its health check with a status the test changes
+type upstream struct {
+	*httptest.Server
+	status atomic.Int64
+	probes atomic.Int64
+}
+
+func newUpstream(t *testing.T) *upstream {
+	t.Helper()
+	u := &upstream{}
+	u.status.Store(http.StatusOK)
+	u.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			u.probes.Add(1)
+		}
+		w.WriteHeader(int(u.status.Load()))
+	}))
+	t.Cleanup(u.Close)
+	return u
+}
+
+// runChecks runs the checks due at now and waits for their probes to finish
+func runChecks(t *testing.T, hc *healthChecker, now time.Time) {
+	t.Helper()
+	hc.runDueChecks(context.Background(), now)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		hc.mu.Lock()
+		running := len(hc.inFlight)
+		hc.mu.Unlock()
+		if running == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the probes to finish")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHealthChecker(t *testing.T) {
+	flaky, stable := newUpstream(t), newUpstream(t)
+	manager := routemanager.New(&routemanager.Config{})
+	manager.ReplaceRoutes([]*models.ServiceRoute{{
+		Name:        "orders",
+		PathPrefix:  "/orders",
+		Targets:     []models.Target{{URL: flaky.URL}, {URL: stable.URL}},
+		HealthCheck: &models.HealthCheck{Path: "/healthz", Interval: time.Second, HealthyThreshold: 2, UnhealthyThreshold: 2},
+	}})
+	route := manager.GetRouteByName("orders")
+	balancer, err := manager.GetBalancer(route)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	target := balancer.Targets()[0]
+	inRotation := func() bool {
+		for i := 0; i < 4; i++ {
+			if balancer.Next(nil) == target {
+				return true
+			}
+		}
+		return false
+	}
+
+	hc := New(manager, &Config{}).(*healthChecker)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the target stays in rotation until it failed the unhealthy threshold in a row
+	flaky.status.Store(http.StatusServiceUnavailable)
+	runChecks(t, hc, now)
+	if !target.Healthy() || !inRotation() {
+		t.Fatal("expected a single failure to keep the target in rotation")
+	}
+	now = now.Add(time.Second)
+	runChecks(t, hc, now)
+	if target.Healthy() || inRotation() {
+		t.Fatal("expected the target to be taken out of rotation")
+	}
+	if health := target.Health(); health.LastError != "unexpected status 503" {
+		t.Fatalf("expected the failed probe to be recorded, got %q", health.LastError)
+	}
+
+	// and comes back after the healthy threshold
+	flaky.status.Store(http.StatusOK)
+	now = now.Add(time.Second)
+	runChecks(t, hc, now)
+	if target.Healthy() {
+		t.Fatal("expected a single success to keep the target out of rotation")
+	}
+	now = now.Add(time.Second)
+	runChecks(t, hc, now)
+	if !target.Healthy() || !inRotation() {
+		t.Fatal("expected the target to be put back in rotation")
+	}
+
+	// routes are probed at their own interval
+	probes := stable.probes.Load()
+	runChecks(t, hc, now.Add(time.Second/2))
+	if got := stable.probes.Load(); got != probes {
+		t.Fatalf("expected no probe before the interval elapsed, got %d more", got-probes)
+	}
+
+	// removed routes are no longer probed
+	manager.ReplaceRoutes(nil)
+	runChecks(t, hc, now.Add(time.Minute))
+	if got := stable.probes.Load(); got != probes {
+		t.Fatalf("expected the removed route not to be probed, got %d more", got-probes)
+	}
+	if len(hc.lastRun) != 0 {
+		t.Fatalf("expected the removed route to be forgotten, got %v", hc.lastRun)
+	}
+}
+
+func TestStartStopsWithContext(t *testing.T) {
+	hc := New(routemanager.New(&routemanager.Config{}), &Config{CheckInterval: time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		hc.Start(ctx)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the health checker to stop with its context")
+	}
+}
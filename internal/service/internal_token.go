@@ -0,0 +1,49 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/internal/service/auth"
+)
+
+// JWKSPath is where the admin server publishes the keys internal tokens are verified with
+const JWKSPath = "/.well-known/jwks.json"
+
+// attachInternalToken removes the internal token header sent by the client and, for routes asking for
+// it, sends upstream a token minted for the route. It reports false when the response was written.
+func (s *Service) attachInternalToken(ctx *gin.Context, route *models.ServiceRoute) bool {
+	header := s.tokenMinter.Header()
+	// the Authorization header is stripped or forwarded by the route's identity
+	if http.CanonicalHeaderKey(header) != "Authorization" {
+		ctx.Request.Header.Del(header)
+	}
+	if route.Identity == nil || !route.Identity.InternalToken {
+		return true
+	}
+	claims := auth.Claims(ctx)
+	if claims == nil {
+		return true
+	}
+
+	token, err := s.tokenMinter.Mint(claims, route.Name)
+	if err != nil {
+		logger.Error(ctx, "Failed to mint internal token for route %s: %v", route.Name, err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mint internal token"})
+		return false
+	}
+	if http.CanonicalHeaderKey(header) == "Authorization" {
+		token = "Bearer " + token
+	}
+	auth.AddUpstreamHeaders(ctx, http.Header{http.CanonicalHeaderKey(header): {token}})
+	return true
+}
+
+// ServeJWKS serves the JWK set of the internal token keys
+func (s *Service) ServeJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.Write(s.tokenMinter.JWKS())
+}
@@ -0,0 +1,101 @@
+package loadbalancer
+
+import (
+	"hash/fnv"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+// virtualNodesPerWeight is the number of points each unit of weight gets on the hash ring
+const virtualNodesPerWeight = 100
+
+type ringPoint struct {
+	hash   uint64
+	target *Target
+}
+
+// consistentHash maps requests with the same key (header, cookie or client IP) to the same target.
+// Adding or removing a target, or a target leaving rotation, only remaps the keys that belonged to it.
+// Requests without a key fall back to round robin.
+type consistentHash struct {
+	targets  []*Target
+	ring     []ringPoint
+	hashOn   string
+	hashKey  string
+	fallback *roundRobin
+}
+
+func newConsistentHash(targets []*Target, hashOn, hashKey string) *consistentHash {
+	b := &consistentHash{
+		targets:  targets,
+		hashOn:   hashOn,
+		hashKey:  hashKey,
+		fallback: newRoundRobin(targets),
+	}
+	for _, target := range targets {
+		for i := 0; i < target.Weight*virtualNodesPerWeight; i++ {
+			b.ring = append(b.ring, ringPoint{
+				hash:   hashKey64(target.URL.String() + "#" + strconv.Itoa(i)),
+				target: target,
+			})
+		}
+	}
+	sort.Slice(b.ring, func(i, j int) bool {
+		return b.ring[i].hash < b.ring[j].hash
+	})
+	return b
+}
+
+func (b *consistentHash) Next(req *http.Request) *Target {
+	if len(b.ring) == 0 {
+		return nil
+	}
+
+	key := requestKey(req, b.hashOn, b.hashKey)
+	if key == "" {
+		return b.fallback.Next(req)
+	}
+
+	hash := hashKey64(key)
+	idx := sort.Search(len(b.ring), func(i int) bool {
+		return b.ring[i].hash >= hash
+	})
+	// walk the ring clockwise to the first available target
+	for i := 0; i < len(b.ring); i++ {
+		if target := b.ring[(idx+i)%len(b.ring)].target; target.Available() {
+			return target
+		}
+	}
+	return nil
+}
+
+func (b *consistentHash) Targets() []*Target {
+	return b.targets
+}
+
+// requestKey extracts the value to hash from the request
+func requestKey(req *http.Request, hashOn, hashKey string) string {
+	switch hashOn {
+	case HashOnHeader:
+		return req.Header.Get(hashKey)
+	case HashOnCookie:
+		if cookie, err := req.Cookie(hashKey); err == nil {
+			return cookie.Value
+		}
+		return ""
+	case HashOnClientIP:
+		return utils.GetClientIP(req)
+	}
+	return ""
+}
+
+// hashKey64 hashes the key onto the ring, mixed as the FNV hashes of the virtual nodes of a target
+// only differ in their low bits and would otherwise cluster on the ring
+func hashKey64(key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return mix64(h.Sum64())
+}
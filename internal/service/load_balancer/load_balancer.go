@@ -0,0 +1,197 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+	circuitbreaker "github.com/gofreego/opengate/internal/service/circuit_breaker"
+)
+
+// Supported load balancing policies
+const (
+	PolicyRoundRobin         = "round_robin"
+	PolicyWeightedRoundRobin = "weighted_round_robin"
+	PolicyLeastConnections   = "least_connections"
+	PolicyRandomTwoChoices   = "random_two_choices"
+	PolicyConsistentHash     = "consistent_hash"
+)
+
+// Supported consistent hash keys
+const (
+	HashOnHeader   = "header"
+	HashOnCookie   = "cookie"
+	HashOnClientIP = "client_ip"
+)
+
+// Target is a parsed upstream target along with its runtime state
+type Target struct {
+	URL       *url.URL
+	Weight    int
+	active    atomic.Int64 // in-flight requests
+	unhealthy atomic.Bool  // targets start healthy until a health check says otherwise
+
+	// Breaker is the passive circuit breaker of the target, nil when the route has none
+	Breaker *circuitbreaker.Breaker
+
+	mu     sync.Mutex
+	health HealthStatus
+}
+
+// HealthStatus is the result of the active health checks of a target
+type HealthStatus struct {
+	Healthy              bool
+	ConsecutiveSuccesses int
+	ConsecutiveFailures  int
+	LastCheckedAt        time.Time // zero if the target was never checked
+	LastError            string
+}
+
+// Acquire marks a request as in flight on the target. Every Acquire must be paired with a Release.
+func (t *Target) Acquire() {
+	t.active.Add(1)
+}
+
+// Release marks an in-flight request on the target as done
+func (t *Target) Release() {
+	t.active.Add(-1)
+}
+
+// ActiveRequests returns the number of requests currently in flight on the target
+func (t *Target) ActiveRequests() int64 {
+	return t.active.Load()
+}
+
+// Healthy reports whether the target is in rotation
+func (t *Target) Healthy() bool {
+	return !t.unhealthy.Load()
+}
+
+// Available reports whether the target is healthy and not ejected by its circuit breaker
+func (t *Target) Available() bool {
+	return t.Healthy() && (t.Breaker == nil || t.Breaker.Ready())
+}
+
+// Health returns a snapshot of the target's health check state
+func (t *Target) Health() HealthStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	status := t.health
+	status.Healthy = t.Healthy()
+	return status
+}
+
+// RecordCheck records the outcome of a health check. The target is taken out of rotation after
+// unhealthyThreshold consecutive failures and put back after healthyThreshold consecutive successes.
+// It reports whether the target's health changed.
+func (t *Target) RecordCheck(checkErr error, healthyThreshold, unhealthyThreshold int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.health.LastCheckedAt = time.Now()
+	if checkErr != nil {
+		t.health.ConsecutiveSuccesses = 0
+		t.health.ConsecutiveFailures++
+		t.health.LastError = checkErr.Error()
+		if t.Healthy() && t.health.ConsecutiveFailures >= unhealthyThreshold {
+			t.unhealthy.Store(true)
+			return true
+		}
+		return false
+	}
+
+	t.health.ConsecutiveFailures = 0
+	t.health.ConsecutiveSuccesses++
+	t.health.LastError = ""
+	if !t.Healthy() && t.health.ConsecutiveSuccesses >= healthyThreshold {
+		t.unhealthy.Store(false)
+		return true
+	}
+	return false
+}
+
+// Balancer picks the upstream target for each request of a route
+type Balancer interface {
+	// Next returns the available target the request should be sent to, or nil if there is none
+	Next(req *http.Request) *Target
+	// Targets returns all targets of the balancer
+	Targets() []*Target
+}
+
+// New builds a balancer for the given targets using the configured policy.
+// A nil load balancer config defaults to round robin.
+func New(cfg *models.LoadBalancer, targets []models.Target) (Balancer, error) {
+	if err := Validate(cfg, targets); err != nil {
+		return nil, err
+	}
+
+	parsed := make([]*Target, len(targets))
+	for i, target := range targets {
+		u, _ := url.Parse(target.URL) // already validated
+		weight := target.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		parsed[i] = &Target{URL: u, Weight: weight}
+	}
+
+	if cfg == nil {
+		return newRoundRobin(parsed), nil
+	}
+	switch cfg.Policy {
+	case PolicyWeightedRoundRobin:
+		return newWeightedRoundRobin(parsed), nil
+	case PolicyLeastConnections:
+		return newLeastConnections(parsed), nil
+	case PolicyRandomTwoChoices:
+		return newRandomTwoChoices(parsed), nil
+	case PolicyConsistentHash:
+		return newConsistentHash(parsed, cfg.HashOn, cfg.HashKey), nil
+	default:
+		return newRoundRobin(parsed), nil
+	}
+}
+
+// Validate checks the targets and load balancer settings of a route
+func Validate(cfg *models.LoadBalancer, targets []models.Target) error {
+	if len(targets) == 0 {
+		return fmt.Errorf("at least one target is required")
+	}
+	for _, target := range targets {
+		u, err := url.Parse(target.URL)
+		if err != nil {
+			return fmt.Errorf("invalid target url %q: %w", target.URL, err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid target url %q: scheme and host are required", target.URL)
+		}
+		if target.Weight < 0 {
+			return fmt.Errorf("invalid weight %d for target %q: must not be negative", target.Weight, target.URL)
+		}
+	}
+
+	if cfg == nil {
+		return nil
+	}
+	switch cfg.Policy {
+	case "", PolicyRoundRobin, PolicyWeightedRoundRobin, PolicyLeastConnections, PolicyRandomTwoChoices:
+		return nil
+	case PolicyConsistentHash:
+		switch cfg.HashOn {
+		case HashOnHeader, HashOnCookie:
+			if cfg.HashKey == "" {
+				return fmt.Errorf("hash_key is required when hashing on %s", cfg.HashOn)
+			}
+		case HashOnClientIP:
+		default:
+			return fmt.Errorf("invalid hash_on %q: must be one of %s, %s, %s", cfg.HashOn, HashOnHeader, HashOnCookie, HashOnClientIP)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid load balancing policy %q", cfg.Policy)
+	}
+}
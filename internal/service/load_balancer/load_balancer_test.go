@@ -0,0 +1,158 @@
+package loadbalancer
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+func newTestBalancer(t *testing.T, cfg *models.LoadBalancer, targets ...models.Target) Balancer {
+	t.Helper()
+	b, err := New(cfg, targets)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return b
+}
+
+// takeOutOfRotation fails the health check of the target once with thresholds of one
+func takeOutOfRotation(target *Target) {
+	target.RecordCheck(errors.New("connection refused"), 1, 1)
+}
+
+func TestWeightedRoundRobin(t *testing.T) {
+	b := newTestBalancer(t, &models.LoadBalancer{Policy: PolicyWeightedRoundRobin},
+		models.Target{URL: "http://a.local", Weight: 5},
+		models.Target{URL: "http://b.local", Weight: 1},
+		models.Target{URL: "http://c.local", Weight: 1},
+	)
+
+	// every cycle of the total weight picks the targets by their weight, without bursts to the heaviest
+	var picked string
+	counts := map[string]int{}
+	for i := 0; i < 7*10; i++ {
+		host := b.Next(nil).URL.Host
+		picked += host[:1]
+		counts[host]++
+	}
+	if counts["a.local"] != 50 || counts["b.local"] != 10 || counts["c.local"] != 10 {
+		t.Fatalf("expected a 5:1:1 distribution, got %v", counts)
+	}
+	if picked[:7] != "aabacaa" {
+		t.Fatalf("expected the smooth order aabacaa, got %s", picked[:7])
+	}
+
+	takeOutOfRotation(b.Targets()[0])
+	for i := 0; i < 10; i++ {
+		if host := b.Next(nil).URL.Host; host == "a.local" {
+			t.Fatal("expected the unhealthy target to be skipped")
+		}
+	}
+}
+
+func TestLeastConnections(t *testing.T) {
+	b := newTestBalancer(t, &models.LoadBalancer{Policy: PolicyLeastConnections},
+		models.Target{URL: "http://a.local"},
+		models.Target{URL: "http://b.local", Weight: 2},
+	)
+	a, bTarget := b.Targets()[0], b.Targets()[1]
+
+	a.Acquire()
+	if got := b.Next(nil); got != bTarget {
+		t.Fatalf("expected the idle target, got %s", got.URL)
+	}
+	// b takes twice the load of a for its weight
+	bTarget.Acquire()
+	bTarget.Acquire()
+	if got := b.Next(nil); got != bTarget && got != a {
+		t.Fatalf("expected a target, got %v", got)
+	}
+	bTarget.Acquire()
+	if got := b.Next(nil); got != a {
+		t.Fatalf("expected the less loaded target, got %s", got.URL)
+	}
+
+	a.Release()
+	bTarget.Release()
+	bTarget.Release()
+	bTarget.Release()
+	if a.ActiveRequests() != 0 || bTarget.ActiveRequests() != 0 {
+		t.Fatal("expected every request to be released")
+	}
+	seen := map[*Target]bool{}
+	for i := 0; i < 4; i++ {
+		seen[b.Next(nil)] = true
+	}
+	if len(seen) != 2 {
+		t.Fatal("expected idle targets to share the load")
+	}
+}
+
+func TestConsistentHash(t *testing.T) {
+	targets := make([]models.Target, 4)
+	for i := range targets {
+		targets[i] = models.Target{URL: fmt.Sprintf("http://upstream-%d.local", i)}
+	}
+	b := newTestBalancer(t, &models.LoadBalancer{Policy: PolicyConsistentHash, HashOn: HashOnHeader, HashKey: "X-Session"}, targets...)
+	request := func(session string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if session != "" {
+			req.Header.Set("X-Session", session)
+		}
+		return req
+	}
+
+	before := map[string]*Target{}
+	shares := map[*Target]int{}
+	for i := 0; i < 200; i++ {
+		session := fmt.Sprintf("session-%d", i)
+		before[session] = b.Next(request(session))
+		if got := b.Next(request(session)); got != before[session] {
+			t.Fatalf("expected %s to stick to %s, got %s", session, before[session].URL, got.URL)
+		}
+		shares[before[session]]++
+	}
+	for _, target := range b.Targets() {
+		if shares[target] < 25 {
+			t.Fatalf("expected the keys to spread over the targets, got %d of 200 on %s", shares[target], target.URL)
+		}
+	}
+
+	// only the keys of the target leaving rotation move
+	gone := b.Targets()[1]
+	takeOutOfRotation(gone)
+	for session, target := range before {
+		got := b.Next(request(session))
+		switch {
+		case got == gone:
+			t.Fatalf("expected %s to leave the target out of rotation", session)
+		case target != gone && got != target:
+			t.Fatalf("expected %s to stay on %s, got %s", session, target.URL, got.URL)
+		}
+	}
+
+	// requests without a key are spread in round robin
+	seen := map[*Target]bool{}
+	for i := 0; i < 4; i++ {
+		seen[b.Next(request(""))] = true
+	}
+	if len(seen) != 3 || seen[gone] {
+		t.Fatalf("expected requests without a key to go round robin over the available targets, got %d targets", len(seen))
+	}
+}
+
+func TestNoAvailableTarget(t *testing.T) {
+	for _, policy := range []string{PolicyRoundRobin, PolicyWeightedRoundRobin, PolicyLeastConnections, PolicyRandomTwoChoices} {
+		b := newTestBalancer(t, &models.LoadBalancer{Policy: policy}, models.Target{URL: "http://a.local"}, models.Target{URL: "http://b.local"})
+		for _, target := range b.Targets() {
+			takeOutOfRotation(target)
+		}
+		if got := b.Next(httptest.NewRequest(http.MethodGet, "/", nil)); got != nil {
+			t.Fatalf("expected %s to find no target, got %s", policy, got.URL)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package loadbalancer
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// roundRobin cycles through the available targets in order
+type roundRobin struct {
+	targets []*Target
+	next    atomic.Uint64
+}
+
+func newRoundRobin(targets []*Target) *roundRobin {
+	return &roundRobin{targets: targets}
+}
+
+func (b *roundRobin) Next(req *http.Request) *Target {
+	for range b.targets {
+		n := b.next.Add(1) - 1
+		if target := b.targets[n%uint64(len(b.targets))]; target.Available() {
+			return target
+		}
+	}
+	return nil
+}
+
+func (b *roundRobin) Targets() []*Target {
+	return b.targets
+}
+
+// weightedRoundRobin is the smooth weighted round robin used by nginx:
+// targets are picked proportionally to their weight without sending bursts to the heaviest one
+type weightedRoundRobin struct {
+	targets []*Target
+	mu      sync.Mutex
+	current []int
+}
+
+func newWeightedRoundRobin(targets []*Target) *weightedRoundRobin {
+	return &weightedRoundRobin{
+		targets: targets,
+		current: make([]int, len(targets)),
+	}
+}
+
+func (b *weightedRoundRobin) Next(req *http.Request) *Target {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	total, best := 0, -1
+	for i, target := range b.targets {
+		if !target.Available() {
+			continue
+		}
+		b.current[i] += target.Weight
+		total += target.Weight
+		if best < 0 || b.current[i] > b.current[best] {
+			best = i
+		}
+	}
+	if best < 0 {
+		return nil
+	}
+	b.current[best] -= total
+	return b.targets[best]
+}
+
+func (b *weightedRoundRobin) Targets() []*Target {
+	return b.targets
+}
+
+// leastConnections picks the available target with the fewest in-flight requests relative to its weight.
+// Ties are broken in round robin order so idle targets share the load evenly.
+type leastConnections struct {
+	targets []*Target
+	next    atomic.Uint64
+}
+
+func newLeastConnections(targets []*Target) *leastConnections {
+	return &leastConnections{targets: targets}
+}
+
+func (b *leastConnections) Next(req *http.Request) *Target {
+	if len(b.targets) == 0 {
+		return nil
+	}
+
+	start := int((b.next.Add(1) - 1) % uint64(len(b.targets)))
+	var best *Target
+	for i := range b.targets {
+		target := b.targets[(start+i)%len(b.targets)]
+		if !target.Available() {
+			continue
+		}
+		if best == nil || lessLoaded(target, best) {
+			best = target
+		}
+	}
+	return best
+}
+
+func (b *leastConnections) Targets() []*Target {
+	return b.targets
+}
+
+// randomTwoChoices samples two distinct available targets at random and picks the less loaded one
+type randomTwoChoices struct {
+	targets []*Target
+}
+
+func newRandomTwoChoices(targets []*Target) *randomTwoChoices {
+	return &randomTwoChoices{targets: targets}
+}
+
+func (b *randomTwoChoices) Next(req *http.Request) *Target {
+	available := availableTargets(b.targets)
+	switch len(available) {
+	case 0:
+		return nil
+	case 1:
+		return available[0]
+	}
+
+	i := rand.IntN(len(available))
+	j := rand.IntN(len(available) - 1)
+	if j >= i {
+		j++
+	}
+	if lessLoaded(available[j], available[i]) {
+		return available[j]
+	}
+	return available[i]
+}
+
+func (b *randomTwoChoices) Targets() []*Target {
+	return b.targets
+}
+
+// lessLoaded reports whether a has fewer in-flight requests per unit of weight than b
+func lessLoaded(a, b *Target) bool {
+	return a.ActiveRequests()*int64(b.Weight) < b.ActiveRequests()*int64(a.Weight)
+}
+
+// availableTargets returns the targets that are in rotation, avoiding a copy when all of them are
+func availableTargets(targets []*Target) []*Target {
+	for i, target := range targets {
+		if target.Available() {
+			continue
+		}
+		available := make([]*Target, i, len(targets)-1)
+		copy(available, targets[:i])
+		for _, t := range targets[i+1:] {
+			if t.Available() {
+				available = append(available, t)
+			}
+		}
+		return available
+	}
+	return targets
+}
@@ -0,0 +1,140 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+// Version is a weighted version of a route's backend along with the balancer of its targets
+type Version struct {
+	Name     string
+	Weight   int
+	Balancer Balancer
+}
+
+// split spreads the requests of a route across the versions of its backend by weight. Every request is
+// mapped to a point of [0, total weight) and the versions own consecutive ranges of it in order. Requests
+// with a sticky key always map to the same point, so a client keeps its version as long as the weights
+// don't change, and raising the weight of one of two versions only moves clients towards it.
+type split struct {
+	versions  []Version
+	total     int
+	stickyOn  string
+	stickyKey string
+	targets   []*Target
+}
+
+// NewSplit builds a balancer splitting the requests between the versions by weight, pinning clients to a
+// version by the hash of their stickyOn value (header, cookie or client_ip) when set
+func NewSplit(versions []Version, stickyOn, stickyKey string) Balancer {
+	b := &split{
+		versions:  versions,
+		stickyOn:  stickyOn,
+		stickyKey: stickyKey,
+	}
+	for _, version := range versions {
+		b.total += version.Weight
+		b.targets = append(b.targets, version.Balancer.Targets()...)
+	}
+	return b
+}
+
+// Next picks the version of the request and returns one of its targets. When the version has no available
+// target the other versions still receiving traffic take the request.
+func (b *split) Next(req *http.Request) *Target {
+	if b.total <= 0 {
+		return nil
+	}
+
+	picked := b.pick(req)
+	if target := b.versions[picked].Balancer.Next(req); target != nil {
+		return target
+	}
+	for i, version := range b.versions {
+		if i == picked || version.Weight <= 0 {
+			continue
+		}
+		if target := version.Balancer.Next(req); target != nil {
+			return target
+		}
+	}
+	return nil
+}
+
+// pick returns the index of the version whose range holds the point of the request
+func (b *split) pick(req *http.Request) int {
+	var point int
+	if key := requestKey(req, b.stickyOn, b.stickyKey); key != "" {
+		// the top 53 bits of the mixed hash as a fraction of [0, 1), scaled to the total weight
+		point = int(float64(hashKey64(key)>>11) / (1 << 53) * float64(b.total))
+	} else {
+		point = rand.IntN(b.total)
+	}
+	for i, version := range b.versions {
+		if point < version.Weight {
+			return i
+		}
+		point -= version.Weight
+	}
+	return len(b.versions) - 1
+}
+
+func (b *split) Targets() []*Target {
+	return b.targets
+}
+
+// mix64 is the murmur3 finalizer, it spreads the low bit differences of similar keys over the high bits
+func mix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+// ValidateSplit checks the versions and sticky settings of a traffic split
+func ValidateSplit(cfg *models.LoadBalancer, split *models.TrafficSplit) error {
+	if split == nil {
+		return nil
+	}
+	if len(split.Versions) == 0 {
+		return fmt.Errorf("split requires at least one version")
+	}
+
+	total := 0
+	names := make(map[string]struct{}, len(split.Versions))
+	for _, version := range split.Versions {
+		if version.Name == "" {
+			return fmt.Errorf("name is required for every split version")
+		}
+		if _, ok := names[version.Name]; ok {
+			return fmt.Errorf("duplicate split version %q", version.Name)
+		}
+		names[version.Name] = struct{}{}
+		if version.Weight < 0 {
+			return fmt.Errorf("invalid weight %d for version %q: must not be negative", version.Weight, version.Name)
+		}
+		total += version.Weight
+		if err := Validate(cfg, version.Targets); err != nil {
+			return fmt.Errorf("version %q: %w", version.Name, err)
+		}
+	}
+	if total == 0 {
+		return fmt.Errorf("at least one split version must have a weight")
+	}
+
+	switch split.StickyOn {
+	case "", HashOnClientIP:
+	case HashOnHeader, HashOnCookie:
+		if split.StickyKey == "" {
+			return fmt.Errorf("sticky_key is required when sticky on %s", split.StickyOn)
+		}
+	default:
+		return fmt.Errorf("invalid sticky_on %q: must be one of %s, %s, %s", split.StickyOn, HashOnHeader, HashOnCookie, HashOnClientIP)
+	}
+	return nil
+}
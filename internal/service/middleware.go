@@ -0,0 +1,146 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/opengate/internal/models"
+)
+
+// Middleware wraps the handling of a proxied request. It may act before and after calling next,
+// or answer the request itself without calling next.
+type Middleware func(next gin.HandlerFunc) gin.HandlerFunc
+
+// MiddlewareFactory builds a middleware from its per-route JSON config, nil when the route has none
+type MiddlewareFactory func(config json.RawMessage) (Middleware, error)
+
+// middlewareRegistry holds the middlewares routes can reference by name in their Middleware list
+var middlewareRegistry = map[string]MiddlewareFactory{
+	MiddlewareCORS:      newCORSMiddleware,
+	MiddlewareLogging:   newLoggingMiddleware,
+	MiddlewareRequestID: newRequestIDMiddleware,
+	MiddlewareHeaders:   newHeadersMiddleware,
+}
+
+// RegisterMiddleware makes a middleware available to routes under the given name.
+// It must be called before the service is created.
+func RegisterMiddleware(name string, factory MiddlewareFactory) {
+	middlewareRegistry[name] = factory
+}
+
+// buildMiddlewareChain resolves the named middlewares with their config into a single middleware
+// running them in order, the first one being the outermost
+func buildMiddlewareChain(names []string, configs map[string]map[string]any) (Middleware, error) {
+	for name := range configs {
+		if !slices.Contains(names, name) {
+			return nil, fmt.Errorf("middleware_config has an entry for %q which is not in middleware", name)
+		}
+	}
+
+	middlewares := make([]Middleware, 0, len(names))
+	for _, name := range names {
+		factory, ok := middlewareRegistry[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown middleware %q, must be one of %s", name, strings.Join(registeredMiddlewares(), ", "))
+		}
+
+		var config json.RawMessage
+		if cfg, ok := configs[name]; ok {
+			raw, err := json.Marshal(cfg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid config of middleware %q: %w", name, err)
+			}
+			config = raw
+		}
+
+		middleware, err := factory(config)
+		if err != nil {
+			return nil, fmt.Errorf("invalid config of middleware %q: %w", name, err)
+		}
+		middlewares = append(middlewares, middleware)
+	}
+
+	return func(next gin.HandlerFunc) gin.HandlerFunc {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](next)
+		}
+		return next
+	}, nil
+}
+
+// registeredMiddlewares returns the sorted names of the registered middlewares
+func registeredMiddlewares() []string {
+	names := make([]string, 0, len(middlewareRegistry))
+	for name := range middlewareRegistry {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// validateMiddleware checks that every middleware of a route is registered and accepts its config
+func validateMiddleware(names []string, configs map[string]map[string]any) error {
+	_, err := buildMiddlewareChain(names, configs)
+	return err
+}
+
+// middlewareChainEntry is a resolved middleware chain along with the settings it was built for
+type middlewareChainEntry struct {
+	chain     Middleware
+	signature string
+}
+
+// middlewareChains keeps the resolved middleware chain of every route so middlewares are only
+// built once per route version rather than on every request
+type middlewareChains struct {
+	mu      sync.RWMutex
+	entries map[string]*middlewareChainEntry // route name -> chain
+}
+
+func newMiddlewareChains() *middlewareChains {
+	return &middlewareChains{
+		entries: make(map[string]*middlewareChainEntry),
+	}
+}
+
+// get returns the middleware chain of the route, resolving it again if the route's middlewares changed
+func (c *middlewareChains) get(route *models.ServiceRoute) (Middleware, error) {
+	configJSON, err := json.Marshal(route.MiddlewareConfig)
+	if err != nil {
+		return nil, err
+	}
+	signature := strings.Join(route.Middleware, ",") + "|" + string(configJSON)
+
+	c.mu.RLock()
+	entry := c.entries[route.Name]
+	c.mu.RUnlock()
+	if entry != nil && entry.signature == signature {
+		return entry.chain, nil
+	}
+
+	chain, err := buildMiddlewareChain(route.Middleware, route.MiddlewareConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[route.Name] = &middlewareChainEntry{
+		chain:     chain,
+		signature: signature,
+	}
+	return chain, nil
+}
+
+// remove drops the middleware chains of the routes
+func (c *middlewareChains) remove(names ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, name := range names {
+		delete(c.entries, name)
+	}
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+// Built-in middlewares
+const (
+	MiddlewareCORS      = "cors"
+	MiddlewareLogging   = "logging"
+	MiddlewareRequestID = "request_id"
+	MiddlewareHeaders   = "headers"
+)
+
+const defaultRequestIDHeader = "X-Request-Id"
+
+// decodeMiddlewareConfig unmarshals a middleware config, rejecting unknown fields so typos don't go unnoticed
+func decodeMiddlewareConfig(config json.RawMessage, v any) error {
+	if config == nil {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(config))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// newCORSMiddleware replaces the CORS headers of the route's responses, including any set by the upstream,
+// with the configured policy. Without config the gateway wide CORS policy applies unchanged.
+func newCORSMiddleware(config json.RawMessage) (Middleware, error) {
+	if config == nil {
+		return func(next gin.HandlerFunc) gin.HandlerFunc { return next }, nil
+	}
+	cfg := utils.DefaultCORSConfig()
+	if err := decodeMiddlewareConfig(config, cfg); err != nil {
+		return nil, err
+	}
+
+	return func(next gin.HandlerFunc) gin.HandlerFunc {
+		return func(ctx *gin.Context) {
+			origin := ctx.Request.Header.Get("Origin")
+			withResponseHeaders(ctx, next, func(header http.Header) {
+				header.Del("Access-Control-Allow-Origin")
+				header.Del("Access-Control-Allow-Methods")
+				header.Del("Access-Control-Allow-Headers")
+				header.Del("Access-Control-Max-Age")
+				if cfg.Enabled && origin != "" {
+					header.Set("Access-Control-Allow-Origin", cfg.AllowedOrigins)
+					header.Set("Access-Control-Allow-Methods", cfg.AllowedMethods)
+					header.Set("Access-Control-Allow-Headers", cfg.AllowedHeaders)
+					header.Set("Access-Control-Max-Age", strconv.Itoa(cfg.MaxAge))
+				}
+			})
+		}
+	}, nil
+}
+
+// newLoggingMiddleware logs every proxied request of the route with the upstream URL it was sent to,
+// after any rewrite, its status and latency
+func newLoggingMiddleware(config json.RawMessage) (Middleware, error) {
+	if err := decodeMiddlewareConfig(config, &struct{}{}); err != nil {
+		return nil, err
+	}
+
+	return func(next gin.HandlerFunc) gin.HandlerFunc {
+		return func(ctx *gin.Context) {
+			start := time.Now()
+			method, path := ctx.Request.Method, ctx.Request.URL.RequestURI()
+			next(ctx)
+			upstream := ctx.GetString(constants.UPSTREAM_URL)
+			if upstream == "" {
+				upstream = "-"
+			}
+			logger.Info(ctx, "%s %s -> %s %d %v %s", method, path, upstream, ctx.Writer.Status(),
+				time.Since(start), utils.GetClientIP(ctx.Request))
+		}
+	}, nil
+}
+
+// requestIDConfig is the config of the request_id middleware
+type requestIDConfig struct {
+	Header string `json:"header"` // default X-Request-Id
+}
+
+// newRequestIDMiddleware makes sure every request carries a request id, generating one if the client sent none,
+// and echoes it in the response
+func newRequestIDMiddleware(config json.RawMessage) (Middleware, error) {
+	cfg := requestIDConfig{Header: defaultRequestIDHeader}
+	if err := decodeMiddlewareConfig(config, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Header == "" {
+		return nil, fmt.Errorf("header must not be empty")
+	}
+
+	return func(next gin.HandlerFunc) gin.HandlerFunc {
+		return func(ctx *gin.Context) {
+			requestID := ctx.Request.Header.Get(cfg.Header)
+			if requestID == "" {
+				requestID = newRequestID()
+				ctx.Request.Header.Set(cfg.Header, requestID)
+			}
+			ctx.Writer.Header().Set(cfg.Header, requestID)
+			next(ctx)
+		}
+	}, nil
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// headerRules are the header changes applied by the headers middleware.
+// Set values may reference path parameters of the route as {name}.
+type headerRules struct {
+	Set    map[string]string `json:"set"`
+	Remove []string          `json:"remove"`
+}
+
+func (rules *headerRules) apply(header http.Header, params utils.Params) {
+	for _, name := range rules.Remove {
+		header.Del(name)
+	}
+	for name, value := range rules.Set {
+		header.Set(name, params.Expand(value))
+	}
+}
+
+// headersConfig is the config of the headers middleware
+type headersConfig struct {
+	Request  headerRules `json:"request"`
+	Response headerRules `json:"response"`
+}
+
+// newHeadersMiddleware sets and removes headers of the requests sent upstream and of the responses sent back
+func newHeadersMiddleware(config json.RawMessage) (Middleware, error) {
+	var cfg headersConfig
+	if err := decodeMiddlewareConfig(config, &cfg); err != nil {
+		return nil, err
+	}
+
+	return func(next gin.HandlerFunc) gin.HandlerFunc {
+		return func(ctx *gin.Context) {
+			params := PathParams(ctx)
+			cfg.Request.apply(ctx.Request.Header, params)
+			withResponseHeaders(ctx, next, func(header http.Header) {
+				cfg.Response.apply(header, params)
+			})
+		}
+	}, nil
+}
+
+// withResponseHeaders runs next with rewrite applied to the response headers right before they are written
+func withResponseHeaders(ctx *gin.Context, next gin.HandlerFunc, rewrite func(http.Header)) {
+	writer := ctx.Writer
+	ctx.Writer = &headerRewriter{ResponseWriter: writer, rewrite: rewrite}
+	defer func() { ctx.Writer = writer }()
+	next(ctx)
+}
+
+// headerRewriter is a response writer applying a header rewrite once, before the headers are sent
+type headerRewriter struct {
+	gin.ResponseWriter
+	rewrite func(http.Header)
+	applied bool
+}
+
+func (w *headerRewriter) apply() {
+	if !w.applied {
+		w.applied = true
+		w.rewrite(w.Header())
+	}
+}
+
+func (w *headerRewriter) WriteHeader(code int) {
+	w.apply()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *headerRewriter) WriteHeaderNow() {
+	w.apply()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *headerRewriter) Write(data []byte) (int, error) {
+	w.apply()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *headerRewriter) WriteString(s string) (int, error) {
+	w.apply()
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// registerTraceMiddleware registers a middleware recording in trace when the request enters and leaves it
+func registerTraceMiddleware(t *testing.T, name string, trace *[]string) {
+	t.Helper()
+	RegisterMiddleware(name, func(config json.RawMessage) (Middleware, error) {
+		return func(next gin.HandlerFunc) gin.HandlerFunc {
+			return func(ctx *gin.Context) {
+				*trace = append(*trace, ">"+name)
+				next(ctx)
+				*trace = append(*trace, "<"+name)
+			}
+		}, nil
+	})
+	t.Cleanup(func() { delete(middlewareRegistry, name) })
+}
+
+func TestMiddlewareChainOrder(t *testing.T) {
+	var trace []string
+	registerTraceMiddleware(t, "outer", &trace)
+	registerTraceMiddleware(t, "inner", &trace)
+
+	chain, err := buildMiddlewareChain([]string{"outer", "inner"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	chain(func(ctx *gin.Context) { trace = append(trace, "proxy") })(ctx)
+
+	if got := strings.Join(trace, " "); got != ">outer >inner proxy <inner <outer" {
+		t.Fatalf("expected the first middleware to be the outermost, got %s", got)
+	}
+}
+
+func TestBuildMiddlewareChainRejectsInvalidConfig(t *testing.T) {
+	tests := []struct {
+		names   []string
+		configs map[string]map[string]any
+	}{
+		{[]string{"gzip"}, nil},
+		{[]string{MiddlewareLogging}, map[string]map[string]any{MiddlewareHeaders: {}}},
+		{[]string{MiddlewareHeaders}, map[string]map[string]any{MiddlewareHeaders: {"respons": map[string]any{}}}},
+		{[]string{MiddlewareHeaders}, map[string]map[string]any{MiddlewareHeaders: {"response": map[string]any{"sett": map[string]any{}}}}},
+	}
+	for _, test := range tests {
+		if _, err := buildMiddlewareChain(test.names, test.configs); err == nil {
+			t.Fatalf("expected %v with config %v to be rejected", test.names, test.configs)
+		}
+	}
+
+	configs := map[string]map[string]any{MiddlewareHeaders: {"response": map[string]any{"set": map[string]any{"X-Gateway": "opengate"}}}}
+	if _, err := buildMiddlewareChain([]string{MiddlewareRequestID, MiddlewareHeaders}, configs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestResponseHeadersAppliedOnce(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(recorder)
+	writer := ctx.Writer
+
+	rewrites := 0
+	withResponseHeaders(ctx, func(ctx *gin.Context) {
+		ctx.Writer.Header().Set("Server", "upstream")
+		ctx.Writer.WriteHeader(http.StatusCreated)
+		ctx.Writer.WriteHeaderNow()
+		ctx.Writer.Write([]byte("created"))
+		ctx.Writer.WriteString(" order")
+	}, func(header http.Header) {
+		rewrites++
+		header.Del("Server")
+		header.Add("X-Gateway", "opengate")
+	})
+
+	if rewrites != 1 {
+		t.Fatalf("expected the response headers to be rewritten once, got %d", rewrites)
+	}
+	if got := recorder.Header().Values("X-Gateway"); len(got) != 1 || recorder.Header().Get("Server") != "" {
+		t.Fatalf("expected the rewritten headers, got %v", recorder.Header())
+	}
+	if recorder.Code != http.StatusCreated || recorder.Body.String() != "created order" {
+		t.Fatalf("expected the response to be written through, got %d %q", recorder.Code, recorder.Body.String())
+	}
+	if ctx.Writer != writer {
+		t.Fatal("expected the original writer to be restored")
+	}
+}
@@ -0,0 +1,220 @@
+package mirror
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+const (
+	defaultPercentage   = 100
+	defaultMaxBodyBytes = 64 << 10
+	defaultTimeout      = 5 * time.Second
+
+	// maxInFlight bounds the mirrored requests of a route waiting on the mirror, further ones are dropped
+	maxInFlight = 64
+)
+
+// Header marks the requests sent to a mirror so it can tell them from regular traffic
+const Header = "X-Mirrored-Request"
+
+// Mirror replays a sample of the requests of a route to a secondary upstream in the background and
+// discards the responses. It keeps its own stats so the mirror never shows up in the route's numbers.
+type Mirror struct {
+	target       *url.URL
+	percentage   float64
+	maxBodyBytes int64
+	timeout      time.Duration
+	transport    *http.Transport
+	inFlight     chan struct{}
+
+	requests     atomic.Int64
+	errors       atomic.Int64
+	dropped      atomic.Int64
+	totalLatency atomic.Int64 // nanoseconds, over all completed requests
+	maxLatency   atomic.Int64 // nanoseconds
+
+	mu        sync.Mutex
+	lastError string
+}
+
+// Stats are the outcomes of the requests sent to a mirror
+type Stats struct {
+	Target     string
+	Requests   int64 // completed mirrored requests
+	Errors     int64 // mirrored requests that failed or got a 5xx
+	Dropped    int64 // sampled requests not mirrored as their body was too large or the mirror too slow
+	AvgLatency time.Duration
+	MaxLatency time.Duration
+	LastError  string
+}
+
+// New builds the mirror of a route. Zero values fall back to the defaults.
+func New(cfg *models.Mirror) (*Mirror, error) {
+	if err := Validate(cfg); err != nil {
+		return nil, err
+	}
+	target, _ := url.Parse(cfg.TargetURL) // already validated
+
+	m := &Mirror{
+		target:       target,
+		percentage:   cfg.Percentage,
+		maxBodyBytes: cfg.MaxBodyBytes,
+		timeout:      cfg.Timeout,
+		inFlight:     make(chan struct{}, maxInFlight),
+	}
+	if m.percentage <= 0 {
+		m.percentage = defaultPercentage
+	}
+	if m.maxBodyBytes <= 0 {
+		m.maxBodyBytes = defaultMaxBodyBytes
+	}
+	if m.timeout <= 0 {
+		m.timeout = defaultTimeout
+	}
+	m.transport = http.DefaultTransport.(*http.Transport).Clone()
+	m.transport.MaxIdleConnsPerHost = maxInFlight
+	return m, nil
+}
+
+// Validate checks the mirror settings of a route
+func Validate(cfg *models.Mirror) error {
+	if cfg == nil {
+		return nil
+	}
+	u, err := url.Parse(cfg.TargetURL)
+	if err != nil {
+		return fmt.Errorf("invalid mirror target_url %q: %w", cfg.TargetURL, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid mirror target_url %q: scheme and host are required", cfg.TargetURL)
+	}
+	if cfg.Percentage < 0 || cfg.Percentage > 100 {
+		return fmt.Errorf("invalid mirror percentage %v: must be between 0 and 100", cfg.Percentage)
+	}
+	if cfg.MaxBodyBytes < 0 {
+		return fmt.Errorf("invalid mirror max_body_bytes %d: must not be negative", cfg.MaxBodyBytes)
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("invalid mirror timeout %v: must not be negative", cfg.Timeout)
+	}
+	return nil
+}
+
+// Sample reports whether the request falls within the mirrored percentage
+func (m *Mirror) Sample() bool {
+	return m.percentage >= 100 || rand.Float64()*100 < m.percentage
+}
+
+// MaxBodyBytes returns the largest request body mirrored
+func (m *Mirror) MaxBodyBytes() int64 {
+	return m.maxBodyBytes
+}
+
+// Drop counts a sampled request that could not be mirrored
+func (m *Mirror) Drop() {
+	m.dropped.Add(1)
+}
+
+// Send replays the request with the body to the mirror in the background. The request, a clone owned by
+// the mirror from then on, is sent with its path, query and headers as they are to the mirror's host.
+func (m *Mirror) Send(req *http.Request, body []byte) {
+	select {
+	case m.inFlight <- struct{}{}:
+	default:
+		m.Drop()
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(req.Context()), m.timeout)
+	out := req.WithContext(ctx)
+	out.RequestURI = ""
+	out.URL.Scheme = m.target.Scheme
+	out.URL.Host = m.target.Host
+	out.URL.Path = joinPath(m.target.Path, req.URL.Path)
+	out.URL.RawPath = ""
+	out.Header.Set(Header, "true")
+	out.Body = nil
+	out.GetBody = nil
+	out.ContentLength = int64(len(body))
+	if len(body) > 0 {
+		out.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	go func() {
+		defer func() { <-m.inFlight }()
+		defer cancel()
+
+		start := time.Now()
+		resp, err := m.transport.RoundTrip(out)
+		if err == nil {
+			// drain so the connection is reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode >= http.StatusInternalServerError {
+				err = fmt.Errorf("mirror responded %s", resp.Status)
+			}
+		}
+		m.record(time.Since(start), err)
+	}()
+}
+
+func (m *Mirror) record(latency time.Duration, err error) {
+	m.requests.Add(1)
+	m.totalLatency.Add(int64(latency))
+	for {
+		current := m.maxLatency.Load()
+		if int64(latency) <= current || m.maxLatency.CompareAndSwap(current, int64(latency)) {
+			break
+		}
+	}
+	if err != nil {
+		m.errors.Add(1)
+		m.mu.Lock()
+		m.lastError = err.Error()
+		m.mu.Unlock()
+	}
+}
+
+// Stats returns the stats of the requests sent to the mirror
+func (m *Mirror) Stats() Stats {
+	stats := Stats{
+		Target:     m.target.String(),
+		Requests:   m.requests.Load(),
+		Errors:     m.errors.Load(),
+		Dropped:    m.dropped.Load(),
+		MaxLatency: time.Duration(m.maxLatency.Load()),
+	}
+	if stats.Requests > 0 {
+		stats.AvgLatency = time.Duration(m.totalLatency.Load() / stats.Requests)
+	}
+	m.mu.Lock()
+	stats.LastError = m.lastError
+	m.mu.Unlock()
+	return stats
+}
+
+// Close releases the idle connections to the mirror
+func (m *Mirror) Close() {
+	m.transport.CloseIdleConnections()
+}
+
+func joinPath(base, path string) string {
+	switch {
+	case base == "" || base == "/":
+		return path
+	case path == "" || path == "/":
+		return base
+	}
+	return strings.TrimSuffix(base, "/") + "/" + strings.TrimPrefix(path, "/")
+}
@@ -0,0 +1,70 @@
+package mirror
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+func TestSendReplaysRequestToMirror(t *testing.T) {
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r.Method + " " + r.URL.RequestURI() + " " + r.Header.Get(Header) + " " + string(body)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	m, err := New(&models.Mirror{TargetURL: server.URL + "/shadow"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer m.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/users?verbose=true", strings.NewReader("ignored"))
+	m.Send(req, []byte(`{"name":"a"}`))
+
+	select {
+	case got := <-received:
+		if want := `POST /shadow/api/users?verbose=true true {"name":"a"}`; got != want {
+			t.Fatalf("expected %q, got %q", want, got)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("mirror never received the request")
+	}
+
+	// the stats are recorded once the response is drained
+	deadline := time.Now().Add(2 * time.Second)
+	for m.Stats().Requests == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if stats := m.Stats(); stats.Requests != 1 || stats.Errors != 1 || stats.LastError == "" {
+		t.Fatalf("expected one failed request, got %+v", stats)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mirror  *models.Mirror
+		wantErr bool
+	}{
+		{"nil", nil, false},
+		{"defaults", &models.Mirror{TargetURL: "http://shadow:8080"}, false},
+		{"relative target", &models.Mirror{TargetURL: "/shadow"}, true},
+		{"percentage over 100", &models.Mirror{TargetURL: "http://shadow", Percentage: 101}, true},
+		{"negative body limit", &models.Mirror{TargetURL: "http://shadow", MaxBodyBytes: -1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.mirror); (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
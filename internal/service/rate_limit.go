@@ -0,0 +1,72 @@
+package service
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/internal/models"
+	ratelimiter "github.com/gofreego/opengate/internal/service/rate_limiter"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+// rateLimit counts the request against the route's rate limit and sets the RateLimit headers. Requests whose
+// authentication failed are counted by client IP whatever the key. It reports false when the request is over
+// the limit and was answered with a 429.
+func (s *Service) rateLimit(ctx *gin.Context, route *models.ServiceRoute, unauthenticated bool) bool {
+	if route.RateLimit == nil {
+		return true
+	}
+	key := "ip:" + s.proxies.ClientIP(ctx.Request)
+	if !unauthenticated {
+		key = rateLimitKey(ctx, route.RateLimit, s.proxies)
+	}
+	limit := ratelimiter.LimitOf(route.RateLimit)
+	result, err := s.rateLimiter.Take(ctx, route.Name+"|"+key, limit)
+	if err != nil {
+		// an unavailable backend lets the requests through rather than failing the route
+		logger.Warn(ctx, "Failed to check the rate limit of route %s: %v", route.Name, err)
+		return true
+	}
+
+	ratelimiter.SetHeaders(ctx.Writer.Header(), limit, result)
+	if !result.Allowed {
+		logger.Debug(ctx, "Rate limit exceeded for route: %s", route.Name)
+		ctx.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests"})
+		return false
+	}
+	return true
+}
+
+// rateLimitKey returns what the request is counted by, the client IP when the request lacks it. The client IP
+// is only taken from X-Forwarded-For when a trusted proxy sent the request, as clients could rotate it otherwise.
+func rateLimitKey(ctx *gin.Context, cfg *models.RateLimit, proxies utils.TrustedProxies) string {
+	switch cfg.Key {
+	case ratelimiter.KeyUserID:
+		if c, exists := ctx.Get(constants.JWT_CLAIMS); exists {
+			if claims, ok := c.(*jwtutils.JWTClaims); ok {
+				if claims.UserID != 0 {
+					return "user:" + strconv.FormatInt(claims.UserID, 10)
+				}
+				if claims.UserUUID != "" {
+					return "user:" + claims.UserUUID
+				}
+				if claims.Subject != "" {
+					return "user:" + claims.Subject
+				}
+			}
+		}
+	case ratelimiter.KeyAPIKey:
+		if id, ok := ctx.Value(constants.API_KEY_ID).(int64); ok {
+			return "api_key:" + strconv.FormatInt(id, 10)
+		}
+	case ratelimiter.KeyHeader:
+		if value := ctx.GetHeader(cfg.Header); value != "" {
+			return "header:" + value
+		}
+	}
+	return "ip:" + proxies.ClientIP(ctx.Request)
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/opengate/internal/models"
+	ratelimiter "github.com/gofreego/opengate/internal/service/rate_limiter"
+	routemanager "github.com/gofreego/opengate/internal/service/route_manager"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+func TestRateLimitIgnoresSpoofedForwardedFor(t *testing.T) {
+	proxies, err := utils.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Service{rateLimiter: ratelimiter.NewMemory(), proxies: proxies}
+	route := &models.ServiceRoute{Name: "orders", RateLimit: &models.RateLimit{Requests: 1}}
+	limited := func(remoteAddr, forwardedFor string) bool {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/orders", nil)
+		ctx.Request.RemoteAddr = remoteAddr
+		ctx.Request.Header.Set("X-Forwarded-For", forwardedFor)
+		return !s.rateLimit(ctx, route, false)
+	}
+
+	// a client rotating X-Forwarded-For is still counted by its own address
+	if limited("203.0.113.7:4000", "198.51.100.1") {
+		t.Fatal("expected the first request to be allowed")
+	}
+	if !limited("203.0.113.7:4001", "198.51.100.2") {
+		t.Fatal("expected the spoofed X-Forwarded-For to be ignored")
+	}
+
+	// behind a trusted proxy the client is the address the proxy appended, not what the client sent
+	if limited("10.0.0.1:4000", "198.51.100.1, 203.0.113.8") {
+		t.Fatal("expected the first request through the proxy to be allowed")
+	}
+	if !limited("10.0.0.2:4000", "198.51.100.2, 203.0.113.8") {
+		t.Fatal("expected the client behind the proxy to be limited")
+	}
+	if limited("10.0.0.1:4000", "203.0.113.9") {
+		t.Fatal("expected other clients behind the proxy to have their own limit")
+	}
+}
+
+// rejectingAuth is an auth manager rejecting every request
+type rejectingAuth struct{}
+
+func (rejectingAuth) Authenticate(ctx *gin.Context, strategies []string, mode string) error {
+	return errors.New("invalid credentials")
+}
+
+func (rejectingAuth) Validate(strategies []string, mode string) error { return nil }
+
+func TestRateLimitCountsRejectedCredentials(t *testing.T) {
+	for _, key := range []string{ratelimiter.KeyClientIP, ratelimiter.KeyUserID} {
+		s := &Service{routeManager: routemanager.New(&routemanager.Config{}), authManager: rejectingAuth{}, rateLimiter: ratelimiter.NewMemory()}
+		s.routeManager.AddRoute(&models.ServiceRoute{
+			Name:           "orders",
+			PathPrefix:     "/orders",
+			TargetURL:      "http://orders.local",
+			Authentication: &models.Authentication{Required: true},
+			RateLimit:      &models.RateLimit{Requests: 1, Key: key},
+		})
+
+		codes := make([]int, 2)
+		for i := range codes {
+			recorder := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(recorder)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/orders/1", nil)
+			s.RouteRequest(ctx)
+			codes[i] = recorder.Code
+		}
+		if codes[0] != http.StatusUnauthorized || codes[1] != http.StatusTooManyRequests {
+			t.Fatalf("expected the rejected credentials of the %s limit to use it up, got %v", key, codes)
+		}
+	}
+}
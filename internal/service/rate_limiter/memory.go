@@ -0,0 +1,132 @@
+package ratelimiter
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Memory is the in-memory backend. Limits of keys that stopped sending requests are dropped once
+// they're fully available again.
+type Memory struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+	now     func() time.Time
+}
+
+// entry is the state of the limit of a key
+type entry struct {
+	tokens      float64   // token bucket: tokens left at last
+	last        time.Time // token bucket: last refill
+	windowStart time.Time // sliding window: start of the current window
+	current     int64     // sliding window: requests in the current window
+	previous    int64     // sliding window: requests in the previous window
+	expires     time.Time // when the limit is fully available again
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		entries: make(map[string]*entry),
+		now:     time.Now,
+	}
+}
+
+func (m *Memory) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	e, ok := m.entries[key]
+	if !ok {
+		e = &entry{}
+		m.entries[key] = e
+	}
+	if limit.Algorithm == AlgorithmSlidingWindow {
+		return e.slidingWindow(now, limit), nil
+	}
+	return e.tokenBucket(now, limit), nil
+}
+
+// tokenBucket takes a token from a bucket holding up to Burst tokens and refilled with Requests tokens per Window
+func (e *entry) tokenBucket(now time.Time, limit Limit) Result {
+	burst := float64(limit.Burst)
+	perToken := float64(limit.Window) / float64(limit.Requests) // nanoseconds to refill a token
+	if e.last.IsZero() {
+		e.tokens = burst
+	} else {
+		e.tokens = math.Min(burst, e.tokens+float64(now.Sub(e.last))/perToken)
+	}
+	e.last = now
+
+	result := Result{Limit: limit.Burst}
+	if e.tokens >= 1 {
+		e.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - e.tokens) * perToken)
+	}
+	result.Remaining = int64(e.tokens)
+	result.Reset = time.Duration((burst - e.tokens) * perToken)
+	e.expires = now.Add(result.Reset)
+	return result
+}
+
+// slidingWindow counts the request in fixed windows and weighs the previous window by how much of it
+// the sliding window still covers, which approximates counting the requests of the last Window
+func (e *entry) slidingWindow(now time.Time, limit Limit) Result {
+	start := now.Truncate(limit.Window)
+	if !e.windowStart.Equal(start) {
+		if e.windowStart.Add(limit.Window).Equal(start) {
+			e.previous = e.current
+		} else {
+			e.previous = 0
+		}
+		e.current = 0
+		e.windowStart = start
+	}
+	elapsed := now.Sub(start)
+	weight := float64(limit.Window-elapsed) / float64(limit.Window)
+	count := float64(e.previous)*weight + float64(e.current)
+
+	result := Result{Limit: limit.Requests, Reset: limit.Window - elapsed}
+	if count+1 <= float64(limit.Requests) {
+		e.current++
+		result.Allowed = true
+		result.Remaining = max(limit.Requests-int64(math.Ceil(count+1)), 0)
+	} else if e.current+1 > limit.Requests {
+		// the current window alone is full
+		result.RetryAfter = limit.Window - elapsed
+	} else {
+		// the weight of the previous window has to drop enough for one more request
+		free := float64(limit.Requests-1-e.current) / float64(e.previous)
+		result.RetryAfter = max(time.Duration(float64(limit.Window)*(1-free))-elapsed, time.Millisecond)
+	}
+	e.expires = start.Add(2 * limit.Window)
+	return result
+}
+
+// CleanupEvery drops the idle limits at the interval until the context is done
+func (m *Memory) CleanupEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.cleanup()
+		}
+	}
+}
+
+func (m *Memory) cleanup() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	for key, e := range m.entries {
+		if !now.Before(e.expires) {
+			delete(m.entries, key)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package ratelimiter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+// Algorithms counting the requests of a rate limit
+const (
+	AlgorithmTokenBucket   = "token_bucket"   // a bucket of Burst tokens refilled at Requests per Window
+	AlgorithmSlidingWindow = "sliding_window" // at most Requests over any Window
+)
+
+// Keys the requests of a rate limit are counted by
+const (
+	KeyClientIP = "client_ip"
+	KeyUserID   = "user_id" // the authenticated user, the client IP for anonymous requests
+	KeyAPIKey   = "api_key" // the verified API key, the client IP for requests without one
+	KeyHeader   = "header"  // the value of Header, the client IP when it's missing
+)
+
+// BackendMemory keeps the limits in the memory of each gateway instance
+const BackendMemory = "memory"
+
+const (
+	defaultWindow          = time.Second
+	defaultCleanupInterval = time.Minute
+)
+
+// Response headers of a rate limited route
+const (
+	HeaderLimit      = "RateLimit-Limit"
+	HeaderRemaining  = "RateLimit-Remaining"
+	HeaderReset      = "RateLimit-Reset"
+	HeaderPolicy     = "RateLimit-Policy"
+	HeaderRetryAfter = "Retry-After"
+)
+
+type Config struct {
+	Backend         string        `yaml:"Backend"`         // memory (default)
+	CleanupInterval time.Duration `yaml:"CleanupInterval"` // how often idle limits are dropped from memory, default 1m
+	TrustedProxies  []string      `yaml:"TrustedProxies"`  // addresses or CIDRs of the proxies whose X-Forwarded-For gives the client IP
+}
+
+// Limit is how many requests a key may send
+type Limit struct {
+	Algorithm string
+	Requests  int64
+	Window    time.Duration
+	Burst     int64 // token bucket only
+}
+
+// Result is the outcome of counting a request against a limit
+type Result struct {
+	Allowed    bool
+	Limit      int64         // requests a key may send at once
+	Remaining  int64         // requests the key may still send at once
+	Reset      time.Duration // until the limit is fully available again
+	RetryAfter time.Duration // until a request is allowed again, when it wasn't
+}
+
+// Backend holds the state of the limits. The memory backend limits every gateway instance on its own,
+// a backend shared by the instances, like Redis, makes them enforce a single limit together.
+type Backend interface {
+	// Take counts a request of the key against the limit
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// New builds the backend named in the config
+func New(ctx context.Context, cfg *Config) (Backend, error) {
+	switch cfg.Backend {
+	case "", BackendMemory:
+		interval := cfg.CleanupInterval
+		if interval <= 0 {
+			interval = defaultCleanupInterval
+		}
+		m := NewMemory()
+		go m.CleanupEvery(ctx, interval)
+		return m, nil
+	}
+	return nil, fmt.Errorf("unknown rate limit backend %q: must be %s", cfg.Backend, BackendMemory)
+}
+
+// LimitOf returns the limit of a route with the defaults applied
+func LimitOf(cfg *models.RateLimit) Limit {
+	limit := Limit{
+		Algorithm: cfg.Algorithm,
+		Requests:  cfg.Requests,
+		Window:    cfg.Window,
+		Burst:     cfg.Burst,
+	}
+	if limit.Algorithm == "" {
+		limit.Algorithm = AlgorithmTokenBucket
+	}
+	if limit.Window <= 0 {
+		limit.Window = defaultWindow
+	}
+	if limit.Burst <= 0 {
+		limit.Burst = limit.Requests
+	}
+	return limit
+}
+
+// KeyedByIdentity reports whether the requests of the limit are counted by who the authentication says sent them
+func KeyedByIdentity(cfg *models.RateLimit) bool {
+	return cfg.Key == KeyUserID || cfg.Key == KeyAPIKey
+}
+
+// Validate checks the rate limit settings of a route
+func Validate(cfg *models.RateLimit) error {
+	if cfg == nil {
+		return nil
+	}
+	switch cfg.Algorithm {
+	case "", AlgorithmTokenBucket:
+	case AlgorithmSlidingWindow:
+		if cfg.Burst != 0 {
+			return fmt.Errorf("rate limit burst is only supported by the %s algorithm", AlgorithmTokenBucket)
+		}
+	default:
+		return fmt.Errorf("invalid rate limit algorithm %q: must be %s or %s", cfg.Algorithm, AlgorithmTokenBucket, AlgorithmSlidingWindow)
+	}
+	if cfg.Requests <= 0 {
+		return fmt.Errorf("invalid rate limit requests %d: must be positive", cfg.Requests)
+	}
+	if cfg.Window < 0 {
+		return fmt.Errorf("invalid rate limit window %v: must not be negative", cfg.Window)
+	}
+	if cfg.Burst < 0 {
+		return fmt.Errorf("invalid rate limit burst %d: must not be negative", cfg.Burst)
+	}
+	switch cfg.Key {
+	case "", KeyClientIP, KeyUserID, KeyAPIKey:
+		if cfg.Header != "" {
+			return fmt.Errorf("rate limit header is only used with the %s key", KeyHeader)
+		}
+	case KeyHeader:
+		if cfg.Header == "" {
+			return fmt.Errorf("rate limit header is required with the %s key", KeyHeader)
+		}
+	default:
+		return fmt.Errorf("invalid rate limit key %q: must be %s, %s, %s or %s", cfg.Key, KeyClientIP, KeyUserID, KeyAPIKey, KeyHeader)
+	}
+	return nil
+}
+
+// SetHeaders tells the client about the limit with the RateLimit headers, and when to retry if it's over it
+func SetHeaders(header http.Header, limit Limit, result Result) {
+	header.Set(HeaderLimit, strconv.FormatInt(result.Limit, 10))
+	header.Set(HeaderRemaining, strconv.FormatInt(result.Remaining, 10))
+	header.Set(HeaderReset, strconv.FormatInt(seconds(result.Reset), 10))
+	policy := fmt.Sprintf("%d;w=%d", limit.Requests, seconds(limit.Window))
+	if limit.Algorithm == AlgorithmTokenBucket && limit.Burst != limit.Requests {
+		policy += fmt.Sprintf(";burst=%d", limit.Burst)
+	}
+	header.Set(HeaderPolicy, policy)
+	if !result.Allowed {
+		header.Set(HeaderRetryAfter, strconv.FormatInt(max(seconds(result.RetryAfter), 1), 10))
+	}
+}
+
+// seconds rounds the duration up to whole seconds
+func seconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,143 @@
+package ratelimiter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+// clock is a fake time source moved forward by the tests
+type clock struct{ now time.Time }
+
+func (c *clock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestMemory() (*Memory, *clock) {
+	c := &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	m := NewMemory()
+	m.now = func() time.Time { return c.now }
+	return m, c
+}
+
+func take(t *testing.T, m *Memory, key string, limit Limit) Result {
+	t.Helper()
+	result, err := m.Take(context.Background(), key, limit)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return result
+}
+
+func TestTokenBucket(t *testing.T) {
+	m, c := newTestMemory()
+	limit := LimitOf(&models.RateLimit{Requests: 2, Window: time.Second, Burst: 4})
+
+	for i := int64(3); i >= 0; i-- {
+		if result := take(t, m, "a", limit); !result.Allowed || result.Remaining != i {
+			t.Fatalf("expected the burst to be allowed with %d remaining, got %+v", i, result)
+		}
+	}
+	result := take(t, m, "a", limit)
+	if result.Allowed || result.RetryAfter != 500*time.Millisecond || result.Reset != 2*time.Second {
+		t.Fatalf("expected the empty bucket to deny for 500ms, got %+v", result)
+	}
+	if result := take(t, m, "b", limit); !result.Allowed {
+		t.Fatal("expected other keys to have their own bucket")
+	}
+
+	// the bucket refills at two tokens per second
+	c.advance(500 * time.Millisecond)
+	if result := take(t, m, "a", limit); !result.Allowed || result.Remaining != 0 {
+		t.Fatalf("expected a refilled token, got %+v", result)
+	}
+	c.advance(10 * time.Second)
+	if result := take(t, m, "a", limit); !result.Allowed || result.Remaining != 3 {
+		t.Fatalf("expected the bucket to refill up to the burst only, got %+v", result)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	m, c := newTestMemory()
+	limit := LimitOf(&models.RateLimit{Algorithm: AlgorithmSlidingWindow, Requests: 10, Window: time.Minute})
+
+	for i := 0; i < 10; i++ {
+		if result := take(t, m, "a", limit); !result.Allowed {
+			t.Fatalf("expected request %d to be allowed", i+1)
+		}
+	}
+	c.advance(15 * time.Second)
+	result := take(t, m, "a", limit)
+	if result.Allowed || result.RetryAfter != 45*time.Second {
+		t.Fatalf("expected the full window to deny until it ends, got %+v", result)
+	}
+
+	// half way through the next window, half of the previous one still counts
+	c.advance(75 * time.Second)
+	for i := 0; i < 5; i++ {
+		if result := take(t, m, "a", limit); !result.Allowed {
+			t.Fatalf("expected request %d of the new window to be allowed, got %+v", i+1, result)
+		}
+	}
+	result = take(t, m, "a", limit)
+	if result.Allowed || result.RetryAfter != 6*time.Second {
+		t.Fatalf("expected a denial until one more previous request slides out, got %+v", result)
+	}
+	c.advance(6 * time.Second)
+	if result := take(t, m, "a", limit); !result.Allowed {
+		t.Fatalf("expected the request to be allowed once the window slid, got %+v", result)
+	}
+}
+
+func TestCleanupDropsIdleLimits(t *testing.T) {
+	m, c := newTestMemory()
+	take(t, m, "a", LimitOf(&models.RateLimit{Requests: 10}))
+	m.cleanup()
+	if len(m.entries) != 1 {
+		t.Fatal("expected the limit in use to be kept")
+	}
+	c.advance(time.Second)
+	m.cleanup()
+	if len(m.entries) != 0 {
+		t.Fatal("expected the refilled limit to be dropped")
+	}
+}
+
+func TestSetHeaders(t *testing.T) {
+	limit := LimitOf(&models.RateLimit{Requests: 100, Window: time.Minute, Burst: 150})
+	header := http.Header{}
+	SetHeaders(header, limit, Result{Limit: 150, Remaining: 0, Reset: 1500 * time.Millisecond, RetryAfter: 200 * time.Millisecond})
+	want := map[string]string{
+		HeaderLimit:      "150",
+		HeaderRemaining:  "0",
+		HeaderReset:      "2",
+		HeaderPolicy:     "100;w=60;burst=150",
+		HeaderRetryAfter: "1",
+	}
+	for name, value := range want {
+		if got := header.Get(name); got != value {
+			t.Fatalf("expected %s %q, got %q", name, value, got)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []*models.RateLimit{
+		{Requests: 0},
+		{Requests: 10, Algorithm: "leaky_bucket"},
+		{Requests: 10, Algorithm: AlgorithmSlidingWindow, Burst: 20},
+		{Requests: 10, Window: -time.Second},
+		{Requests: 10, Key: "cookie"},
+		{Requests: 10, Key: KeyHeader},
+		{Requests: 10, Header: "X-Tenant"},
+	}
+	for _, cfg := range invalid {
+		if err := Validate(cfg); err == nil {
+			t.Fatalf("expected %+v to be invalid", cfg)
+		}
+	}
+	if err := Validate(&models.RateLimit{Requests: 10, Key: KeyHeader, Header: "X-Tenant"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
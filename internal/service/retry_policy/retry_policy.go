@@ -0,0 +1,240 @@
+package retrypolicy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+// Supported retry conditions. RetryOn may also list response status codes such as "503".
+const (
+	RetryOnConnectFailure = "connect-failure" // the upstream connection could not be established
+	RetryOnReset          = "reset"           // the upstream closed or reset the connection before responding
+	RetryOnTimeout        = "timeout"         // the per-try timeout elapsed before the response headers arrived
+	RetryOn5xx            = "5xx"             // any 5xx response
+	RetryOnGatewayError   = "gateway-error"   // 502, 503 or 504 responses
+)
+
+const (
+	defaultAttempts     = 2
+	maxAttempts         = 10
+	defaultBackoffBase  = 25 * time.Millisecond
+	defaultBackoffMax   = 250 * time.Millisecond
+	defaultBudget       = 20
+	defaultMaxBodyBytes = 64 << 10
+
+	// budgetWindow is the period over which the retry budget is measured
+	budgetWindow = 10 * time.Second
+	// minRetriesPerWindow lets low traffic routes retry even when the budget percentage rounds down to nothing
+	minRetriesPerWindow = 3
+)
+
+// IdempotencyKeyHeader marks a single request as safe to retry regardless of its method
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// ErrPerTryTimeout is the cancellation cause of an attempt whose per-try timeout elapsed
+var ErrPerTryTimeout = errors.New("per-try timeout exceeded")
+
+// idempotentMethods are retried without being listed in IdempotentMethods
+var idempotentMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodTrace,
+}
+
+// Policy decides whether and when a failed upstream attempt of a route is retried.
+// It is shared by all requests of the route so the retry budget spans them.
+type Policy struct {
+	attempts          int
+	conditions        map[string]bool
+	statusCodes       map[int]bool
+	perTryTimeout     time.Duration
+	backoffBase       time.Duration
+	backoffMax        time.Duration
+	budget            int
+	maxBodyBytes      int64
+	idempotentMethods []string
+
+	mu          sync.Mutex
+	windowStart time.Time
+	requests    int
+	retries     int
+	now         func() time.Time
+}
+
+// New builds the retry policy of a route. Zero values of the config fall back to the defaults
+// and an empty RetryOn retries connection failures and resets.
+func New(cfg *models.RetryPolicy) *Policy {
+	p := &Policy{
+		attempts:          min(utils.ValueOrDefault(cfg.Attempts, defaultAttempts), maxAttempts),
+		conditions:        make(map[string]bool),
+		statusCodes:       make(map[int]bool),
+		perTryTimeout:     cfg.PerTryTimeout,
+		backoffBase:       utils.ValueOrDefault(cfg.BackoffBase, defaultBackoffBase),
+		backoffMax:        utils.ValueOrDefault(cfg.BackoffMax, defaultBackoffMax),
+		budget:            utils.ValueOrDefault(cfg.Budget, defaultBudget),
+		maxBodyBytes:      utils.ValueOrDefault(cfg.MaxBodyBytes, defaultMaxBodyBytes),
+		idempotentMethods: append(slices.Clone(idempotentMethods), cfg.IdempotentMethods...),
+		now:               time.Now,
+	}
+
+	retryOn := cfg.RetryOn
+	if len(retryOn) == 0 {
+		retryOn = []string{RetryOnConnectFailure, RetryOnReset}
+	}
+	for _, condition := range retryOn {
+		if code, err := strconv.Atoi(condition); err == nil {
+			p.statusCodes[code] = true
+			continue
+		}
+		p.conditions[condition] = true
+	}
+	return p
+}
+
+// Attempts returns the maximum number of attempts of a request, including the first one
+func (p *Policy) Attempts() int {
+	return p.attempts
+}
+
+// PerTryTimeout returns how long an attempt may wait for the response headers, 0 if unbounded
+func (p *Policy) PerTryTimeout() time.Duration {
+	return p.perTryTimeout
+}
+
+// MaxBodyBytes returns the size up to which request bodies are buffered so they can be replayed
+func (p *Policy) MaxBodyBytes() int64 {
+	return p.maxBodyBytes
+}
+
+// Retryable reports whether the request may be sent more than once
+func (p *Policy) Retryable(req *http.Request) bool {
+	return slices.Contains(p.idempotentMethods, req.Method) || req.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+// RetryableStatus reports whether an upstream response with the status should be retried
+func (p *Policy) RetryableStatus(status int) bool {
+	switch {
+	case p.statusCodes[status]:
+		return true
+	case p.conditions[RetryOn5xx] && status >= 500 && status <= 599:
+		return true
+	case p.conditions[RetryOnGatewayError]:
+		return status == http.StatusBadGateway || status == http.StatusServiceUnavailable || status == http.StatusGatewayTimeout
+	default:
+		return false
+	}
+}
+
+// RetryableError reports whether an attempt that failed with the transport error should be retried
+func (p *Policy) RetryableError(err error) bool {
+	switch {
+	case errors.Is(err, ErrPerTryTimeout):
+		return p.conditions[RetryOnTimeout]
+	case isConnectFailure(err):
+		return p.conditions[RetryOnConnectFailure]
+	case isReset(err):
+		return p.conditions[RetryOnReset]
+	default:
+		return false
+	}
+}
+
+// RecordRequest counts a request of the route towards the retry budget
+func (p *Policy) RecordRequest() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.resetWindow(p.now())
+	p.requests++
+}
+
+// AllowRetry reports whether the retry budget has room for one more retry and, if so, uses it up
+func (p *Policy) AllowRetry() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.resetWindow(p.now())
+	if p.retries >= max(p.requests*p.budget/100, minRetriesPerWindow) {
+		return false
+	}
+	p.retries++
+	return true
+}
+
+func (p *Policy) resetWindow(now time.Time) {
+	if now.Sub(p.windowStart) >= budgetWindow {
+		p.windowStart, p.requests, p.retries = now, 0, 0
+	}
+}
+
+// Backoff returns how long to wait before the given retry (1 for the first retry): an exponential
+// backoff capped at the maximum, half of it randomised so concurrent retries don't line up
+func (p *Policy) Backoff(retry int) time.Duration {
+	backoff := p.backoffMax
+	if shift := retry - 1; shift < 32 && p.backoffBase<<shift < p.backoffMax {
+		backoff = p.backoffBase << shift
+	}
+	half := backoff / 2
+	return half + rand.N(half+1)
+}
+
+func isConnectFailure(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func isReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Validate checks the retry policy settings of a route
+func Validate(cfg *models.RetryPolicy) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.Attempts < 0 || cfg.Attempts > maxAttempts {
+		return fmt.Errorf("invalid retry attempts %d: must be between 0 and %d, 0 uses the default of %d", cfg.Attempts, maxAttempts, defaultAttempts)
+	}
+	for _, condition := range cfg.RetryOn {
+		if code, err := strconv.Atoi(condition); err == nil {
+			if code < 100 || code > 599 {
+				return fmt.Errorf("invalid retry_on status code %d", code)
+			}
+			continue
+		}
+		switch condition {
+		case RetryOnConnectFailure, RetryOnReset, RetryOnTimeout, RetryOn5xx, RetryOnGatewayError:
+		default:
+			return fmt.Errorf("invalid retry_on condition %q: must be a status code or one of %s", condition,
+				strings.Join([]string{RetryOnConnectFailure, RetryOnReset, RetryOnTimeout, RetryOn5xx, RetryOnGatewayError}, ", "))
+		}
+	}
+	if cfg.PerTryTimeout < 0 || cfg.BackoffBase < 0 || cfg.BackoffMax < 0 {
+		return fmt.Errorf("retry timeouts and backoff must not be negative")
+	}
+	if cfg.BackoffBase > 0 && cfg.BackoffMax > 0 && cfg.BackoffBase > cfg.BackoffMax {
+		return fmt.Errorf("retry backoff_base must not exceed backoff_max")
+	}
+	if cfg.Budget < 0 || cfg.Budget > 100 {
+		return fmt.Errorf("invalid retry budget %d: must be a percentage between 1 and 100", cfg.Budget)
+	}
+	if cfg.MaxBodyBytes < 0 {
+		return fmt.Errorf("retry max_body_bytes must not be negative")
+	}
+	for _, method := range cfg.IdempotentMethods {
+		if method == "" || method != strings.ToUpper(method) {
+			return fmt.Errorf("invalid idempotent method %q: must be an upper case HTTP method", method)
+		}
+	}
+	return nil
+}
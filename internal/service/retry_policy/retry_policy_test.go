@@ -0,0 +1,172 @@
+package retrypolicy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+// clock is a fake time source moved forward by the tests
+type clock struct{ now time.Time }
+
+func (c *clock) advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestRetryable(t *testing.T) {
+	p := New(&models.RetryPolicy{})
+	withKey := httptest.NewRequest(http.MethodPost, "/", nil)
+	withKey.Header.Set(IdempotencyKeyHeader, "order-1")
+
+	tests := []struct {
+		policy *Policy
+		req    *http.Request
+		want   bool
+	}{
+		{p, httptest.NewRequest(http.MethodGet, "/", nil), true},
+		{p, httptest.NewRequest(http.MethodPut, "/", nil), true},
+		{p, httptest.NewRequest(http.MethodPost, "/", nil), false},
+		{p, httptest.NewRequest(http.MethodPatch, "/", nil), false},
+		{p, withKey, true},
+		{New(&models.RetryPolicy{IdempotentMethods: []string{http.MethodPost}}), httptest.NewRequest(http.MethodPost, "/", nil), true},
+	}
+	for _, test := range tests {
+		if got := test.policy.Retryable(test.req); got != test.want {
+			t.Fatalf("expected %s with headers %v to be retryable %v", test.req.Method, test.req.Header, test.want)
+		}
+	}
+}
+
+func TestRetryableStatus(t *testing.T) {
+	tests := []struct {
+		retryOn []string
+		status  int
+		want    bool
+	}{
+		{nil, http.StatusServiceUnavailable, false},
+		{[]string{"503"}, http.StatusServiceUnavailable, true},
+		{[]string{"503"}, http.StatusBadGateway, false},
+		{[]string{"429"}, http.StatusTooManyRequests, true},
+		{[]string{RetryOn5xx}, http.StatusInternalServerError, true},
+		{[]string{RetryOn5xx}, http.StatusTooManyRequests, false},
+		{[]string{RetryOnGatewayError}, http.StatusGatewayTimeout, true},
+		{[]string{RetryOnGatewayError}, http.StatusInternalServerError, false},
+		{[]string{RetryOnGatewayError, "500"}, http.StatusInternalServerError, true},
+	}
+	for _, test := range tests {
+		if got := New(&models.RetryPolicy{RetryOn: test.retryOn}).RetryableStatus(test.status); got != test.want {
+			t.Fatalf("expected status %d to be retryable %v with %v", test.status, test.want, test.retryOn)
+		}
+	}
+}
+
+func TestRetryableError(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	resetErr := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	timeoutErr := fmt.Errorf("round trip: %w", ErrPerTryTimeout)
+
+	tests := []struct {
+		retryOn []string
+		err     error
+		want    bool
+	}{
+		{nil, dialErr, true},
+		{nil, resetErr, true},
+		{nil, io.ErrUnexpectedEOF, true},
+		{nil, timeoutErr, false},
+		{[]string{RetryOnTimeout}, timeoutErr, true},
+		{[]string{RetryOnTimeout}, dialErr, false},
+		{[]string{RetryOnConnectFailure}, resetErr, false},
+		{[]string{RetryOnReset}, dialErr, false},
+		{nil, errors.New("tls: bad certificate"), false},
+	}
+	for _, test := range tests {
+		if got := New(&models.RetryPolicy{RetryOn: test.retryOn}).RetryableError(test.err); got != test.want {
+			t.Fatalf("expected %v to be retryable %v with %v", test.err, test.want, test.retryOn)
+		}
+	}
+}
+
+func TestBudget(t *testing.T) {
+	c := &clock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	p := New(&models.RetryPolicy{Budget: 10})
+	p.now = func() time.Time { return c.now }
+
+	// low traffic routes may still retry a few times
+	p.RecordRequest()
+	for i := 0; i < minRetriesPerWindow; i++ {
+		if !p.AllowRetry() {
+			t.Fatalf("expected retry %d to be within the minimum budget", i+1)
+		}
+	}
+	if p.AllowRetry() {
+		t.Fatal("expected the minimum budget to be used up")
+	}
+
+	// the budget grows with the requests of the window
+	for i := 0; i < 99; i++ {
+		p.RecordRequest()
+	}
+	for i := minRetriesPerWindow; i < 10; i++ {
+		if !p.AllowRetry() {
+			t.Fatalf("expected retry %d to be within 10%% of 100 requests", i+1)
+		}
+	}
+	if p.AllowRetry() {
+		t.Fatal("expected the budget to be used up")
+	}
+
+	c.advance(budgetWindow)
+	if !p.AllowRetry() {
+		t.Fatal("expected the budget to start over with the next window")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := New(&models.RetryPolicy{BackoffBase: 100 * time.Millisecond, BackoffMax: time.Second})
+	tests := []struct {
+		retry int
+		max   time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{64, time.Second},
+	}
+	for _, test := range tests {
+		for i := 0; i < 20; i++ {
+			if backoff := p.Backoff(test.retry); backoff < test.max/2 || backoff > test.max {
+				t.Fatalf("expected the backoff of retry %d between %v and %v, got %v", test.retry, test.max/2, test.max, backoff)
+			}
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []*models.RetryPolicy{
+		{Attempts: -1},
+		{Attempts: maxAttempts + 1},
+		{RetryOn: []string{"600"}},
+		{RetryOn: []string{"always"}},
+		{BackoffBase: time.Second, BackoffMax: time.Millisecond},
+		{Budget: 101},
+		{IdempotentMethods: []string{"post"}},
+	}
+	for _, cfg := range invalid {
+		if err := Validate(cfg); err == nil {
+			t.Fatalf("expected %+v to be invalid", cfg)
+		}
+	}
+	for _, cfg := range []*models.RetryPolicy{{}, {Attempts: 3, RetryOn: []string{RetryOnGatewayError, "429"}}} {
+		if err := Validate(cfg); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package rewrite
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+var templateParamPattern = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// Rewriter rewrites the path and host of the requests of a route before they are proxied
+type Rewriter struct {
+	prefix      string
+	regex       *regexp.Regexp
+	replacement string
+	path        string
+	host        string
+}
+
+// New compiles the rewrite of a route
+func New(cfg *models.Rewrite) (*Rewriter, error) {
+	r := &Rewriter{
+		prefix:      cfg.Prefix,
+		replacement: cfg.Replacement,
+		path:        cfg.Path,
+		host:        cfg.Host,
+	}
+	if cfg.Regex != "" {
+		regex, err := regexp.Compile(cfg.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rewrite regex: %w", err)
+		}
+		r.regex = regex
+	}
+	return r, nil
+}
+
+// Path returns the upstream path of a request path, prefix being the part of it matched by the route's
+// pattern. Prefix and path templates may reference the path parameters as {name}, regex replacements
+// their capture groups as $1 or ${name}.
+func (r *Rewriter) Path(path, prefix string, params utils.Params) string {
+	switch {
+	case r.prefix != "":
+		rest := strings.TrimPrefix(path, prefix)
+		rewritten := params.Expand(r.prefix)
+		if rest == "" {
+			return rewritten
+		}
+		return strings.TrimSuffix(rewritten, "/") + "/" + strings.TrimPrefix(rest, "/")
+	case r.regex != nil:
+		return r.regex.ReplaceAllString(path, r.replacement)
+	case r.path != "":
+		return params.Expand(r.path)
+	default:
+		return path
+	}
+}
+
+// Host returns the Host header to send upstream, empty to keep the client's
+func (r *Rewriter) Host(params utils.Params) string {
+	return params.Expand(r.host)
+}
+
+// RewritesPath reports whether the rewriter changes the path
+func (r *Rewriter) RewritesPath() bool {
+	return r.prefix != "" || r.regex != nil || r.path != ""
+}
+
+// Validate checks the rewrite of a route against its path pattern
+func Validate(cfg *models.Rewrite, pathPrefix string, stripPrefix bool) error {
+	if cfg == nil {
+		return nil
+	}
+
+	modes := 0
+	for _, set := range []bool{cfg.Prefix != "", cfg.Regex != "", cfg.Path != ""} {
+		if set {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return fmt.Errorf("rewrite must set only one of prefix, regex or path")
+	}
+	if modes > 0 && stripPrefix {
+		return fmt.Errorf("strip_prefix can't be combined with a path rewrite, use rewrite prefix / instead")
+	}
+	if cfg.Replacement != "" && cfg.Regex == "" {
+		return fmt.Errorf("rewrite replacement requires regex")
+	}
+	if cfg.Regex != "" {
+		if _, err := regexp.Compile(cfg.Regex); err != nil {
+			return fmt.Errorf("invalid rewrite regex: %w", err)
+		}
+	}
+	for _, template := range []string{cfg.Prefix, cfg.Path} {
+		if template != "" && !strings.HasPrefix(template, "/") {
+			return fmt.Errorf("invalid rewrite %q: must start with /", template)
+		}
+	}
+	if strings.ContainsAny(cfg.Host, "/ ") {
+		return fmt.Errorf("invalid rewrite host %q", cfg.Host)
+	}
+
+	// every {name} of the templates must be a path parameter of the route
+	params := utils.PatternParams(pathPrefix)
+	for _, template := range []string{cfg.Prefix, cfg.Path, cfg.Host} {
+		for _, ref := range templateParamPattern.FindAllStringSubmatch(template, -1) {
+			if !slices.Contains(params, ref[1]) {
+				return fmt.Errorf("rewrite references {%s} which is not a path parameter of %q", ref[1], pathPrefix)
+			}
+		}
+	}
+	return nil
+}
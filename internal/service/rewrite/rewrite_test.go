@@ -0,0 +1,80 @@
+package rewrite
+
+import (
+	"testing"
+
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+func TestPath(t *testing.T) {
+	params := utils.Params{{Key: "id", Value: "42"}}
+	tests := []struct {
+		name    string
+		rewrite models.Rewrite
+		path    string
+		prefix  string
+		want    string
+	}{
+		{"prefix keeps the rest", models.Rewrite{Prefix: "/internal/users/{id}"}, "/api/users/42/orders", "/api/users/42", "/internal/users/42/orders"},
+		{"prefix of the whole path", models.Rewrite{Prefix: "/internal/users/{id}"}, "/api/users/42", "/api/users/42", "/internal/users/42"},
+		{"root prefix", models.Rewrite{Prefix: "/"}, "/api/users/42/orders", "/api/users/42", "/orders"},
+		{"regex capture groups", models.Rewrite{Regex: `^/v1/(\w+)/(.*)$`, Replacement: "/v2/$2/$1"}, "/v1/users/42", "/v1", "/v2/42/users"},
+		{"regex named groups", models.Rewrite{Regex: `^/v1/(?P<rest>.*)$`, Replacement: "/v2/${rest}"}, "/v1/users/42", "/v1", "/v2/users/42"},
+		{"regex without match", models.Rewrite{Regex: `^/v3/(.*)$`, Replacement: "/v2/$1"}, "/v1/users", "/v1", "/v1/users"},
+		{"path template", models.Rewrite{Path: "/profiles/{id}"}, "/api/users/42/orders", "/api/users/42", "/profiles/42"},
+		{"host only", models.Rewrite{Host: "users.internal"}, "/api/users/42", "/api/users/42", "/api/users/42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rewriter, err := New(&tt.rewrite)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := rewriter.Path(tt.path, tt.prefix, params); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestHost(t *testing.T) {
+	rewriter, err := New(&models.Rewrite{Host: "{tenant}.internal"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := rewriter.Host(utils.Params{{Key: "tenant", Value: "acme"}}); got != "acme.internal" {
+		t.Fatalf("expected acme.internal, got %q", got)
+	}
+	if rewriter.RewritesPath() {
+		t.Fatal("expected a host only rewrite to keep the path")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		rewrite     *models.Rewrite
+		stripPrefix bool
+		wantErr     bool
+	}{
+		{"nil", nil, true, false},
+		{"prefix with params", &models.Rewrite{Prefix: "/internal/{id}", Host: "{tenant}.internal"}, false, false},
+		{"two modes", &models.Rewrite{Prefix: "/a", Path: "/b"}, false, true},
+		{"with strip prefix", &models.Rewrite{Path: "/b"}, true, true},
+		{"host with strip prefix", &models.Rewrite{Host: "users.internal"}, true, false},
+		{"replacement without regex", &models.Rewrite{Replacement: "/b"}, false, true},
+		{"invalid regex", &models.Rewrite{Regex: "("}, false, true},
+		{"relative template", &models.Rewrite{Path: "b"}, false, true},
+		{"invalid host", &models.Rewrite{Host: "a/b"}, false, true},
+		{"unknown param", &models.Rewrite{Path: "/users/{user}"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.rewrite, "/tenants/{tenant}/users/{id}", tt.stripPrefix)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
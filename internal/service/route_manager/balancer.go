@@ -0,0 +1,186 @@
+package routemanager
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gofreego/opengate/internal/models"
+	circuitbreaker "github.com/gofreego/opengate/internal/service/circuit_breaker"
+	loadbalancer "github.com/gofreego/opengate/internal/service/load_balancer"
+)
+
+// balancerEntry is a pooled balancer along with the upstream settings it was built for
+type balancerEntry struct {
+	balancer  loadbalancer.Balancer
+	signature string
+	versions  map[string]*balancerEntry // balancers of the versions of a split route, by version name
+}
+
+// balancerPool keeps one balancer per route so policy state (round robin position,
+// in-flight request counts) survives route refreshes that don't change the upstreams
+type balancerPool struct {
+	mu      sync.RWMutex
+	entries map[string]*balancerEntry // route name -> balancer
+}
+
+func newBalancerPool() *balancerPool {
+	return &balancerPool{
+		entries: make(map[string]*balancerEntry),
+	}
+}
+
+// get returns the pooled balancer for the route, building a new one if the route's
+// targets, load balancer or circuit breaker settings changed since it was created
+func (p *balancerPool) get(route *models.ServiceRoute) (loadbalancer.Balancer, error) {
+	signature := balancerSignature(route)
+
+	p.mu.RLock()
+	entry := p.entries[route.Name]
+	p.mu.RUnlock()
+	if entry != nil && entry.signature == signature {
+		return entry.balancer, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// another request may have rebuilt it while we were waiting for the lock
+	entry = p.entries[route.Name]
+	if entry != nil && entry.signature == signature {
+		return entry.balancer, nil
+	}
+	if route.Split != nil {
+		entry, err := newSplitEntry(route, entry)
+		if err != nil {
+			return nil, err
+		}
+		entry.signature = signature
+		p.entries[route.Name] = entry
+		return entry.balancer, nil
+	}
+	balancer, err := newTargetsBalancer(route, route.GetTargets())
+	if err != nil {
+		return nil, err
+	}
+	p.entries[route.Name] = &balancerEntry{
+		balancer:  balancer,
+		signature: signature,
+	}
+	return balancer, nil
+}
+
+// newSplitEntry builds the balancer of a split route. The balancers of versions whose targets didn't change
+// are taken over from the previous entry, so shifting weights keeps their health and circuit breaker state.
+func newSplitEntry(route *models.ServiceRoute, previous *balancerEntry) (*balancerEntry, error) {
+	entry := &balancerEntry{versions: make(map[string]*balancerEntry, len(route.Split.Versions))}
+	versions := make([]loadbalancer.Version, 0, len(route.Split.Versions))
+	for _, version := range route.Split.Versions {
+		signature := upstreamSettingsSignature(route, version.Targets)
+		var versionEntry *balancerEntry
+		if previous != nil {
+			versionEntry = previous.versions[version.Name]
+		}
+		if versionEntry == nil || versionEntry.signature != signature {
+			balancer, err := newTargetsBalancer(route, version.Targets)
+			if err != nil {
+				return nil, fmt.Errorf("version %s: %w", version.Name, err)
+			}
+			versionEntry = &balancerEntry{balancer: balancer, signature: signature}
+		}
+		entry.versions[version.Name] = versionEntry
+		versions = append(versions, loadbalancer.Version{
+			Name:     version.Name,
+			Weight:   version.Weight,
+			Balancer: versionEntry.balancer,
+		})
+	}
+	entry.balancer = loadbalancer.NewSplit(versions, route.Split.StickyOn, route.Split.StickyKey)
+	return entry, nil
+}
+
+// newTargetsBalancer builds a balancer of the targets with the load balancer and circuit breaker settings of the route
+func newTargetsBalancer(route *models.ServiceRoute, targets []models.Target) (loadbalancer.Balancer, error) {
+	balancer, err := loadbalancer.New(route.LoadBalancer, targets)
+	if err != nil {
+		return nil, err
+	}
+	if route.CircuitBreaker != nil {
+		for _, target := range balancer.Targets() {
+			target.Breaker = circuitbreaker.New(route.CircuitBreaker)
+		}
+	}
+	return balancer, nil
+}
+
+// retain drops balancers of routes that are no longer present
+func (p *balancerPool) retain(routes []*models.ServiceRoute) {
+	names := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		names[route.Name] = struct{}{}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for name := range p.entries {
+		if _, ok := names[name]; !ok {
+			delete(p.entries, name)
+		}
+	}
+}
+
+// targetsSignature identifies the upstream targets of a route, including the targets of its split versions
+func targetsSignature(route *models.ServiceRoute) string {
+	var sb strings.Builder
+	writeTargets(&sb, route.GetTargets())
+	if route.Split != nil {
+		for _, version := range route.Split.Versions {
+			sb.WriteString(version.Name)
+			sb.WriteByte(':')
+			writeTargets(&sb, version.Targets)
+		}
+	}
+	return sb.String()
+}
+
+func writeTargets(sb *strings.Builder, targets []models.Target) {
+	for _, target := range targets {
+		sb.WriteString(target.URL)
+		sb.WriteByte('|')
+		sb.WriteString(strconv.Itoa(target.Weight))
+		sb.WriteByte(',')
+	}
+}
+
+// balancerSignature identifies the upstream targets, load balancer, health check toggle, circuit breaker
+// and traffic split settings of a route
+func balancerSignature(route *models.ServiceRoute) string {
+	signature := upstreamSettingsSignature(route, route.GetTargets())
+	if split := route.Split; split != nil {
+		signature += "|split|" + split.StickyOn + "|" + split.StickyKey
+		for _, version := range split.Versions {
+			signature += fmt.Sprintf("|%s=%d:%s", version.Name, version.Weight, upstreamSettingsSignature(route, version.Targets))
+		}
+	}
+	return signature
+}
+
+// upstreamSettingsSignature identifies the targets along with the load balancer, health check toggle and
+// circuit breaker settings of the route they are balanced with
+func upstreamSettingsSignature(route *models.ServiceRoute, targets []models.Target) string {
+	var sb strings.Builder
+	writeTargets(&sb, targets)
+	signature := sb.String()
+	if lb := route.LoadBalancer; lb != nil {
+		signature += lb.Policy + "|" + lb.HashOn + "|" + lb.HashKey
+	}
+	// rebuild when health checks are turned on or off so no target stays out of rotation
+	if route.HealthCheck != nil {
+		signature += "|health_check"
+	}
+	if cb := route.CircuitBreaker; cb != nil {
+		signature += fmt.Sprintf("|%d|%d|%d|%s|%s|%d", cb.ConsecutiveFailures, cb.ErrorRateThreshold,
+			cb.MinRequests, cb.Window, cb.EjectionDuration, cb.HalfOpenRequests)
+	}
+	return signature
+}
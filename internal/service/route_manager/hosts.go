@@ -0,0 +1,138 @@
+package routemanager
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+// routeTrie holds the routes of a host bucket by path prefix, several routes may share a prefix
+type routeTrie = utils.Trie[[]*routeCandidate]
+
+// wildcardBucket holds the routes of a wildcard host such as *.example.com
+type wildcardBucket struct {
+	suffix string // .example.com
+	trie   *routeTrie
+}
+
+// hostRouter splits routes into host buckets, each matched on the longest path prefix.
+// Routes without hosts go to the default bucket, which serves any host.
+type hostRouter struct {
+	exact     map[string]*routeTrie
+	wildcards []wildcardBucket // most specific first
+	fallback  *routeTrie
+}
+
+func newHostRouter(routes []*models.ServiceRoute) *hostRouter {
+	// candidates by bucket and path prefix, the default bucket being ""
+	buckets := make(map[string]map[string][]*routeCandidate)
+	add := func(bucket string, candidate *routeCandidate) {
+		if buckets[bucket] == nil {
+			buckets[bucket] = make(map[string][]*routeCandidate)
+		}
+		prefix := patternKey(candidate.route.PathPrefix)
+		buckets[bucket][prefix] = append(buckets[bucket][prefix], candidate)
+	}
+	for _, route := range routes {
+		candidate := newRouteCandidate(route)
+		if len(route.Hosts) == 0 {
+			add("", candidate)
+			continue
+		}
+		for _, host := range route.Hosts {
+			add(strings.ToLower(host), candidate)
+		}
+	}
+
+	r := &hostRouter{
+		exact:    make(map[string]*routeTrie),
+		fallback: utils.NewTrie[[]*routeCandidate](),
+	}
+	for bucket, prefixes := range buckets {
+		trie := r.fallback
+		if bucket != "" {
+			trie = utils.NewTrie[[]*routeCandidate]()
+			if suffix, ok := strings.CutPrefix(bucket, "*"); ok {
+				r.wildcards = append(r.wildcards, wildcardBucket{suffix: suffix, trie: trie})
+			} else {
+				r.exact[bucket] = trie
+			}
+		}
+		for prefix, candidates := range prefixes {
+			sortCandidates(candidates)
+			trie.Insert(prefix, candidates)
+		}
+	}
+	sort.Slice(r.wildcards, func(i, j int) bool { return len(r.wildcards[i].suffix) > len(r.wildcards[j].suffix) })
+	return r
+}
+
+// match returns the route of the most specific host bucket matching the request: the exact host first,
+// then the wildcard hosts from the most specific one and last the routes without hosts
+func (r *hostRouter) match(req *http.Request) *Match {
+	host := normalizeHost(req.Host)
+	if trie := r.exact[host]; trie != nil {
+		if match := matchTrie(trie, req); match != nil {
+			return match
+		}
+	}
+	for _, bucket := range r.wildcards {
+		if strings.HasSuffix(host, bucket.suffix) {
+			if match := matchTrie(bucket.trie, req); match != nil {
+				return match
+			}
+		}
+	}
+	return matchTrie(r.fallback, req)
+}
+
+// matchTrie returns the first route whose match conditions hold, trying the most specific pattern first
+func matchTrie(trie *routeTrie, req *http.Request) *Match {
+	for _, trieMatch := range trie.FindMatches(req.URL.Path) {
+		for _, candidate := range trieMatch.Route {
+			if candidate.matches(req) {
+				return &Match{
+					Route:  candidate.route,
+					Params: candidate.params(trieMatch.Params),
+					Prefix: trieMatch.Prefix,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// patternKey returns the path pattern as the trie sees it, so routes of equivalent patterns share a node
+func patternKey(pattern string) string {
+	parts := strings.FieldsFunc(pattern, func(r rune) bool { return r == '/' })
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			parts[i] = "{_}"
+		}
+	}
+	return "/" + strings.Join(parts, "/")
+}
+
+// normalizeHost strips the port and trailing dot of a request host and lower cases it
+func normalizeHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// ValidateHosts checks the hosts of a route: host names without port, optionally starting with a *. wildcard label
+func ValidateHosts(hosts []string) error {
+	for _, host := range hosts {
+		name := strings.TrimPrefix(host, "*.")
+		if name == "" || strings.ContainsAny(name, "*:/ ") || strings.HasPrefix(name, ".") || strings.HasSuffix(name, ".") {
+			return fmt.Errorf("invalid host %q: must be a host name such as api.example.com or *.example.com", host)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package routemanager
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+// headerMatcher is a compiled header condition of a route
+type headerMatcher struct {
+	name    string
+	exact   string
+	regex   *regexp.Regexp
+	present bool
+}
+
+// routeCandidate is a route along with its compiled match conditions
+type routeCandidate struct {
+	route       *models.ServiceRoute
+	methods     []string
+	headers     []headerMatcher
+	queryParams []models.QueryParamMatch
+	invalid     bool     // a header regex failed to compile
+	paramNames  []string // names of the path parameters of the route's pattern
+}
+
+// newRouteCandidate compiles the match conditions of the route. Invalid regular expressions are rejected
+// when routes are created, a route whose expression still fails to compile never matches.
+func newRouteCandidate(route *models.ServiceRoute) *routeCandidate {
+	c := &routeCandidate{route: route, paramNames: utils.PatternParams(route.PathPrefix)}
+	if route.Match == nil {
+		return c
+	}
+	c.methods = route.Match.Methods
+	c.queryParams = route.Match.QueryParams
+	for _, header := range route.Match.Headers {
+		matcher := headerMatcher{name: header.Name, exact: header.Exact, present: header.Present}
+		if header.Regex != "" {
+			regex, err := compileMatchRegex(header.Regex)
+			if err != nil {
+				c.invalid = true
+			}
+			matcher.regex = regex
+		}
+		c.headers = append(c.headers, matcher)
+	}
+	return c
+}
+
+// matches reports whether the request meets all match conditions of the route
+func (c *routeCandidate) matches(req *http.Request) bool {
+	if c.invalid {
+		return false
+	}
+	if len(c.methods) > 0 && !slices.Contains(c.methods, req.Method) {
+		return false
+	}
+	for _, header := range c.headers {
+		values, ok := req.Header[http.CanonicalHeaderKey(header.name)]
+		if !ok {
+			return false
+		}
+		value := strings.Join(values, ",")
+		if header.exact != "" && value != header.exact {
+			return false
+		}
+		if header.regex != nil && !header.regex.MatchString(value) {
+			return false
+		}
+	}
+	if len(c.queryParams) > 0 {
+		query := req.URL.Query()
+		for _, param := range c.queryParams {
+			if !query.Has(param.Name) {
+				return false
+			}
+			if param.Exact != "" && query.Get(param.Name) != param.Exact {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// params names the path parameters captured by the trie after the route's pattern, routes sharing
+// a trie node may name them differently
+func (c *routeCandidate) params(captured utils.Params) utils.Params {
+	if len(captured) == 0 {
+		return nil
+	}
+	params := make(utils.Params, len(captured))
+	for i, param := range captured {
+		params[i] = utils.Param{Key: c.paramNames[i], Value: param.Value}
+	}
+	return params
+}
+
+// specificity is the number of match conditions of the route
+func (c *routeCandidate) specificity() int {
+	specificity := len(c.headers) + len(c.queryParams)
+	if len(c.methods) > 0 {
+		specificity++
+	}
+	return specificity
+}
+
+// sortCandidates orders routes sharing a prefix deterministically: higher priority first,
+// then routes with more match conditions, then by name
+func sortCandidates(candidates []*routeCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.route.Priority != b.route.Priority {
+			return a.route.Priority > b.route.Priority
+		}
+		if a.specificity() != b.specificity() {
+			return a.specificity() > b.specificity()
+		}
+		return a.route.Name < b.route.Name
+	})
+}
+
+// compileMatchRegex compiles a header regex anchored so it has to match the whole value
+func compileMatchRegex(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + expr + ")$")
+}
+
+// ValidateMatch checks the match conditions of a route
+func ValidateMatch(match *models.RouteMatch) error {
+	if match == nil {
+		return nil
+	}
+	for _, method := range match.Methods {
+		if method == "" || method != strings.ToUpper(method) {
+			return fmt.Errorf("invalid match method %q: must be an upper case HTTP method", method)
+		}
+	}
+	for _, header := range match.Headers {
+		if header.Name == "" {
+			return fmt.Errorf("name is required for every header match")
+		}
+		if header.Exact != "" && header.Regex != "" {
+			return fmt.Errorf("header match %q must set either exact or regex, not both", header.Name)
+		}
+		if header.Exact == "" && header.Regex == "" && !header.Present {
+			return fmt.Errorf("header match %q requires exact, regex or present", header.Name)
+		}
+		if header.Regex != "" {
+			if _, err := compileMatchRegex(header.Regex); err != nil {
+				return fmt.Errorf("invalid regex of header match %q: %w", header.Name, err)
+			}
+		}
+	}
+	for _, param := range match.QueryParams {
+		if param.Name == "" {
+			return fmt.Errorf("name is required for every query param match")
+		}
+		if param.Exact == "" && !param.Present {
+			return fmt.Errorf("query param match %q requires exact or present", param.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package routemanager
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/internal/service/mirror"
+)
+
+// mirrorEntry is a pooled mirror along with the settings it was built for
+type mirrorEntry struct {
+	mirror    *mirror.Mirror
+	err       error
+	signature string
+}
+
+// mirrorPool keeps one mirror per route so its connections and stats survive route refreshes
+type mirrorPool struct {
+	mu      sync.RWMutex
+	entries map[string]*mirrorEntry // route name -> mirror
+}
+
+func newMirrorPool() *mirrorPool {
+	return &mirrorPool{
+		entries: make(map[string]*mirrorEntry),
+	}
+}
+
+// get returns the pooled mirror for the route, nil if the route has none
+func (p *mirrorPool) get(route *models.ServiceRoute) (*mirror.Mirror, error) {
+	if route.Mirror == nil {
+		return nil, nil
+	}
+	signature := fmt.Sprintf("%+v", *route.Mirror)
+
+	p.mu.RLock()
+	entry := p.entries[route.Name]
+	p.mu.RUnlock()
+	if entry != nil && entry.signature == signature {
+		return entry.mirror, entry.err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry = p.entries[route.Name]
+	if entry == nil || entry.signature != signature {
+		if entry != nil && entry.mirror != nil {
+			entry.mirror.Close()
+		}
+		m, err := mirror.New(route.Mirror)
+		entry = &mirrorEntry{
+			mirror:    m,
+			err:       err,
+			signature: signature,
+		}
+		p.entries[route.Name] = entry
+	}
+	return entry.mirror, entry.err
+}
+
+// retain drops mirrors of routes that are no longer present or no longer mirror
+func (p *mirrorPool) retain(routes []*models.ServiceRoute) {
+	names := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		if route.Mirror != nil {
+			names[route.Name] = struct{}{}
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for name, entry := range p.entries {
+		if _, ok := names[name]; !ok {
+			if entry.mirror != nil {
+				entry.mirror.Close()
+			}
+			delete(p.entries, name)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package routemanager
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+var paramNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Match is the route selected for a request along with what its path pattern captured
+type Match struct {
+	Route  *models.ServiceRoute
+	Params utils.Params // path parameters captured by {name} segments
+	Prefix string       // the part of the request path matched by the route's pattern
+}
+
+// ValidatePathPrefix checks the path pattern of a route: static segments, {name} parameters,
+// * single segment wildcards optionally following a literal prefix and a final ** wildcard
+func ValidatePathPrefix(pattern string) error {
+	parts := strings.FieldsFunc(pattern, func(r rune) bool { return r == '/' })
+	seen := make(map[string]bool)
+	for i, part := range parts {
+		switch {
+		case part == "**":
+			if i != len(parts)-1 {
+				return fmt.Errorf("invalid path_prefix %q: ** must be the last segment", pattern)
+			}
+		case strings.HasPrefix(part, "{") || strings.HasSuffix(part, "}"):
+			name := strings.TrimSuffix(strings.TrimPrefix(part, "{"), "}")
+			if !strings.HasPrefix(part, "{") || !strings.HasSuffix(part, "}") || !paramNamePattern.MatchString(name) {
+				return fmt.Errorf("invalid path parameter %q in path_prefix %q: must be {name} with a letter, digit or _ name", part, pattern)
+			}
+			if seen[name] {
+				return fmt.Errorf("duplicate path parameter %q in path_prefix %q", name, pattern)
+			}
+			seen[name] = true
+		case strings.Contains(part, "*"):
+			if strings.Index(part, "*") != len(part)-1 {
+				return fmt.Errorf("invalid wildcard %q in path_prefix %q: * may only end a segment", part, pattern)
+			}
+		}
+	}
+	return nil
+}
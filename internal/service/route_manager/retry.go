@@ -0,0 +1,70 @@
+package routemanager
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gofreego/opengate/internal/models"
+	retrypolicy "github.com/gofreego/opengate/internal/service/retry_policy"
+)
+
+// retryEntry is a pooled retry policy along with the settings it was built for
+type retryEntry struct {
+	policy    *retrypolicy.Policy
+	signature string
+}
+
+// retryPool keeps one retry policy per route so the retry budget is shared by all requests of the route
+type retryPool struct {
+	mu      sync.RWMutex
+	entries map[string]*retryEntry // route name -> retry policy
+}
+
+func newRetryPool() *retryPool {
+	return &retryPool{
+		entries: make(map[string]*retryEntry),
+	}
+}
+
+// get returns the pooled retry policy for the route, nil if the route has none
+func (p *retryPool) get(route *models.ServiceRoute) *retrypolicy.Policy {
+	if route.RetryPolicy == nil {
+		return nil
+	}
+	signature := fmt.Sprintf("%+v", *route.RetryPolicy)
+
+	p.mu.RLock()
+	entry := p.entries[route.Name]
+	p.mu.RUnlock()
+	if entry != nil && entry.signature == signature {
+		return entry.policy
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry = p.entries[route.Name]
+	if entry == nil || entry.signature != signature {
+		entry = &retryEntry{
+			policy:    retrypolicy.New(route.RetryPolicy),
+			signature: signature,
+		}
+		p.entries[route.Name] = entry
+	}
+	return entry.policy
+}
+
+// retain drops retry policies of routes that are no longer present
+func (p *retryPool) retain(routes []*models.ServiceRoute) {
+	names := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		names[route.Name] = struct{}{}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for name := range p.entries {
+		if _, ok := names[name]; !ok {
+			delete(p.entries, name)
+		}
+	}
+}
@@ -2,109 +2,147 @@ package routemanager
 
 import (
 	"net/http"
-	"net/http/httputil"
-	"net/url"
+	"slices"
+	"sync"
+	"sync/atomic"
 
 	"github.com/gofreego/opengate/internal/models"
-	"github.com/gofreego/opengate/pkg/utils"
+	loadbalancer "github.com/gofreego/opengate/internal/service/load_balancer"
+	"github.com/gofreego/opengate/internal/service/mirror"
+	retrypolicy "github.com/gofreego/opengate/internal/service/retry_policy"
+	"github.com/gofreego/opengate/internal/service/rewrite"
 )
 
 type Manager interface {
 	GetRoutes() []*models.ServiceRoute
 	GetRouteByName(name string) *models.ServiceRoute
 	GetRouteByRequest(req *http.Request) *models.ServiceRoute
+	MatchRequest(req *http.Request) *Match
 	AddRoute(route *models.ServiceRoute)
 	ReplaceRoutes(routes []*models.ServiceRoute)
-	GetReverseProxy(targetURL string) *httputil.ReverseProxy
+	GetTransport(route *models.ServiceRoute) http.RoundTripper
+	GetBalancer(route *models.ServiceRoute) (loadbalancer.Balancer, error)
+	GetRetryPolicy(route *models.ServiceRoute) *retrypolicy.Policy
+	GetRewriter(route *models.ServiceRoute) (*rewrite.Rewriter, error)
+	GetMirror(route *models.ServiceRoute) (*mirror.Mirror, error)
+}
+
+type Config struct {
+	Transport TransportConfig `yaml:"Transport"`
 }
 
 type manager struct {
-	routes      []*models.ServiceRoute            // Original slice for compatibility
-	trie        *utils.Trie[*models.ServiceRoute] // Trie for efficient path matching
-	nameIndex   map[string]*models.ServiceRoute   // Hash map for name-based lookups
-	cachedProxy map[string]*httputil.ReverseProxy // Cache of reverse proxies by target URL
+	current    atomic.Pointer[snapshot] // Routing table read by requests
+	writeMu    sync.Mutex               // Serializes routing table updates
+	transports *transportPool           // Pooled upstream transports by route name
+	balancers  *balancerPool            // Pooled load balancers by route name
+	retries    *retryPool               // Pooled retry policies by route name
+	mirrors    *mirrorPool              // Pooled traffic mirrors by route name
 }
 
-func New() Manager {
+func New(cfg *Config) Manager {
 	m := &manager{
-		routes:    make([]*models.ServiceRoute, 0),
-		trie:      utils.NewTrie[*models.ServiceRoute](),
-		nameIndex: make(map[string]*models.ServiceRoute),
+		transports: newTransportPool(&cfg.Transport),
+		balancers:  newBalancerPool(),
+		retries:    newRetryPool(),
+		mirrors:    newMirrorPool(),
 	}
+	m.current.Store(emptySnapshot)
 	return m
 }
 
 func (m *manager) GetRoutes() []*models.ServiceRoute {
 	// Return a copy to prevent external modification
-	result := make([]*models.ServiceRoute, len(m.routes))
-	copy(result, m.routes)
-	return result
+	return slices.Clone(m.current.Load().routes)
 }
 
 // Optimized O(1) lookup using hash map
 func (m *manager) GetRouteByName(name string) *models.ServiceRoute {
-	return m.nameIndex[name]
+	if compiled := m.current.Load().nameIndex[name]; compiled != nil {
+		return compiled.route
+	}
+	return nil
 }
 
-// Optimized O(m) lookup using trie where m is path length
+// GetRouteByRequest returns the route selected for the request, see MatchRequest
 func (m *manager) GetRouteByRequest(req *http.Request) *models.ServiceRoute {
-	requestPath := req.URL.Path
-
-	// First try trie-based lookup for exact and prefix matches
-	if route := m.trie.FindLongestMatch(requestPath); route != nil {
-		return route
+	if match := m.MatchRequest(req); match != nil {
+		return match.Route
 	}
-
 	return nil
 }
 
-func (m *manager) AddRoute(route *models.ServiceRoute) {
-	// Add to routes slice
-	m.routes = append(m.routes, route)
-
-	// Add to name index
-	m.nameIndex[route.Name] = route
+// MatchRequest selects the host bucket of the request and returns the first of its routes, most specific
+// path pattern first, whose match conditions hold. The trie lookup is O(m) where m is the path length.
+func (m *manager) MatchRequest(req *http.Request) *Match {
+	return m.current.Load().hosts.match(req)
+}
 
-	// Add to trie
-	m.trie.Insert(route.PathPrefix, route)
+// AddRoute adds the route to the routing table, replacing the route of the same name if any
+func (m *manager) AddRoute(route *models.ServiceRoute) {
+	m.writeMu.Lock()
+	defer m.writeMu.Unlock()
+
+	routes := slices.Clone(m.current.Load().routes)
+	if i := slices.IndexFunc(routes, func(r *models.ServiceRoute) bool { return r.Name == route.Name }); i >= 0 {
+		routes[i] = route
+	} else {
+		routes = append(routes, route)
+	}
+	m.current.Store(m.buildSnapshot(routes))
 }
 
+// ReplaceRoutes builds a new routing table from the routes and swaps it in
 func (m *manager) ReplaceRoutes(routes []*models.ServiceRoute) {
-	trie := buildTrie(routes)
-	nameIndex := make(map[string]*models.ServiceRoute)
-	for _, route := range routes {
-		nameIndex[route.Name] = route
-	}
+	m.writeMu.Lock()
+	defer m.writeMu.Unlock()
+
+	m.current.Store(m.buildSnapshot(slices.Clone(routes)))
 
-	m.trie = trie
-	m.routes = routes
-	m.nameIndex = nameIndex
+	// Release connections, balancers, retry policies and mirrors held for routes that no longer exist
+	m.transports.retain(routes)
+	m.balancers.retain(routes)
+	m.retries.retain(routes)
+	m.mirrors.retain(routes)
 }
 
-func (m *manager) GetReverseProxy(targetURL string) *httputil.ReverseProxy {
-	// Check cache first
-	if proxy, exists := m.cachedProxy[targetURL]; exists {
-		return proxy
-	}
+// GetTransport returns the pooled upstream transport for the route.
+// The transport is reused across requests and only rebuilt when the route's target or timeout changes.
+func (m *manager) GetTransport(route *models.ServiceRoute) http.RoundTripper {
+	return m.transports.get(route)
+}
 
-	// Create new reverse proxy
-	proxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: targetURL})
+// GetBalancer returns the load balancer for the route.
+// The balancer keeps its state across requests and is only rebuilt when the route's targets or policy change.
+func (m *manager) GetBalancer(route *models.ServiceRoute) (loadbalancer.Balancer, error) {
+	if compiled := m.current.Load().compiled(route); compiled != nil {
+		return compiled.balancer, compiled.balancerErr
+	}
+	return m.balancers.get(route)
+}
 
-	// Initialize cache map if nil
-	if m.cachedProxy == nil {
-		m.cachedProxy = make(map[string]*httputil.ReverseProxy)
+// GetRetryPolicy returns the retry policy for the route, nil if the route doesn't retry.
+// The policy and its retry budget are shared by all requests of the route.
+func (m *manager) GetRetryPolicy(route *models.ServiceRoute) *retrypolicy.Policy {
+	if compiled := m.current.Load().compiled(route); compiled != nil {
+		return compiled.retryPolicy
 	}
+	return m.retries.get(route)
+}
 
-	// Cache the proxy
-	m.cachedProxy[targetURL] = proxy
-	return proxy
+// GetRewriter returns the compiled rewrite of the route, nil if the route doesn't rewrite
+func (m *manager) GetRewriter(route *models.ServiceRoute) (*rewrite.Rewriter, error) {
+	if compiled := m.current.Load().compiled(route); compiled != nil {
+		return compiled.rewriter, compiled.rewriteErr
+	}
+	return newRewriter(route)
 }
 
-// Helper method to rebuild trie
-func buildTrie(routes []*models.ServiceRoute) *utils.Trie[*models.ServiceRoute] {
-	trie := utils.NewTrie[*models.ServiceRoute]()
-	for _, route := range routes {
-		trie.Insert(route.PathPrefix, route)
+// GetMirror returns the traffic mirror of the route, nil if the route doesn't mirror.
+// The mirror and its stats are shared by all requests of the route.
+func (m *manager) GetMirror(route *models.ServiceRoute) (*mirror.Mirror, error) {
+	if compiled := m.current.Load().compiled(route); compiled != nil {
+		return compiled.mirror, compiled.mirrorErr
 	}
-	return trie
+	return m.mirrors.get(route)
 }
@@ -0,0 +1,347 @@
+package routemanager
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+func testRoutes(version int64, count int) []*models.ServiceRoute {
+	routes := make([]*models.ServiceRoute, 0, count)
+	for i := range count {
+		routes = append(routes, &models.ServiceRoute{
+			Name:        fmt.Sprintf("route-%d", i),
+			PathPrefix:  fmt.Sprintf("/svc%d", i),
+			TargetURL:   fmt.Sprintf("http://upstream-%d.local", i),
+			RetryPolicy: &models.RetryPolicy{Attempts: 2},
+			UpdatedAt:   version,
+		})
+	}
+	return routes
+}
+
+func TestAddRouteReplacesRouteOfSameName(t *testing.T) {
+	m := New(&Config{})
+	m.AddRoute(&models.ServiceRoute{Name: "users", PathPrefix: "/users", TargetURL: "http://a.local"})
+	m.AddRoute(&models.ServiceRoute{Name: "users", PathPrefix: "/people", TargetURL: "http://b.local"})
+
+	if routes := m.GetRoutes(); len(routes) != 1 {
+		t.Fatalf("expected 1 route, got %d", len(routes))
+	}
+	if route := m.GetRouteByRequest(httptest.NewRequest("GET", "/users/1", nil)); route != nil {
+		t.Fatalf("expected the old path prefix to be gone, got route %q", route.Name)
+	}
+	if route := m.GetRouteByRequest(httptest.NewRequest("GET", "/people/1", nil)); route == nil || route.TargetURL != "http://b.local" {
+		t.Fatalf("expected the updated route, got %+v", route)
+	}
+}
+
+func TestReplaceRoutesRemovesRoutes(t *testing.T) {
+	m := New(&Config{})
+	m.ReplaceRoutes(testRoutes(1, 3))
+	m.ReplaceRoutes(testRoutes(2, 1))
+
+	if route := m.GetRouteByName("route-2"); route != nil {
+		t.Fatalf("expected route-2 to be removed")
+	}
+	if route := m.GetRouteByRequest(httptest.NewRequest("GET", "/svc2/x", nil)); route != nil {
+		t.Fatalf("expected /svc2 to be unrouted, got route %q", route.Name)
+	}
+	if route := m.GetRouteByName("route-0"); route == nil || route.UpdatedAt != 2 {
+		t.Fatalf("expected route-0 of the new table, got %+v", route)
+	}
+}
+
+func TestReplaceRoutesKeepsStateOfUnchangedRoutes(t *testing.T) {
+	m := New(&Config{})
+	m.ReplaceRoutes(testRoutes(1, 1))
+	balancer, err := m.GetBalancer(m.GetRouteByName("route-0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := m.GetRetryPolicy(m.GetRouteByName("route-0"))
+
+	m.ReplaceRoutes(testRoutes(2, 1))
+	route := m.GetRouteByName("route-0")
+	if got, _ := m.GetBalancer(route); got != balancer {
+		t.Fatalf("expected the balancer to survive a reload without upstream changes")
+	}
+	if got := m.GetRetryPolicy(route); got != policy {
+		t.Fatalf("expected the retry policy to survive a reload without policy changes")
+	}
+}
+
+// TestConcurrentReloads reloads the routing table while requests are routed, run it with -race
+func TestConcurrentReloads(t *testing.T) {
+	const routeCount = 20
+	m := New(&Config{})
+	m.ReplaceRoutes(testRoutes(0, routeCount))
+
+	var stop atomic.Bool
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; !stop.Load(); n++ {
+				path := fmt.Sprintf("/svc%d/items/%d", (i+n)%routeCount, n)
+				route := m.GetRouteByRequest(httptest.NewRequest("GET", path, nil))
+				if route == nil {
+					continue
+				}
+				if _, err := m.GetBalancer(route); err != nil {
+					t.Errorf("balancer of %s: %v", route.Name, err)
+					return
+				}
+				if m.GetRetryPolicy(route) == nil {
+					t.Errorf("missing retry policy of %s", route.Name)
+					return
+				}
+				m.GetTransport(route)
+				m.GetRouteByName(route.Name)
+				_ = len(m.GetRoutes())
+			}
+		}()
+	}
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for version := int64(1); !stop.Load(); version++ {
+			// alternate between the full table and a table with half of the routes removed
+			count := routeCount
+			if version%2 == 0 {
+				count = routeCount / 2
+			}
+			m.ReplaceRoutes(testRoutes(version, count))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for n := 0; !stop.Load(); n++ {
+			m.AddRoute(&models.ServiceRoute{
+				Name:        fmt.Sprintf("route-%d", n%routeCount),
+				PathPrefix:  fmt.Sprintf("/svc%d", n%routeCount),
+				TargetURL:   "http://added.local",
+				RetryPolicy: &models.RetryPolicy{Attempts: 3},
+			})
+		}
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+	stop.Store(true)
+	wg.Wait()
+
+	names := make(map[string]bool)
+	for _, route := range m.GetRoutes() {
+		if names[route.Name] {
+			t.Fatalf("duplicate route %s in the routing table", route.Name)
+		}
+		names[route.Name] = true
+	}
+}
+
+func TestGetRouteByRequestMatchesHosts(t *testing.T) {
+	m := New(&Config{})
+	m.ReplaceRoutes([]*models.ServiceRoute{
+		{Name: "default", PathPrefix: "/"},
+		{Name: "api", PathPrefix: "/v1", Hosts: []string{"api.example.com"}},
+		{Name: "admin", PathPrefix: "/v1", Hosts: []string{"admin.example.com"}},
+		{Name: "tenants", PathPrefix: "/v1", Hosts: []string{"*.example.com"}},
+		{Name: "eu-tenants", PathPrefix: "/v1", Hosts: []string{"*.eu.example.com"}},
+	})
+
+	tests := []struct {
+		host, path, want string
+	}{
+		{"api.example.com", "/v1/users", "api"},
+		{"ADMIN.example.com:8080", "/v1/users", "admin"},
+		{"acme.example.com", "/v1/users", "tenants"},
+		{"acme.eu.example.com", "/v1/users", "eu-tenants"},
+		{"example.com", "/v1/users", "default"},
+		{"api.example.com", "/other", "default"},
+		{"localhost:8080", "/v1/users", "default"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest("GET", tt.path, nil)
+		req.Host = tt.host
+		route := m.GetRouteByRequest(req)
+		if route == nil || route.Name != tt.want {
+			t.Errorf("%s%s: expected route %q, got %+v", tt.host, tt.path, tt.want, route)
+		}
+	}
+}
+
+func TestGetRouteByRequestEvaluatesMatchConditions(t *testing.T) {
+	m := New(&Config{})
+	m.ReplaceRoutes([]*models.ServiceRoute{
+		{Name: "orders", PathPrefix: "/orders"},
+		{Name: "orders-write", PathPrefix: "/orders", Match: &models.RouteMatch{Methods: []string{"POST", "PUT"}}},
+		{Name: "orders-v2", PathPrefix: "/orders/", Match: &models.RouteMatch{
+			Headers: []models.HeaderMatch{{Name: "X-Api-Version", Regex: "2(\\.[0-9]+)?"}},
+		}},
+		{Name: "orders-beta", PathPrefix: "/orders", Priority: 10, Match: &models.RouteMatch{
+			QueryParams: []models.QueryParamMatch{{Name: "beta", Exact: "true"}},
+		}},
+		{Name: "orders-export", PathPrefix: "/orders/export", Match: &models.RouteMatch{
+			Headers: []models.HeaderMatch{{Name: "Authorization", Present: true}},
+		}},
+	})
+
+	tests := []struct {
+		method, target string
+		header         map[string]string
+		want           string
+	}{
+		{"GET", "/orders/1", nil, "orders"},
+		{"POST", "/orders", nil, "orders-write"},
+		{"GET", "/orders/1", map[string]string{"X-Api-Version": "2.1"}, "orders-v2"},
+		{"GET", "/orders/1", map[string]string{"X-Api-Version": "12"}, "orders"},
+		{"POST", "/orders?beta=true", map[string]string{"X-Api-Version": "2"}, "orders-beta"},
+		{"GET", "/orders/export", map[string]string{"Authorization": "Bearer x"}, "orders-export"},
+		// falls back to the shorter prefix when no route of the longest one matches
+		{"POST", "/orders/export", nil, "orders-write"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.target, nil)
+		for name, value := range tt.header {
+			req.Header.Set(name, value)
+		}
+		route := m.GetRouteByRequest(req)
+		if route == nil || route.Name != tt.want {
+			t.Errorf("%s %s %v: expected route %q, got %+v", tt.method, tt.target, tt.header, tt.want, route)
+		}
+	}
+}
+
+func TestMatchRequestCapturesPathParams(t *testing.T) {
+	m := New(&Config{})
+	m.ReplaceRoutes([]*models.ServiceRoute{
+		{Name: "user-orders", PathPrefix: "/users/{userId}/orders", Match: &models.RouteMatch{Methods: []string{"POST"}}},
+		{Name: "orders-of", PathPrefix: "/users/{id}/orders"},
+	})
+
+	match := m.MatchRequest(httptest.NewRequest("GET", "/users/42/orders/7", nil))
+	if match == nil || match.Route.Name != "orders-of" {
+		t.Fatalf("expected route orders-of, got %+v", match)
+	}
+	if id, _ := match.Params.Get("id"); id != "42" || match.Prefix != "/users/42/orders" {
+		t.Fatalf("expected id 42 and prefix /users/42/orders, got %v %q", match.Params, match.Prefix)
+	}
+
+	match = m.MatchRequest(httptest.NewRequest("POST", "/users/42/orders", nil))
+	if userID, _ := match.Params.Get("userId"); match.Route.Name != "user-orders" || userID != "42" {
+		t.Fatalf("expected route user-orders with userId 42, got %+v", match)
+	}
+}
+
+func splitRoute(version int64, stableWeight, canaryWeight int) *models.ServiceRoute {
+	return &models.ServiceRoute{
+		Name:       "checkout",
+		PathPrefix: "/checkout",
+		Split: &models.TrafficSplit{
+			Versions: []models.RouteVersion{
+				{Name: "stable", Weight: stableWeight, Targets: []models.Target{{URL: "http://stable.local"}}},
+				{Name: "canary", Weight: canaryWeight, Targets: []models.Target{{URL: "http://canary.local"}}},
+			},
+			StickyOn:  "header",
+			StickyKey: "X-User",
+		},
+		UpdatedAt: version,
+	}
+}
+
+func TestSplitStickyClientsMoveOnlyTowardsGrowingVersion(t *testing.T) {
+	m := New(&Config{})
+	route := splitRoute(1, 90, 10)
+	m.ReplaceRoutes([]*models.ServiceRoute{route})
+	balancer, err := m.GetBalancer(route)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assigned := make(map[string]string, 1000)
+	canary := 0
+	for i := range 1000 {
+		req := httptest.NewRequest("GET", "/checkout", nil)
+		req.Header.Set("X-User", fmt.Sprintf("user-%d", i))
+		host := balancer.Next(req).URL.Host
+		if again := balancer.Next(req).URL.Host; again != host {
+			t.Fatalf("expected user-%d to stick to %s, got %s", i, host, again)
+		}
+		assigned[req.Header.Get("X-User")] = host
+		if host == "canary.local" {
+			canary++
+		}
+	}
+	if canary < 50 || canary > 150 {
+		t.Fatalf("expected about 100 of 1000 clients on the canary, got %d", canary)
+	}
+
+	shifted := splitRoute(2, 50, 50)
+	m.ReplaceRoutes([]*models.ServiceRoute{shifted})
+	shiftedBalancer, err := m.GetBalancer(shifted)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if shiftedBalancer.Targets()[1] != balancer.Targets()[1] {
+		t.Fatal("expected the version balancers to be kept when only the weights change")
+	}
+	for user, host := range assigned {
+		req := httptest.NewRequest("GET", "/checkout", nil)
+		req.Header.Set("X-User", user)
+		if host == "canary.local" && shiftedBalancer.Next(req).URL.Host != "canary.local" {
+			t.Fatalf("expected %s to stay on the canary", user)
+		}
+	}
+}
+
+func TestSplitFallsBackToAvailableVersions(t *testing.T) {
+	m := New(&Config{})
+	route := splitRoute(1, 0, 100)
+	route.Split.StickyOn = ""
+	m.ReplaceRoutes([]*models.ServiceRoute{route})
+	balancer, err := m.GetBalancer(route)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	canary := balancer.Targets()[1]
+	canary.RecordCheck(fmt.Errorf("down"), 1, 1)
+	if target := balancer.Next(httptest.NewRequest("GET", "/checkout", nil)); target != nil {
+		t.Fatalf("expected no target while the only weighted version is down, got %s", target.URL)
+	}
+
+	route = splitRoute(2, 1, 100)
+	m.ReplaceRoutes([]*models.ServiceRoute{route})
+	balancer, _ = m.GetBalancer(route)
+	for range 10 {
+		if target := balancer.Next(httptest.NewRequest("GET", "/checkout", nil)); target == nil || target.URL.Host != "stable.local" {
+			t.Fatalf("expected the stable version to take the canary's requests, got %v", target)
+		}
+	}
+}
+
+func BenchmarkMatchRequest(b *testing.B) {
+	m := New(&Config{})
+	routes := make([]*models.ServiceRoute, 0, 3000)
+	for i := range 1000 {
+		routes = append(routes,
+			&models.ServiceRoute{Name: fmt.Sprintf("static-%d", i), PathPrefix: fmt.Sprintf("/api/service%d", i)},
+			&models.ServiceRoute{Name: fmt.Sprintf("params-%d", i), PathPrefix: fmt.Sprintf("/api/service%d/users/{id}", i)},
+			&models.ServiceRoute{Name: fmt.Sprintf("host-%d", i), PathPrefix: "/api", Hosts: []string{fmt.Sprintf("tenant%d.example.com", i)}},
+		)
+	}
+	m.ReplaceRoutes(routes)
+	req := httptest.NewRequest("GET", "/api/service500/users/42/orders", nil)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		m.MatchRequest(req)
+	}
+}
@@ -0,0 +1,71 @@
+package routemanager
+
+import (
+	"github.com/gofreego/opengate/internal/models"
+	loadbalancer "github.com/gofreego/opengate/internal/service/load_balancer"
+	"github.com/gofreego/opengate/internal/service/mirror"
+	retrypolicy "github.com/gofreego/opengate/internal/service/retry_policy"
+	"github.com/gofreego/opengate/internal/service/rewrite"
+)
+
+// compiledRoute is a route along with the per-route state resolved when its snapshot was built
+type compiledRoute struct {
+	route       *models.ServiceRoute
+	balancer    loadbalancer.Balancer
+	balancerErr error
+	retryPolicy *retrypolicy.Policy
+	rewriter    *rewrite.Rewriter
+	rewriteErr  error
+	mirror      *mirror.Mirror
+	mirrorErr   error
+}
+
+// snapshot is an immutable routing table. A new snapshot is built and swapped in on every
+// change so requests read a consistent table without locking.
+type snapshot struct {
+	routes    []*models.ServiceRoute
+	hosts     *hostRouter               // Host buckets with a trie each for efficient path matching
+	nameIndex map[string]*compiledRoute // Hash map for name-based lookups
+}
+
+var emptySnapshot = &snapshot{
+	hosts:     newHostRouter(nil),
+	nameIndex: make(map[string]*compiledRoute),
+}
+
+// buildSnapshot builds the routing table of the routes, resolving their balancers, retry
+// policies and mirrors from the pools so their state carries over from the previous snapshot
+func (m *manager) buildSnapshot(routes []*models.ServiceRoute) *snapshot {
+	snap := &snapshot{
+		routes:    routes,
+		hosts:     newHostRouter(routes),
+		nameIndex: make(map[string]*compiledRoute, len(routes)),
+	}
+	for _, route := range routes {
+		compiled := &compiledRoute{
+			route:       route,
+			retryPolicy: m.retries.get(route),
+		}
+		compiled.balancer, compiled.balancerErr = m.balancers.get(route)
+		compiled.rewriter, compiled.rewriteErr = newRewriter(route)
+		compiled.mirror, compiled.mirrorErr = m.mirrors.get(route)
+		snap.nameIndex[route.Name] = compiled
+	}
+	return snap
+}
+
+// newRewriter compiles the rewrite of the route, nil if the route has none
+func newRewriter(route *models.ServiceRoute) (*rewrite.Rewriter, error) {
+	if route.Rewrite == nil {
+		return nil, nil
+	}
+	return rewrite.New(route.Rewrite)
+}
+
+// compiled returns the compiled state of the route if the route is the one served by the snapshot
+func (s *snapshot) compiled(route *models.ServiceRoute) *compiledRoute {
+	if compiled := s.nameIndex[route.Name]; compiled != nil && compiled.route == route {
+		return compiled
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package routemanager
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/pkg/utils"
+)
+
+const (
+	defaultRouteTimeout        = 30 * time.Second
+	defaultMaxIdleConns        = 512
+	defaultMaxIdleConnsPerHost = 64
+	defaultDialTimeout         = 5 * time.Second
+	defaultKeepAlive           = 30 * time.Second
+	defaultTLSHandshakeTimeout = 5 * time.Second
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
+// TransportConfig controls the pooled upstream transports used to proxy requests.
+// Zero values fall back to sensible defaults.
+type TransportConfig struct {
+	MaxIdleConns        int           `yaml:"MaxIdleConns"`
+	MaxIdleConnsPerHost int           `yaml:"MaxIdleConnsPerHost"`
+	MaxConnsPerHost     int           `yaml:"MaxConnsPerHost"` // 0 means no limit
+	DialTimeout         time.Duration `yaml:"DialTimeout"`
+	KeepAlive           time.Duration `yaml:"KeepAlive"`
+	TLSHandshakeTimeout time.Duration `yaml:"TLSHandshakeTimeout"`
+	IdleConnTimeout     time.Duration `yaml:"IdleConnTimeout"`
+	// TransportMaxAge rebuilds a route's whole transport once it gets older than this, closing its
+	// idle connections so the route reconnects (e.g. after DNS changes). Connections still in use
+	// finish on the old transport. Individual connections are not aged.
+	// 0 means transports are only rebuilt when the route changes.
+	TransportMaxAge time.Duration `yaml:"TransportMaxAge"`
+}
+
+// transportEntry is a pooled transport along with the route settings it was built for
+type transportEntry struct {
+	transport *http.Transport
+	targets   string // signature of the route's upstream targets
+	timeout   time.Duration
+	createdAt time.Time
+}
+
+// transportPool keeps one transport per route so upstream connections are reused across requests
+type transportPool struct {
+	cfg     *TransportConfig
+	mu      sync.RWMutex
+	entries map[string]*transportEntry // route name -> transport
+	now     func() time.Time
+}
+
+func newTransportPool(cfg *TransportConfig) *transportPool {
+	if cfg == nil {
+		cfg = &TransportConfig{}
+	}
+	return &transportPool{
+		cfg:     cfg,
+		entries: make(map[string]*transportEntry),
+		now:     time.Now,
+	}
+}
+
+// get returns the pooled transport for the route, building a new one if the route's
+// targets or timeout changed since the transport was created, or if it outlived TransportMaxAge
+func (p *transportPool) get(route *models.ServiceRoute) *http.Transport {
+	timeout := routeTimeout(route)
+	targets := targetsSignature(route)
+
+	p.mu.RLock()
+	entry := p.entries[route.Name]
+	p.mu.RUnlock()
+	if p.isUsable(entry, targets, timeout) {
+		return entry.transport
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// another request may have rebuilt it while we were waiting for the lock
+	entry = p.entries[route.Name]
+	if p.isUsable(entry, targets, timeout) {
+		return entry.transport
+	}
+	if entry != nil {
+		entry.transport.CloseIdleConnections()
+	}
+	entry = &transportEntry{
+		transport: p.newTransport(timeout),
+		targets:   targets,
+		timeout:   timeout,
+		createdAt: p.now(),
+	}
+	p.entries[route.Name] = entry
+	return entry.transport
+}
+
+// retain drops transports of routes that are no longer present
+func (p *transportPool) retain(routes []*models.ServiceRoute) {
+	names := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		names[route.Name] = struct{}{}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for name, entry := range p.entries {
+		if _, ok := names[name]; !ok {
+			entry.transport.CloseIdleConnections()
+			delete(p.entries, name)
+		}
+	}
+}
+
+func (p *transportPool) isUsable(entry *transportEntry, targets string, timeout time.Duration) bool {
+	if entry == nil || entry.targets != targets || entry.timeout != timeout {
+		return false
+	}
+	if p.cfg.TransportMaxAge > 0 && p.now().Sub(entry.createdAt) > p.cfg.TransportMaxAge {
+		return false
+	}
+	return true
+}
+
+func (p *transportPool) newTransport(timeout time.Duration) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   utils.ValueOrDefault(p.cfg.DialTimeout, defaultDialTimeout),
+		KeepAlive: utils.ValueOrDefault(p.cfg.KeepAlive, defaultKeepAlive),
+	}
+	return &http.Transport{
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          utils.ValueOrDefault(p.cfg.MaxIdleConns, defaultMaxIdleConns),
+		MaxIdleConnsPerHost:   utils.ValueOrDefault(p.cfg.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost),
+		MaxConnsPerHost:       p.cfg.MaxConnsPerHost,
+		IdleConnTimeout:       utils.ValueOrDefault(p.cfg.IdleConnTimeout, defaultIdleConnTimeout),
+		TLSHandshakeTimeout:   utils.ValueOrDefault(p.cfg.TLSHandshakeTimeout, defaultTLSHandshakeTimeout),
+		ResponseHeaderTimeout: timeout,
+		ExpectContinueTimeout: time.Second,
+	}
+}
+
+// routeTimeout returns the route's upstream timeout, defaulting to 30 seconds
+func routeTimeout(route *models.ServiceRoute) time.Duration {
+	if route.Timeout > 0 {
+		return route.Timeout
+	}
+	return defaultRouteTimeout
+}
@@ -0,0 +1,46 @@
+package routemanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofreego/opengate/internal/models"
+)
+
+func TestTransportPool(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := newTransportPool(&TransportConfig{TransportMaxAge: time.Minute})
+	p.now = func() time.Time { return now }
+	route := &models.ServiceRoute{Name: "orders", TargetURL: "http://orders.local"}
+
+	transport := p.get(route)
+	if p.get(&models.ServiceRoute{Name: "orders", TargetURL: "http://orders.local"}) != transport {
+		t.Fatal("expected the transport to be reused across requests")
+	}
+	if p.get(&models.ServiceRoute{Name: "users", TargetURL: "http://orders.local"}) == transport {
+		t.Fatal("expected every route to get its own transport")
+	}
+
+	retargeted := p.get(&models.ServiceRoute{Name: "orders", TargetURL: "http://orders-v2.local"})
+	if retargeted == transport {
+		t.Fatal("expected a new transport when the targets change")
+	}
+	slower := p.get(&models.ServiceRoute{Name: "orders", TargetURL: "http://orders-v2.local", Timeout: time.Minute})
+	if slower == retargeted || slower.ResponseHeaderTimeout != time.Minute {
+		t.Fatal("expected a new transport with the new timeout when the timeout changes")
+	}
+
+	now = now.Add(time.Minute)
+	if p.get(&models.ServiceRoute{Name: "orders", TargetURL: "http://orders-v2.local", Timeout: time.Minute}) != slower {
+		t.Fatal("expected the transport to be reused up to its max age")
+	}
+	now = now.Add(time.Second)
+	if p.get(&models.ServiceRoute{Name: "orders", TargetURL: "http://orders-v2.local", Timeout: time.Minute}) == slower {
+		t.Fatal("expected the transport to be rebuilt once older than its max age")
+	}
+
+	p.retain(nil)
+	if len(p.entries) != 0 {
+		t.Fatalf("expected the transports of removed routes to be dropped, got %d", len(p.entries))
+	}
+}
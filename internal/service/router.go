@@ -1,10 +1,13 @@
 package service
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httputil"
-	"net/url"
 	"strings"
 	"time"
 
@@ -14,73 +17,325 @@ import (
 	"github.com/gofreego/openauth/pkg/jwtutils"
 	"github.com/gofreego/opengate/internal/constants"
 	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/internal/service/auth"
+	loadbalancer "github.com/gofreego/opengate/internal/service/load_balancer"
+	ratelimiter "github.com/gofreego/opengate/internal/service/rate_limiter"
+	retrypolicy "github.com/gofreego/opengate/internal/service/retry_policy"
+	"github.com/gofreego/opengate/pkg/utils"
 )
 
 func (s *Service) RouteRequest(ctx *gin.Context) {
 	// Get the route for this request
-	route := s.routeManager.GetRouteByRequest(ctx.Request)
-	if route == nil {
+	match := s.routeManager.MatchRequest(ctx.Request)
+	if match == nil {
 		ctx.JSON(http.StatusNotFound, gin.H{"error": "No route found for this request"})
 		return
 	}
+	route := match.Route
+	if len(match.Params) > 0 {
+		ctx.Set(constants.PATH_PARAMS, match.Params)
+	}
+
+	// Count the request against the route's rate limit before it is authenticated, so rejected credentials
+	// use the limit up too. Limits counted by the user or API key wait for the request to be authenticated
+	// and count the rejected requests by client IP.
+	limitAfterAuth := route.RateLimit != nil && ratelimiter.KeyedByIdentity(route.RateLimit)
+	if !limitAfterAuth && !s.rateLimit(ctx, route, false) {
+		return
+	}
 
-	// Check authentication if required
-	if route.Authentication.IsAuthenticationRequired(ctx.Request.URL.Path, ctx.Request.Method) {
-		if err := s.authManager.Authenticate(ctx); err != nil {
+	// Check authentication if required, then the permissions and claims the route requires
+	if required, require := route.Authentication.Policy(ctx.Request.URL.Path, ctx.Request.Method); required {
+		if err := s.authManager.Authenticate(ctx, route.Authentication.Strategies, route.Authentication.Mode); err != nil {
 			logger.Warn(ctx, "Authentication failed for route: %s, error: %v", route.Name, err)
+			if limitAfterAuth && !s.rateLimit(ctx, route, true) {
+				return
+			}
+			if status := auth.RejectionStatus(err); status != http.StatusUnauthorized {
+				ctx.JSON(status, gin.H{"error": http.StatusText(status)})
+				return
+			}
 			ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
 			return
 		}
+		if err := auth.Authorize(ctx, require); err != nil {
+			logger.Warn(ctx, "Authorization failed for route: %s, error: %v", route.Name, err)
+			if limitAfterAuth && !s.rateLimit(ctx, route, false) {
+				return
+			}
+			ctx.JSON(http.StatusForbidden, gin.H{"error": "Permission denied"})
+			return
+		}
+	}
+
+	if limitAfterAuth && !s.rateLimit(ctx, route, false) {
+		return
 	}
 
-	// Use ProxyPass to handle the request
-	s.proxyPass(ctx, route)
+	// Run the route's middleware chain around the proxy
+	chain, err := s.middlewares.get(route)
+	if err != nil {
+		logger.Error(ctx, "Invalid middleware for route %s: %v", route.Name, err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid middleware configuration"})
+		return
+	}
+	chain(func(ctx *gin.Context) {
+		s.proxyPass(ctx, route, match.Prefix)
+	})(ctx)
 }
 
-// proxyPass handles the proxying of requests to the target service
-func (s *Service) proxyPass(ctx *gin.Context, route *models.ServiceRoute) {
-	// Parse target URL
-	targetURL, err := url.Parse(route.TargetURL)
+// errRetryResponse aborts a proxied response that the route's retry policy is going to retry
+var errRetryResponse = errors.New("retrying upstream response")
+
+// PathParams returns the path parameters captured by the pattern of the request's route
+func PathParams(ctx *gin.Context) utils.Params {
+	if params, ok := ctx.Get(constants.PATH_PARAMS); ok {
+		return params.(utils.Params)
+	}
+	return nil
+}
+
+// proxyPass handles the proxying of requests to the target service, prefix being the part
+// of the request path matched by the route's pattern
+func (s *Service) proxyPass(ctx *gin.Context, route *models.ServiceRoute, prefix string) {
+	// Pick the upstream target with the route's load balancing policy
+	balancer, err := s.routeManager.GetBalancer(route)
 	if err != nil {
-		logger.Error(ctx, "Failed to parse target URL: %v", err)
+		logger.Error(ctx, "Invalid upstream targets for route %s: %v", route.Name, err)
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid target URL"})
 		return
 	}
 
+	// Rewrite the path and host sent upstream
+	path, host, err := s.rewriteRequest(route, ctx.Request.URL.Path, prefix, PathParams(ctx))
+	if err != nil {
+		logger.Error(ctx, "Invalid rewrite for route %s: %v", route.Name, err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid rewrite configuration"})
+		return
+	}
+	ctx.Request.URL.Path = path
+	if host != "" {
+		ctx.Set(constants.UPSTREAM_HOST, host)
+	}
+
+	// Send the route's upstream a token minted for it in place of any sent by the client
+	if !s.attachInternalToken(ctx, route) {
+		return
+	}
+
+	// Shadow a sample of the requests to the route's mirror before they go upstream
+	s.mirrorRequest(ctx, route)
+
+	// Only requests that are safe to repeat and whose body could be buffered are retried
+	policy := s.routeManager.GetRetryPolicy(route)
+	attempts := 1
+	var body []byte
+	if policy != nil {
+		policy.RecordRequest()
+		if policy.Retryable(ctx.Request) {
+			if buffered, ok := bufferBody(ctx.Request, policy.MaxBodyBytes()); ok {
+				body = buffered
+				attempts = policy.Attempts()
+			}
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		target := nextTarget(ctx.Request, balancer)
+		if target == nil {
+			if route.CircuitBreaker != nil {
+				logger.Warn(ctx, "Circuit breaker open for all upstream targets of route: %s", route.Name)
+				ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": "Circuit breaker open", "route": route.Name})
+				return
+			}
+			logger.Warn(ctx, "No upstream target available for route: %s", route.Name)
+			ctx.JSON(http.StatusServiceUnavailable, gin.H{"error": "No upstream target available"})
+			return
+		}
+
+		if attempts > 1 {
+			ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		canRetry := func() bool {
+			return attempt < attempts && policy.AllowRetry()
+		}
+		if !s.forward(ctx, route, target, policy, canRetry) {
+			return
+		}
+
+		backoff := policy.Backoff(attempt)
+		logger.Warn(ctx, "Retrying request to route %s in %v (attempt %d of %d)", route.Name, backoff, attempt+1, attempts)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Request.Context().Done():
+			return
+		}
+	}
+}
+
+// nextTarget returns the target the request is sent to, or nil if none admits it. A target picked by the
+// balancer can still be refused by its circuit breaker when another request took its last half open probe
+// in the meantime, in which case the balancer is asked again as it now skips that target.
+func nextTarget(req *http.Request, balancer loadbalancer.Balancer) *loadbalancer.Target {
+	for range balancer.Targets() {
+		target := balancer.Next(req)
+		if target == nil || target.Breaker == nil || target.Breaker.Allow() {
+			return target
+		}
+	}
+	return nil
+}
+
+// mirrorRequest sends a copy of the request to the route's mirror when it is sampled. The mirror
+// runs in the background and its outcome never affects the response to the client.
+func (s *Service) mirrorRequest(ctx *gin.Context, route *models.ServiceRoute) {
+	if route.Mirror == nil {
+		return
+	}
+	m, err := s.routeManager.GetMirror(route)
+	if err != nil {
+		logger.Error(ctx, "Invalid mirror for route %s: %v", route.Name, err)
+		return
+	}
+	if !m.Sample() {
+		return
+	}
+	// Upgraded connections can't be replayed and bodies over the limit are streamed to the route only
+	if ctx.Request.Header.Get("Upgrade") != "" {
+		m.Drop()
+		return
+	}
+	body, ok := bufferBody(ctx.Request, m.MaxBodyBytes())
+	if !ok {
+		m.Drop()
+		return
+	}
+
+	clone := ctx.Request.Clone(ctx.Request.Context())
+	setUpstreamHeaders(ctx, clone, route.Identity)
+	m.Send(clone, body)
+}
+
+// forward proxies the request to the target once. It reports whether the attempt failed in a way
+// the retry policy allows to retry, in which case nothing was written to the client.
+func (s *Service) forward(ctx *gin.Context, route *models.ServiceRoute, target *loadbalancer.Target, policy *retrypolicy.Policy, canRetry func() bool) bool {
+	target.Acquire()
+	defer target.Release()
+
 	// Create reverse proxy with httputil
-	proxy := httputil.NewSingleHostReverseProxy(targetURL)
+	proxy := httputil.NewSingleHostReverseProxy(target.URL)
 
 	// Configure proxy settings
 	s.configureProxy(ctx, proxy, route)
 
-	// Handle path modification if needed
-	if route.StripPrefix {
-		originalPath := ctx.Request.URL.Path
-		if strings.HasPrefix(originalPath, route.PathPrefix) {
-			ctx.Request.URL.Path = strings.TrimPrefix(originalPath, route.PathPrefix)
-			if !strings.HasPrefix(ctx.Request.URL.Path, "/") && ctx.Request.URL.Path != "" {
-				ctx.Request.URL.Path = "/" + ctx.Request.URL.Path
-			}
+	// Bound how long the attempt may wait for the response headers
+	req := ctx.Request
+	var perTryTimer *time.Timer
+	if policy != nil && policy.PerTryTimeout() > 0 {
+		attemptCtx, cancel := context.WithCancelCause(req.Context())
+		defer cancel(nil)
+		perTryTimer = time.AfterFunc(policy.PerTryTimeout(), func() { cancel(retrypolicy.ErrPerTryTimeout) })
+		defer perTryTimer.Stop()
+		req = req.WithContext(attemptCtx)
+	}
+
+	failed, canceled, retry := false, false, false
+	proxy.ModifyResponse = func(resp *http.Response) error {
+		if perTryTimer != nil {
+			perTryTimer.Stop()
+		}
+		failed = resp.StatusCode >= http.StatusInternalServerError
+		if policy != nil && policy.RetryableStatus(resp.StatusCode) && canRetry() {
+			retry = true
+			return errRetryResponse
+		}
+		return nil
+	}
+	errorHandler := proxy.ErrorHandler
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		if errors.Is(err, errRetryResponse) {
+			return
+		}
+		if cause := context.Cause(r.Context()); errors.Is(cause, retrypolicy.ErrPerTryTimeout) {
+			err = cause
 		}
+		// a client going away says nothing about the upstream
+		canceled = errors.Is(err, context.Canceled)
+		failed = !canceled
+		if policy != nil && policy.RetryableError(err) && canRetry() {
+			logger.Warn(r.Context(), "Proxy error: %v", err)
+			retry = true
+			return
+		}
+		errorHandler(w, r, err)
 	}
 
 	// Execute the proxy
-	proxy.ServeHTTP(ctx.Writer, ctx.Request)
+	proxy.ServeHTTP(ctx.Writer, req)
+
+	// Feed the outcome of the attempt to the target's circuit breaker, unless the client left before it was known
+	switch {
+	case target.Breaker == nil:
+	case canceled:
+		target.Breaker.Cancel()
+	case target.Breaker.Record(!failed):
+		logger.Warn(ctx, "Circuit breaker of target %s of route %s is now %s", target.URL, route.Name, target.Breaker.Snapshot().State)
+	}
+	return retry
 }
 
-func (s *Service) configureProxy(ctx *gin.Context, proxy *httputil.ReverseProxy, route *models.ServiceRoute) {
-	// Set timeout by configuring transport
-	timeout := route.Timeout
-	if timeout == 0 {
-		timeout = 30 * time.Second // Default timeout
+// rewriteRequest returns the path and Host header, empty to keep the client's, the request is sent upstream
+// with: the route's rewrite applied, or its matched prefix stripped when it has StripPrefix
+func (s *Service) rewriteRequest(route *models.ServiceRoute, path, prefix string, params utils.Params) (string, string, error) {
+	rewriter, err := s.routeManager.GetRewriter(route)
+	if err != nil {
+		return "", "", err
+	}
+
+	host := ""
+	if rewriter != nil {
+		host = rewriter.Host(params)
 	}
+	switch {
+	case rewriter != nil && rewriter.RewritesPath():
+		path = rewriter.Path(path, prefix, params)
+	case route.StripPrefix && strings.HasPrefix(path, prefix):
+		path = strings.TrimPrefix(path, prefix)
+		if !strings.HasPrefix(path, "/") && path != "" {
+			path = "/" + path
+		}
+	}
+	return path, host, nil
+}
 
-	// Configure transport with timeout
-	proxy.Transport = &http.Transport{
-		ResponseHeaderTimeout: timeout,
-		IdleConnTimeout:       timeout,
+// bufferBody reads the request body into memory so it can be replayed on retries. Bodies larger than
+// limit are left to be streamed, in which case it reports false.
+func bufferBody(req *http.Request, limit int64) ([]byte, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, true
+	}
+	if req.ContentLength > limit {
+		return nil, false
 	}
 
+	body, err := io.ReadAll(io.LimitReader(req.Body, limit+1))
+	if err != nil || int64(len(body)) > limit {
+		// hand what was read back to the proxy along with the rest of the body
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+		return nil, false
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, true
+}
+
+func (s *Service) configureProxy(ctx *gin.Context, proxy *httputil.ReverseProxy, route *models.ServiceRoute) {
+	// Reuse the route's pooled transport so upstream connections are kept alive across requests
+	proxy.Transport = s.routeManager.GetTransport(route)
+
 	// Configure error handler
 	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
 		logger.Error(r.Context(), "Proxy error: %v", err)
@@ -93,64 +348,98 @@ func (s *Service) configureProxy(ctx *gin.Context, proxy *httputil.ReverseProxy,
 	originalDirector := proxy.Director
 	proxy.Director = func(req *http.Request) {
 		originalDirector(req)
+		setUpstreamHeaders(ctx, req, route.Identity)
 
-		// Clear user headers to prevent spoofing
+		// Remember where the request went for the access log
+		ctx.Set(constants.UPSTREAM_URL, req.URL.String())
+	}
+}
+
+// setUpstreamHeaders sets the headers and Host of a request sent upstream, for the route's targets and mirror alike.
+// The identity of the route picks the identity headers, the default ones when it's nil.
+func setUpstreamHeaders(ctx *gin.Context, req *http.Request, identity *models.Identity) {
+	// Clear user headers to prevent spoofing
+	if identity == nil || !identity.ForwardToken {
 		req.Header.Del(goutilsConsts.HEADER_AUTHORIZATION)
-		req.Header.Del(goutilsConsts.USER_ID)
-		req.Header.Del(goutilsConsts.HEADER_USER_UUID)
-		req.Header.Del(goutilsConsts.HEADER_PROFILE_IDS)
-		req.Header.Del(goutilsConsts.PERMISSIONS)
-
-		// Add forwarding headers
-		req.Header.Set("X-Forwarded-Host", req.Host)
-		req.Header.Set("X-Real-IP", getClientIP(req))
-		req.Header.Set("X-Forwarded-Proto", getScheme(req))
-
-		// Add user headers from JWT claims if authentication was required
-		if claims, exists := ctx.Get(constants.JWT_CLAIMS); exists {
-			if jwtClaims, ok := claims.(*jwtutils.JWTClaims); ok {
-				if jwtClaims.UserID != 0 {
-					req.Header.Set(goutilsConsts.USER_ID, fmt.Sprintf("%d", jwtClaims.UserID))
-				}
-				if jwtClaims.UserUUID != "" {
-					req.Header.Set(goutilsConsts.HEADER_USER_UUID, jwtClaims.UserUUID)
-				}
-				if len(jwtClaims.Profiles) > 0 {
-					profileIDs := make([]string, len(jwtClaims.Profiles))
-					for i, p := range jwtClaims.Profiles {
-						profileIDs[i] = fmt.Sprintf("%d", p.Id)
-					}
-					req.Header.Set(goutilsConsts.HEADER_PROFILE_IDS, strings.Join(profileIDs, ","))
-				}
-				if len(jwtClaims.Permissions) > 0 {
-					req.Header.Set("x-user-perms", strings.Join(jwtClaims.Permissions, ","))
-				}
-			}
+	}
+	req.Header.Del(goutilsConsts.USER_ID)
+	req.Header.Del(goutilsConsts.HEADER_USER_UUID)
+	req.Header.Del(goutilsConsts.HEADER_PROFILE_IDS)
+	req.Header.Del(goutilsConsts.PERMISSIONS)
+	for _, header := range auth.ClientCertHeaders {
+		req.Header.Del(header)
+	}
+	if identity != nil {
+		for _, claimHeader := range identity.ClaimHeaders {
+			req.Header.Del(claimHeader.Header)
 		}
 	}
-}
 
-// getClientIP extracts the real client IP from request
-func getClientIP(req *http.Request) string {
-	// Check X-Forwarded-For header first
-	if xff := req.Header.Get("X-Forwarded-For"); xff != "" {
-		// Take the first IP in the chain
-		if idx := strings.Index(xff, ","); idx > 0 {
-			return strings.TrimSpace(xff[:idx])
+	// Add forwarding headers
+	req.Header.Set("X-Forwarded-Host", req.Host)
+	req.Header.Set("X-Real-IP", utils.GetClientIP(req))
+	req.Header.Set("X-Forwarded-Proto", getScheme(req))
+
+	// Send the rewritten Host header
+	if host := ctx.GetString(constants.UPSTREAM_HOST); host != "" {
+		req.Host = host
+	}
+
+	// Add user headers from JWT claims if authentication was required
+	if identity == nil || !identity.OmitDefaultHeaders {
+		setUserHeaders(ctx, req)
+	}
+
+	// Add the headers authentication passed on, like the answer of a forward auth service
+	if headers, ok := ctx.Value(constants.UPSTREAM_HEADERS).(http.Header); ok {
+		for name, values := range headers {
+			req.Header[name] = append([]string(nil), values...)
 		}
-		return strings.TrimSpace(xff)
 	}
 
-	// Check X-Real-IP header
-	if xri := req.Header.Get("X-Real-IP"); xri != "" {
-		return xri
+	if identity == nil {
+		return
 	}
 
-	// Fall back to RemoteAddr
-	if idx := strings.LastIndex(req.RemoteAddr, ":"); idx > 0 {
-		return req.RemoteAddr[:idx]
+	// Add the claims the route maps to headers, then its static headers
+	if len(identity.ClaimHeaders) > 0 {
+		if claims := auth.Claims(ctx); claims != nil {
+			for _, claimHeader := range identity.ClaimHeaders {
+				if claim, ok := auth.LookupClaim(claims, claimHeader.Claim); ok {
+					if value, ok := auth.ClaimHeaderValue(claim); ok {
+						req.Header.Set(claimHeader.Header, value)
+					}
+				}
+			}
+		}
+	}
+	for name, value := range identity.StaticHeaders {
+		req.Header.Set(name, value)
+	}
+}
+
+// setUserHeaders sets the default identity headers from the JWT claims of an authenticated request
+func setUserHeaders(ctx *gin.Context, req *http.Request) {
+	if claims, exists := ctx.Get(constants.JWT_CLAIMS); exists {
+		if jwtClaims, ok := claims.(*jwtutils.JWTClaims); ok {
+			if jwtClaims.UserID != 0 {
+				req.Header.Set(goutilsConsts.USER_ID, fmt.Sprintf("%d", jwtClaims.UserID))
+			}
+			if jwtClaims.UserUUID != "" {
+				req.Header.Set(goutilsConsts.HEADER_USER_UUID, jwtClaims.UserUUID)
+			}
+			if len(jwtClaims.Profiles) > 0 {
+				profileIDs := make([]string, len(jwtClaims.Profiles))
+				for i, p := range jwtClaims.Profiles {
+					profileIDs[i] = fmt.Sprintf("%d", p.Id)
+				}
+				req.Header.Set(goutilsConsts.HEADER_PROFILE_IDS, strings.Join(profileIDs, ","))
+			}
+			if len(jwtClaims.Permissions) > 0 {
+				req.Header.Set("x-user-perms", strings.Join(jwtClaims.Permissions, ","))
+			}
+		}
 	}
-	return req.RemoteAddr
 }
 
 // getScheme determines the request scheme
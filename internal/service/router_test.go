@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofreego/openauth/pkg/jwtutils"
+	"github.com/gofreego/opengate/internal/constants"
+	"github.com/gofreego/opengate/internal/models"
+	"github.com/gofreego/opengate/internal/service/auth"
+	retrypolicy "github.com/gofreego/opengate/internal/service/retry_policy"
+	routemanager "github.com/gofreego/opengate/internal/service/route_manager"
+)
+
+func TestSetUpstreamHeadersIdentity(t *testing.T) {
+	request := func() (*gin.Context, *http.Request) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+		req.Header.Set("Authorization", "Bearer token")
+		req.Header.Set("X-Tenant", "spoofed")
+		ctx.Request = req
+		ctx.Set(constants.JWT_CLAIMS, &jwtutils.JWTClaims{UserID: 42})
+		ctx.Set(constants.TOKEN_CLAIMS, map[string]any{
+			"userId": float64(42),
+			"org":    map[string]any{"tenant": "acme", "roles": []any{"admin", "billing"}},
+		})
+		return ctx, req
+	}
+
+	ctx, req := request()
+	setUpstreamHeaders(ctx, req, nil)
+	if req.Header.Get("Authorization") != "" || req.Header.Get("X-User-Id") != "42" {
+		t.Fatalf("expected the default headers without the token, got %v", req.Header)
+	}
+	if req.Header.Get("X-Tenant") != "spoofed" {
+		t.Fatal("expected headers outside the identity to be left alone")
+	}
+
+	ctx, req = request()
+	setUpstreamHeaders(ctx, req, &models.Identity{
+		ClaimHeaders: []models.ClaimHeader{
+			{Claim: "org.tenant", Header: "X-Tenant"},
+			{Claim: "org.roles", Header: "X-Roles"},
+			{Claim: "missing", Header: "X-Missing"},
+		},
+		OmitDefaultHeaders: true,
+		ForwardToken:       true,
+		StaticHeaders:      map[string]string{"X-Gateway": "opengate"},
+	})
+	want := map[string]string{
+		"Authorization": "Bearer token",
+		"X-Tenant":      "acme",
+		"X-Roles":       "admin,billing",
+		"X-Gateway":     "opengate",
+		"X-User-Id":     "",
+		"X-Missing":     "",
+	}
+	for name, value := range want {
+		if got := req.Header.Get(name); got != value {
+			t.Fatalf("expected %s %q, got %q", name, value, got)
+		}
+	}
+}
+
+// flakyUpstream answers the first request with fail and the following ones with 200, echoing the body
+type flakyUpstream struct {
+	mu     sync.Mutex
+	bodies []string
+	fail   func(w http.ResponseWriter, r *http.Request)
+}
+
+func (u *flakyUpstream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	u.mu.Lock()
+	u.bodies = append(u.bodies, string(body))
+	first := len(u.bodies) == 1
+	u.mu.Unlock()
+	if first {
+		u.fail(w, r)
+		return
+	}
+	w.Write(body)
+}
+
+func proxyTestRequest(t *testing.T, upstream http.Handler, policy *models.RetryPolicy, req *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	server := httptest.NewServer(upstream)
+	defer server.Close()
+	minter, err := auth.NewTokenMinter(context.Background(), &auth.InternalTokenConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Service{routeManager: routemanager.New(&routemanager.Config{}), tokenMinter: minter}
+	route := &models.ServiceRoute{Name: "orders", PathPrefix: "/orders", TargetURL: server.URL, RetryPolicy: policy}
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(closeNotifyRecorder{recorder})
+	ctx.Request = req
+	s.proxyPass(ctx, route, "")
+	return recorder
+}
+
+// closeNotifyRecorder is a recorder the reverse proxy can write to, as gin expects a CloseNotifier
+type closeNotifyRecorder struct{ *httptest.ResponseRecorder }
+
+func (closeNotifyRecorder) CloseNotify() <-chan bool { return nil }
+
+func TestProxyPassRetries(t *testing.T) {
+	policy := &models.RetryPolicy{Attempts: 2, RetryOn: []string{"503"}, BackoffBase: time.Millisecond, BackoffMax: time.Millisecond}
+	unavailable := func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}
+
+	upstream := &flakyUpstream{fail: unavailable}
+	resp := proxyTestRequest(t, upstream, policy, httptest.NewRequest(http.MethodPut, "/orders/1", strings.NewReader(`{"qty":2}`)))
+	if resp.Code != http.StatusOK || resp.Body.String() != `{"qty":2}` {
+		t.Fatalf("expected the retried response with the body, got %d %q", resp.Code, resp.Body.String())
+	}
+	if len(upstream.bodies) != 2 || upstream.bodies[0] != upstream.bodies[1] {
+		t.Fatalf("expected the body to be sent again on the retry, got %q", upstream.bodies)
+	}
+
+	upstream = &flakyUpstream{fail: unavailable}
+	resp = proxyTestRequest(t, upstream, policy, httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{"qty":2}`)))
+	if resp.Code != http.StatusServiceUnavailable || len(upstream.bodies) != 1 {
+		t.Fatalf("expected a POST not to be retried, got %d after %d attempts", resp.Code, len(upstream.bodies))
+	}
+
+	// an attempt over the per-try timeout is abandoned and retried
+	slow := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}
+	upstream = &flakyUpstream{fail: slow}
+	policy = &models.RetryPolicy{Attempts: 2, RetryOn: []string{retrypolicy.RetryOnTimeout}, PerTryTimeout: 50 * time.Millisecond, BackoffBase: time.Millisecond, BackoffMax: time.Millisecond}
+	resp = proxyTestRequest(t, upstream, policy, httptest.NewRequest(http.MethodGet, "/orders/1", nil))
+	if resp.Code != http.StatusOK || len(upstream.bodies) != 2 {
+		t.Fatalf("expected the timed out attempt to be retried, got %d after %d attempts", resp.Code, len(upstream.bodies))
+	}
+}
@@ -9,14 +9,21 @@ import (
 	"github.com/gofreego/opengate/internal/models"
 	"github.com/gofreego/opengate/internal/service/auth"
 	changedetector "github.com/gofreego/opengate/internal/service/change_detector"
+	healthchecker "github.com/gofreego/opengate/internal/service/health_checker"
+	ratelimiter "github.com/gofreego/opengate/internal/service/rate_limiter"
 	routemanager "github.com/gofreego/opengate/internal/service/route_manager"
 	settingsmanager "github.com/gofreego/opengate/internal/service/settings_manager"
+	"github.com/gofreego/opengate/pkg/utils"
 )
 
 type Config struct {
 	Auth                  auth.Config              `yaml:"Auth"`
+	InternalToken         auth.InternalTokenConfig `yaml:"InternalToken"`
+	RateLimiter           ratelimiter.Config       `yaml:"RateLimiter"`
 	ChangeDetector        changedetector.Config    `yaml:"ChangeDetector"`
+	HealthChecker         healthchecker.Config     `yaml:"HealthChecker"`
 	SettingsManager       settingsmanager.Config   `yaml:"SettingsManager"`
+	RouteManager          routemanager.Config      `yaml:"RouteManager"`
 	InitialRoutes         []models.ServiceRoute    `yaml:"InitialRoutes"`
 	EnablePermissionCheck bool                     `yaml:"EnablePermissionCheck"`
 }
@@ -35,33 +42,63 @@ type Repository interface {
 	// App settings operations
 	GetAppSettings(ctx context.Context) ([]*models.AppSetting, error)
 	UpsertAppSetting(ctx context.Context, setting *models.AppSetting) error
+
+	// API key operations
+	CreateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error)
+	GetAPIKeyByID(ctx context.Context, id int64) (*models.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]*models.APIKey, error)
+	UpdateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error)
 }
 
 type Service struct {
-	repo            Repository
-	settingsMgr     *settingsmanager.Manager
-	routeManager    routemanager.Manager
-	authManager     auth.AuthManager
-	cfg             *Config
+	repo         Repository
+	settingsMgr  *settingsmanager.Manager
+	routeManager routemanager.Manager
+	authManager  auth.AuthManager
+	tokenMinter  *auth.TokenMinter
+	rateLimiter  ratelimiter.Backend
+	proxies      utils.TrustedProxies
+	middlewares  *middlewareChains
+	cfg          *Config
 	opengate_v1.UnimplementedOpenGateServiceServer
 }
 
 func NewService(ctx context.Context, cfg *Config, repo Repository, cache cache.Cache) *Service {
-	authManager, err := auth.NewAuthManager(ctx, &cfg.Auth, cache)
+	authManager, err := auth.NewAuthManager(ctx, &cfg.Auth, cache, repo)
 	if err != nil {
 		panic("failed to create AuthManager: " + err.Error())
 	}
+	tokenMinter, err := auth.NewTokenMinter(ctx, &cfg.InternalToken)
+	if err != nil {
+		panic("failed to create TokenMinter: " + err.Error())
+	}
+	rateLimiter, err := ratelimiter.New(ctx, &cfg.RateLimiter)
+	if err != nil {
+		panic("failed to create rate limiter: " + err.Error())
+	}
+	proxies, err := utils.ParseTrustedProxies(cfg.RateLimiter.TrustedProxies)
+	if err != nil {
+		panic("failed to parse trusted proxies: " + err.Error())
+	}
 	settingsMgr := settingsmanager.New(repo, &cfg.SettingsManager)
 	service := &Service{
 		cfg:          cfg,
 		repo:         repo,
 		settingsMgr:  settingsMgr,
-		routeManager: routemanager.New(),
+		routeManager: routemanager.New(&cfg.RouteManager),
 		authManager:  authManager,
+		tokenMinter:  tokenMinter,
+		rateLimiter:  rateLimiter,
+		proxies:      proxies,
+		middlewares:  newMiddlewareChains(),
 	}
 	// Seed initial routes from config
 	service.seedInitialRoutes(ctx)
-	go changedetector.New(repo, service.routeManager, &cfg.ChangeDetector).DetectChanges(ctx)
+	changeDetector := changedetector.New(repo, service.routeManager, &cfg.ChangeDetector)
+	changeDetector.Subscribe(service.onRoutesChanged)
+	go changeDetector.DetectChanges(ctx)
+	go healthchecker.New(service.routeManager, &cfg.HealthChecker).Start(ctx)
 	go settingsMgr.Start(ctx)
 	return service
 }
@@ -93,13 +130,27 @@ func (s *Service) seedInitialRoutes(ctx context.Context) {
 		}
 
 		config := &models.Config{
-			Name:           route.Name,
-			PathPrefix:     route.PathPrefix,
-			TargetURL:      route.TargetURL,
-			StripPrefix:    route.StripPrefix,
-			Authentication: route.Authentication,
-			Middleware:     route.Middleware,
-			Timeout:        route.Timeout,
+			Name:             route.Name,
+			PathPrefix:       route.PathPrefix,
+			Hosts:            route.Hosts,
+			Match:            route.Match,
+			Priority:         route.Priority,
+			TargetURL:        route.TargetURL,
+			Targets:          route.Targets,
+			Split:            route.Split,
+			Mirror:           route.Mirror,
+			Identity:         route.Identity,
+			RateLimit:        route.RateLimit,
+			LoadBalancer:     route.LoadBalancer,
+			HealthCheck:      route.HealthCheck,
+			CircuitBreaker:   route.CircuitBreaker,
+			RetryPolicy:      route.RetryPolicy,
+			StripPrefix:      route.StripPrefix,
+			Rewrite:          route.Rewrite,
+			Authentication:   route.Authentication,
+			Middleware:       route.Middleware,
+			MiddlewareConfig: route.MiddlewareConfig,
+			Timeout:          route.Timeout,
 		}
 
 		_, err := s.repo.CreateConfig(ctx, config)
@@ -110,3 +161,8 @@ func (s *Service) seedInitialRoutes(ctx context.Context) {
 		logger.Info(ctx, "seeded initial route: %s", route.Name)
 	}
 }
+
+// onRoutesChanged releases the state kept for routes that are no longer served
+func (s *Service) onRoutesChanged(ctx context.Context, event *changedetector.RoutesChanged) {
+	s.middlewares.remove(event.Removed...)
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gofreego/opengate/api/opengate_v1"
+	"github.com/gofreego/opengate/internal/constants"
+)
+
+// TestRoute implements OpenGateServiceServer.TestRoute, it tells which route the gateway would select
+// for a request and the upstream path and host it would send, without proxying anything
+func (s *Service) TestRoute(ctx context.Context, req *opengate_v1.TestRouteRequest) (*opengate_v1.TestRouteResponse, error) {
+	// Check read permission
+	if err := s.checkPermission(ctx, constants.PERMISSION_ROUTES_READ); err != nil {
+		return nil, err
+	}
+
+	target, err := url.ParseRequestURI(req.GetPath())
+	if err != nil || !strings.HasPrefix(target.Path, "/") {
+		return nil, fmt.Errorf("invalid path %q: must start with /", req.GetPath())
+	}
+
+	httpReq := &http.Request{
+		Method: strings.ToUpper(req.GetMethod()),
+		URL:    target,
+		Host:   req.GetHost(),
+		Header: make(http.Header, len(req.GetHeaders())),
+	}
+	for _, header := range req.GetHeaders() {
+		httpReq.Header.Add(header.GetKey(), header.GetValue())
+	}
+
+	match := s.routeManager.MatchRequest(httpReq)
+	if match == nil {
+		return &opengate_v1.TestRouteResponse{
+			Message: "No route matches the request",
+		}, nil
+	}
+
+	route := match.Route
+	path, host, err := s.rewriteRequest(route, target.Path, match.Prefix, match.Params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rewrite configuration for route %s: %w", route.Name, err)
+	}
+
+	resp := &opengate_v1.TestRouteResponse{
+		Matched:       true,
+		Route:         route.Name,
+		MatchedPrefix: match.Prefix,
+		UpstreamPath:  path,
+		UpstreamHost:  host,
+		Message:       "Route matched",
+	}
+	for _, param := range match.Params {
+		resp.PathParams = append(resp.PathParams, &opengate_v1.KeyValue{Key: param.Key, Value: param.Value})
+	}
+	if balancer, err := s.routeManager.GetBalancer(route); err == nil {
+		for _, t := range balancer.Targets() {
+			resp.Targets = append(resp.Targets, t.URL.String())
+		}
+	}
+	return resp, nil
+}
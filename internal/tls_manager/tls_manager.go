@@ -0,0 +1,277 @@
+package tlsmanager
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+)
+
+const defaultReloadInterval = time.Minute
+
+// Client certificate modes of a listener
+const (
+	ClientAuthNone     = "none"     // client certificates aren't asked for
+	ClientAuthOptional = "optional" // client certificates are verified when sent
+	ClientAuthRequire  = "require"  // connections without a valid client certificate are refused
+)
+
+// minVersions are the accepted MinVersion values
+var minVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// Config represents the TLS settings of a listener, it serves plain HTTP without certificates
+type Config struct {
+	CertFile       string        `json:"certFile" yaml:"CertFile"`
+	KeyFile        string        `json:"keyFile" yaml:"KeyFile"`
+	Certificates   []Certificate `json:"certificates" yaml:"Certificates"`     // more certificates, picked by the SNI of the client
+	MinVersion     string        `json:"minVersion" yaml:"MinVersion"`         // 1.2 (default) or 1.3
+	CipherSuites   []string      `json:"cipherSuites" yaml:"CipherSuites"`     // TLS 1.2 cipher suites, Go's secure defaults when empty
+	ClientAuth     string        `json:"clientAuth" yaml:"ClientAuth"`         // none (default), optional or require
+	ClientCAFiles  []string      `json:"clientCaFiles" yaml:"ClientCAFiles"`   // CA bundles client certificates are verified against
+	ReloadInterval time.Duration `json:"reloadInterval" yaml:"ReloadInterval"` // how often the files are checked for changes, default 1m
+	RedirectPort   int           `json:"redirectPort" yaml:"RedirectPort"`     // plain HTTP port redirecting to HTTPS, none when 0
+}
+
+// Certificate is a certificate and key pair, the certificate file may hold the chain
+type Certificate struct {
+	CertFile string `json:"certFile" yaml:"CertFile"`
+	KeyFile  string `json:"keyFile" yaml:"KeyFile"`
+}
+
+// Enabled reports whether the listener serves HTTPS
+func (c *Config) Enabled() bool {
+	return c.CertFile != "" || len(c.Certificates) > 0
+}
+
+// certificates returns every configured certificate, the default one first
+func (c *Config) certificates() []Certificate {
+	certs := make([]Certificate, 0, len(c.Certificates)+1)
+	if c.CertFile != "" {
+		certs = append(certs, Certificate{CertFile: c.CertFile, KeyFile: c.KeyFile})
+	}
+	return append(certs, c.Certificates...)
+}
+
+// files returns the files the TLS settings are loaded from
+func (c *Config) files() []string {
+	var files []string
+	for _, cert := range c.certificates() {
+		files = append(files, cert.CertFile, cert.KeyFile)
+	}
+	return append(files, c.ClientCAFiles...)
+}
+
+// Manager holds the TLS settings of a listener and reloads them when their files change, so
+// certificates are renewed without restarting the process
+type Manager struct {
+	cfg      *Config
+	current  atomic.Pointer[tls.Config]
+	modTimes map[string]time.Time // of the files the current settings were loaded from
+}
+
+// New loads the TLS settings and starts watching their files, nil when TLS isn't configured
+func New(ctx context.Context, cfg *Config) (*Manager, error) {
+	if !cfg.Enabled() {
+		if cfg.ClientAuth != "" && cfg.ClientAuth != ClientAuthNone {
+			return nil, fmt.Errorf("client certificate verification requires TLS, set CertFile and KeyFile")
+		}
+		return nil, nil
+	}
+
+	m := &Manager{cfg: cfg}
+	if err := m.reload(); err != nil {
+		return nil, err
+	}
+	interval := cfg.ReloadInterval
+	if interval <= 0 {
+		interval = defaultReloadInterval
+	}
+	go m.reloadEvery(ctx, interval)
+	return m, nil
+}
+
+// TLSConfig returns the settings a listener serves with, they always hold the latest certificates
+func (m *Manager) TLSConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return m.current.Load(), nil
+		},
+		// GetCertificate only marks the config as holding certificates, GetConfigForClient takes precedence
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return m.current.Load().GetCertificate(hello)
+		},
+	}
+}
+
+func (m *Manager) reloadEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !m.changed() {
+				continue
+			}
+			if err := m.reload(); err != nil {
+				logger.Warn(ctx, "Failed to reload TLS certificates, keeping the previous ones: %v", err)
+				continue
+			}
+			logger.Info(ctx, "Reloaded TLS certificates")
+		}
+	}
+}
+
+// changed reports whether a file changed since the settings were loaded
+func (m *Manager) changed() bool {
+	for _, file := range m.cfg.files() {
+		info, err := os.Stat(file)
+		if err != nil || !info.ModTime().Equal(m.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+// reload loads the settings from their files, the previous ones stay in use when it fails
+func (m *Manager) reload() error {
+	modTimes := make(map[string]time.Time)
+	for _, file := range m.cfg.files() {
+		if info, err := os.Stat(file); err == nil {
+			modTimes[file] = info.ModTime()
+		}
+	}
+	config, err := build(m.cfg)
+	if err != nil {
+		return err
+	}
+	m.current.Store(config)
+	m.modTimes = modTimes
+	return nil
+}
+
+// build loads the certificates and client CAs into the settings the listener serves with
+func build(cfg *Config) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+
+	for _, c := range cfg.certificates() {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load certificate %s: %w", c.CertFile, err)
+		}
+		config.Certificates = append(config.Certificates, cert)
+	}
+	// the certificate matching the SNI of the client is picked, the first one otherwise
+	certs := config.Certificates
+	config.GetCertificate = func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		for i := range certs {
+			if hello.SupportsCertificate(&certs[i]) == nil {
+				return &certs[i], nil
+			}
+		}
+		return &certs[0], nil
+	}
+
+	if cfg.MinVersion != "" {
+		version, ok := minVersions[cfg.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("invalid TLS min version %q: must be 1.2 or 1.3", cfg.MinVersion)
+		}
+		config.MinVersion = version
+	}
+
+	for _, name := range cfg.CipherSuites {
+		id, ok := cipherSuite(name)
+		if !ok {
+			return nil, fmt.Errorf("unsupported cipher suite %q", name)
+		}
+		config.CipherSuites = append(config.CipherSuites, id)
+	}
+
+	switch cfg.ClientAuth {
+	case "", ClientAuthNone:
+		return config, nil
+	case ClientAuthOptional:
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	case ClientAuthRequire:
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("invalid client auth %q: must be %s, %s or %s",
+			cfg.ClientAuth, ClientAuthNone, ClientAuthOptional, ClientAuthRequire)
+	}
+
+	if len(cfg.ClientCAFiles) == 0 {
+		return nil, fmt.Errorf("client auth %s requires ClientCAFiles", cfg.ClientAuth)
+	}
+	config.ClientCAs = x509.NewCertPool()
+	for _, file := range cfg.ClientCAFiles {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		if !config.ClientCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("client CA file %s holds no PEM encoded certificates", file)
+		}
+	}
+	return config, nil
+}
+
+// cipherSuite returns the id of a secure cipher suite by its name, like TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+func cipherSuite(name string) (uint16, bool) {
+	for _, suite := range tls.CipherSuites() {
+		if suite.Name == name {
+			return suite.ID, true
+		}
+	}
+	return 0, false
+}
+
+// NewRedirectServer builds the plain HTTP server sending clients to the HTTPS listener on httpsPort
+func NewRedirectServer(redirectPort, httpsPort int) *http.Server {
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%d", redirectPort),
+		Handler:           RedirectHandler(httpsPort),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+// RedirectHandler permanently redirects requests to the same URL on the HTTPS listener on httpsPort
+func RedirectHandler(httpsPort int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if strings.Contains(host, ":") {
+			host = "[" + host + "]" // IPv6 literal
+		}
+		if httpsPort != 443 {
+			host += ":" + strconv.Itoa(httpsPort)
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusPermanentRedirect)
+	})
+}
+
+// RunRedirect serves the redirect to HTTPS until the server is shut down
+func RunRedirect(ctx context.Context, server *http.Server) {
+	logger.Info(ctx, "Redirecting HTTP on %s to HTTPS", server.Addr)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Error(ctx, "failed to start HTTPS redirect server : %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+package tlsmanager
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert writes a self-signed certificate for the DNS name and its key to dir
+func writeCert(t *testing.T, dir, name string, serial int64) Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := Certificate{CertFile: filepath.Join(dir, name+".pem"), KeyFile: filepath.Join(dir, name+"-key.pem")}
+	if err := os.WriteFile(cert.CertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(cert.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// served returns the serial of the certificate the listener serves for the SNI name
+func served(t *testing.T, addr, serverName string) int64 {
+	t.Helper()
+	conn, err := tls.Dial("tcp", addr, &tls.Config{ServerName: serverName, InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("handshake for %s failed: %v", serverName, err)
+	}
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64()
+}
+
+func TestManagerPicksCertificateBySNIAndReloads(t *testing.T) {
+	dir := t.TempDir()
+	first := writeCert(t, dir, "api.example.com", 1)
+	second := writeCert(t, dir, "admin.example.com", 2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m, err := New(ctx, &Config{
+		CertFile:       first.CertFile,
+		KeyFile:        first.KeyFile,
+		Certificates:   []Certificate{second},
+		ReloadInterval: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", m.TLSConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				conn.(*tls.Conn).Handshake()
+				conn.Close()
+			}()
+		}
+	}()
+	addr := listener.Addr().String()
+
+	if serial := served(t, addr, "admin.example.com"); serial != 2 {
+		t.Fatalf("expected the admin certificate, got serial %d", serial)
+	}
+	if serial := served(t, addr, "unknown.example.com"); serial != 1 {
+		t.Fatalf("expected the default certificate for unknown names, got serial %d", serial)
+	}
+
+	// a renewed certificate is served once reloaded, without a new listener
+	writeCert(t, dir, "admin.example.com", 3)
+	future := time.Now().Add(time.Minute)
+	os.Chtimes(second.CertFile, future, future)
+	if !m.changed() {
+		t.Fatal("expected the renewed certificate to be noticed")
+	}
+	if err := m.reload(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if serial := served(t, addr, "admin.example.com"); serial != 3 {
+		t.Fatalf("expected the renewed certificate, got serial %d", serial)
+	}
+
+	// a broken renewal keeps the previous certificates
+	os.WriteFile(second.KeyFile, []byte("broken"), 0o600)
+	if err := m.reload(); err == nil {
+		t.Fatal("expected the broken key to fail the reload")
+	}
+	if serial := served(t, addr, "admin.example.com"); serial != 3 {
+		t.Fatalf("expected the previous certificate to stay, got serial %d", serial)
+	}
+}
+
+func TestConfigValidation(t *testing.T) {
+	cert := writeCert(t, t.TempDir(), "api.example.com", 1)
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"min version", Config{CertFile: cert.CertFile, KeyFile: cert.KeyFile, MinVersion: "1.0"}},
+		{"cipher suite", Config{CertFile: cert.CertFile, KeyFile: cert.KeyFile, CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}}},
+		{"client auth", Config{CertFile: cert.CertFile, KeyFile: cert.KeyFile, ClientAuth: "sometimes"}},
+		{"client CAs", Config{CertFile: cert.CertFile, KeyFile: cert.KeyFile, ClientAuth: ClientAuthRequire}},
+		{"client auth without TLS", Config{ClientAuth: ClientAuthOptional}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(context.Background(), &tt.cfg); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+
+	m, err := New(context.Background(), &Config{})
+	if err != nil || m != nil {
+		t.Fatalf("expected no manager without certificates, got %v %v", m, err)
+	}
+}
+
+func TestRedirectHandler(t *testing.T) {
+	tests := []struct {
+		host      string
+		httpsPort int
+		want      string
+	}{
+		{"example.com", 443, "https://example.com/orders?id=1"},
+		{"example.com:8080", 8443, "https://example.com:8443/orders?id=1"},
+		{"[::1]:8080", 8443, "https://[::1]:8443/orders?id=1"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/orders?id=1", nil)
+		req.Host = tt.host
+		rec := httptest.NewRecorder()
+		RedirectHandler(tt.httpsPort).ServeHTTP(rec, req)
+		if rec.Code != http.StatusPermanentRedirect || rec.Header().Get("Location") != tt.want {
+			t.Fatalf("expected %s, got %d %s", tt.want, rec.Code, rec.Header().Get("Location"))
+		}
+	}
+}
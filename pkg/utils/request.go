@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// GetClientIP extracts the real client IP from request
+func GetClientIP(req *http.Request) string {
+	// Check X-Forwarded-For header first
+	if xff := req.Header.Get("X-Forwarded-For"); xff != "" {
+		// Take the first IP in the chain
+		if idx := strings.Index(xff, ","); idx > 0 {
+			return strings.TrimSpace(xff[:idx])
+		}
+		return strings.TrimSpace(xff)
+	}
+
+	// Check X-Real-IP header
+	if xri := req.Header.Get("X-Real-IP"); xri != "" {
+		return xri
+	}
+
+	// Fall back to RemoteAddr
+	if idx := strings.LastIndex(req.RemoteAddr, ":"); idx > 0 {
+		return req.RemoteAddr[:idx]
+	}
+	return req.RemoteAddr
+}
+
+// TrustedProxies are the networks of the proxies in front of the gateway whose X-Forwarded-For is believed
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses the addresses and CIDRs of the trusted proxies
+func ParseTrustedProxies(proxies []string) (TrustedProxies, error) {
+	trusted := make(TrustedProxies, 0, len(proxies))
+	for _, proxy := range proxies {
+		// a single address is a network of its own
+		cidr := proxy
+		if !strings.Contains(cidr, "/") {
+			if ip := net.ParseIP(cidr); ip != nil && ip.To4() != nil {
+				cidr += "/32"
+			} else {
+				cidr += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: must be an IP address or CIDR", proxy)
+		}
+		trusted = append(trusted, network)
+	}
+	return trusted, nil
+}
+
+// ClientIP returns the IP of the client that can't be spoofed by it: the peer address, unless it's a trusted
+// proxy, in which case the last X-Forwarded-For address that wasn't added by a trusted proxy
+func (p TrustedProxies) ClientIP(req *http.Request) string {
+	ip := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	if !p.contains(ip) {
+		return ip
+	}
+	forwarded := strings.Split(strings.Join(req.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if hop == "" {
+			continue
+		}
+		ip = hop
+		if !p.contains(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+func (p TrustedProxies) contains(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range p {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
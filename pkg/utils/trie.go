@@ -1,14 +1,84 @@
 package utils
 
 import (
+	"sort"
 	"strings"
 )
 
+// Pattern segments besides static ones:
+//
+//	{name}  matches a single segment and captures it as the path parameter name
+//	*       matches a single segment, v* matches a single segment starting with v
+//	**      matches any number of segments, only as the last segment
+const (
+	wildcardSegment = "*"
+	catchAllSegment = "**"
+)
+
+// Param is a path parameter captured by a {name} segment
+type Param struct {
+	Key   string
+	Value string
+}
+
+// Params are the path parameters captured by a pattern, in pattern order
+type Params []Param
+
+// Get returns the value of the path parameter
+func (params Params) Get(key string) (string, bool) {
+	for _, param := range params {
+		if param.Key == key {
+			return param.Value, true
+		}
+	}
+	return "", false
+}
+
+// Expand replaces the {name} references of the template with the values of the path parameters
+func (params Params) Expand(template string) string {
+	if len(params) == 0 || !strings.Contains(template, "{") {
+		return template
+	}
+	pairs := make([]string, 0, 2*len(params))
+	for _, param := range params {
+		pairs = append(pairs, "{"+param.Key+"}", param.Value)
+	}
+	return strings.NewReplacer(pairs...).Replace(template)
+}
+
+// PatternParams returns the names of the path parameters of a path pattern, in order
+func PatternParams(pattern string) []string {
+	var names []string
+	for _, part := range strings.Split(pattern, "/") {
+		if isParamSegment(part) {
+			names = append(names, part[1:len(part)-1])
+		}
+	}
+	return names
+}
+
+// TrieMatch is a route whose pattern matches a path
+type TrieMatch[k any] struct {
+	Route  k
+	Params Params
+	Prefix string // the part of the path matched by the pattern
+}
+
 // TrieNode represents a node in the prefix trie
 type TrieNode[k any] struct {
-	children map[string]*TrieNode[k]
-	route    k
-	isEnd    bool
+	children  map[string]*TrieNode[k] // static segments
+	param     *TrieNode[k]            // {name} segment
+	wildcards []*wildcardNode[k]      // * segments, longest literal prefix first
+	catchAll  *TrieNode[k]            // ** segment
+	route     k
+	params    []string // names of the parameters captured on the way to this node
+	isEnd     bool
+}
+
+// wildcardNode is a single segment wildcard child, matching segments starting with prefix
+type wildcardNode[k any] struct {
+	prefix string
+	node   *TrieNode[k]
 }
 
 // Trie represents a prefix tree for efficient route matching
@@ -19,56 +89,152 @@ type Trie[k any] struct {
 // NewTrie creates a new trie
 func NewTrie[k any]() *Trie[k] {
 	return &Trie[k]{
-		root: &TrieNode[k]{
-			children: make(map[string]*TrieNode[k]),
-		},
+		root: newTrieNode[k](),
+	}
+}
+
+func newTrieNode[k any]() *TrieNode[k] {
+	return &TrieNode[k]{
+		children: make(map[string]*TrieNode[k]),
 	}
 }
 
-// Insert adds a route to the trie
+// Insert adds a route to the trie under the path pattern
 func (t *Trie[k]) Insert(pathPrefix string, route k) {
 	node := t.root
-	parts := strings.Split(strings.Trim(pathPrefix, "/"), "/")
+	var params []string
 
-	for _, part := range parts {
-		if part == "" {
+	for _, part := range strings.Split(strings.Trim(pathPrefix, "/"), "/") {
+		switch {
+		case part == "":
 			continue
-		}
-		if node.children[part] == nil {
-			node.children[part] = &TrieNode[k]{
-				children: make(map[string]*TrieNode[k]),
+		case part == catchAllSegment:
+			if node.catchAll == nil {
+				node.catchAll = newTrieNode[k]()
+			}
+			node = node.catchAll
+		case isParamSegment(part):
+			if node.param == nil {
+				node.param = newTrieNode[k]()
 			}
+			node = node.param
+			params = append(params, part[1:len(part)-1])
+		case strings.HasSuffix(part, wildcardSegment):
+			node = node.wildcardChild(strings.TrimSuffix(part, wildcardSegment))
+		default:
+			if node.children[part] == nil {
+				node.children[part] = newTrieNode[k]()
+			}
+			node = node.children[part]
 		}
-		node = node.children[part]
 	}
 
 	node.route = route
+	node.params = params
 	node.isEnd = true
 }
 
-// FindLongestMatch finds the route with the longest matching prefix
+func (node *TrieNode[k]) wildcardChild(prefix string) *TrieNode[k] {
+	for _, wildcard := range node.wildcards {
+		if wildcard.prefix == prefix {
+			return wildcard.node
+		}
+	}
+	child := &wildcardNode[k]{prefix: prefix, node: newTrieNode[k]()}
+	node.wildcards = append(node.wildcards, child)
+	sort.SliceStable(node.wildcards, func(i, j int) bool {
+		return len(node.wildcards[i].prefix) > len(node.wildcards[j].prefix)
+	})
+	return child.node
+}
+
+// FindLongestMatch finds the route of the most specific pattern matching the path
 func (t *Trie[k]) FindLongestMatch(path string) k {
-	node := t.root
-	parts := strings.Split(strings.Trim(path, "/"), "/")
-	var lastMatch k
+	var route k
+	if matches := t.FindMatches(path); len(matches) > 0 {
+		route = matches[0].Route
+	}
+	return route
+}
+
+// FindMatches returns the routes of all patterns matching the path, most specific first: at the first
+// segment where two patterns differ a static segment wins over a parameter, which wins over a wildcard,
+// and longer patterns win over their own prefixes. Every node is visited at most once, so the lookup
+// of a path without competing parameters and wildcards is O(path length).
+func (t *Trie[k]) FindMatches(path string) []TrieMatch[k] {
+	m := &trieMatcher[k]{path: path}
+	m.segments, m.ends = splitPath(path)
+	m.walk(t.root, 0)
+	return m.matches
+}
 
-	// Check root for exact "/" match
+// trieMatcher holds the state of a single lookup
+type trieMatcher[k any] struct {
+	path     string
+	segments []string
+	ends     []int // end offset of every segment in path
+	captured []string
+	matches  []TrieMatch[k]
+}
+
+func (m *trieMatcher[k]) walk(node *TrieNode[k], depth int) {
+	if depth < len(m.segments) {
+		segment := m.segments[depth]
+		if child := node.children[segment]; child != nil {
+			m.walk(child, depth+1)
+		}
+		if node.param != nil {
+			m.captured = append(m.captured, segment)
+			m.walk(node.param, depth+1)
+			m.captured = m.captured[:len(m.captured)-1]
+		}
+		for _, wildcard := range node.wildcards {
+			if strings.HasPrefix(segment, wildcard.prefix) {
+				m.walk(wildcard.node, depth+1)
+			}
+		}
+	}
+	if node.catchAll != nil && node.catchAll.isEnd {
+		m.add(node.catchAll, len(m.path))
+	}
 	if node.isEnd {
-		lastMatch = node.route
+		end := 0
+		if depth > 0 {
+			end = m.ends[depth-1]
+		}
+		m.add(node, end)
 	}
+}
 
-	for _, part := range parts {
-		if part == "" {
-			continue
+func (m *trieMatcher[k]) add(node *TrieNode[k], end int) {
+	match := TrieMatch[k]{Route: node.route, Prefix: m.path[:end]}
+	if len(node.params) > 0 {
+		match.Params = make(Params, len(node.params))
+		for i, name := range node.params {
+			match.Params[i] = Param{Key: name, Value: m.captured[i]}
 		}
-		if node.children[part] == nil {
-			break
+	}
+	m.matches = append(m.matches, match)
+}
+
+// splitPath splits the path into its non empty segments along with the offset each one ends at
+func splitPath(path string) ([]string, []int) {
+	segments := make([]string, 0, 8)
+	ends := make([]int, 0, 8)
+	start := 0
+	for i := 0; i <= len(path); i++ {
+		if i < len(path) && path[i] != '/' {
+			continue
 		}
-		node = node.children[part]
-		if node.isEnd {
-			lastMatch = node.route
+		if i > start {
+			segments = append(segments, path[start:i])
+			ends = append(ends, i)
 		}
+		start = i + 1
 	}
+	return segments, ends
+}
 
-	return lastMatch
+func isParamSegment(part string) bool {
+	return len(part) > 2 && part[0] == '{' && part[len(part)-1] == '}'
 }
@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestTriePrecedence(t *testing.T) {
+	trie := NewTrie[string]()
+	for _, pattern := range []string{
+		"/",
+		"/users",
+		"/users/me",
+		"/users/{id}",
+		"/users/{id}/orders",
+		"/users/*/orders",
+		"/v*/catalog",
+		"/v2/catalog",
+		"/files/**",
+	} {
+		trie.Insert(pattern, pattern)
+	}
+
+	tests := []struct {
+		path   string
+		want   string
+		params Params
+		prefix string
+	}{
+		{"/users/me", "/users/me", nil, "/users/me"},
+		// the static segment wins where the patterns differ, even over a longer parameter pattern
+		{"/users/me/orders", "/users/me", nil, "/users/me"},
+		{"/users/42", "/users/{id}", Params{{"id", "42"}}, "/users/42"},
+		{"/users/42/orders/7", "/users/{id}/orders", Params{{"id", "42"}}, "/users/42/orders"},
+		{"/users//42/", "/users/{id}", Params{{"id", "42"}}, "/users//42"},
+		{"/v2/catalog/items", "/v2/catalog", nil, "/v2/catalog"},
+		{"/v3/catalog", "/v*/catalog", nil, "/v3/catalog"},
+		{"/files/a/b/c", "/files/**", nil, "/files/a/b/c"},
+		{"/other", "/", nil, ""},
+	}
+	for _, tt := range tests {
+		matches := trie.FindMatches(tt.path)
+		if len(matches) == 0 {
+			t.Errorf("%s: no match", tt.path)
+			continue
+		}
+		got := matches[0]
+		if got.Route != tt.want || got.Prefix != tt.prefix || fmt.Sprint(got.Params) != fmt.Sprint(tt.params) {
+			t.Errorf("%s: expected %s %v %q, got %s %v %q", tt.path, tt.want, tt.params, tt.prefix, got.Route, got.Params, got.Prefix)
+		}
+	}
+}
+
+func TestTrieFindMatchesOrder(t *testing.T) {
+	trie := NewTrie[string]()
+	for _, pattern := range []string{"/", "/users", "/users/{id}", "/users/*/orders", "/users/{id}/orders"} {
+		trie.Insert(pattern, pattern)
+	}
+
+	var got []string
+	for _, match := range trie.FindMatches("/users/42/orders") {
+		got = append(got, match.Route)
+	}
+	want := "/users/{id}/orders,/users/{id},/users/*/orders,/users,/"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("expected %s, got %s", want, strings.Join(got, ","))
+	}
+}
+
+// benchmarkTrie builds a trie of 1000 services with static, parameter and wildcard routes each
+func benchmarkTrie() *Trie[string] {
+	trie := NewTrie[string]()
+	for i := range 1000 {
+		trie.Insert(fmt.Sprintf("/api/v1/service%d", i), "static")
+		trie.Insert(fmt.Sprintf("/api/v1/service%d/users/{id}/orders/{orderId}", i), "params")
+		trie.Insert(fmt.Sprintf("/api/v1/service%d/files/**", i), "catch-all")
+		trie.Insert(fmt.Sprintf("/api/v*/service%d/catalog", i), "wildcard")
+	}
+	return trie
+}
+
+func BenchmarkTrieStatic(b *testing.B) {
+	trie := benchmarkTrie()
+	b.ReportAllocs()
+	for b.Loop() {
+		trie.FindMatches("/api/v1/service500/health")
+	}
+}
+
+func BenchmarkTrieParams(b *testing.B) {
+	trie := benchmarkTrie()
+	b.ReportAllocs()
+	for b.Loop() {
+		trie.FindMatches("/api/v1/service500/users/42/orders/7")
+	}
+}
+
+func BenchmarkTrieWildcard(b *testing.B) {
+	trie := benchmarkTrie()
+	b.ReportAllocs()
+	for b.Loop() {
+		trie.FindMatches("/api/v2/service500/catalog")
+	}
+}
+
+// BenchmarkTriePathLength shows the lookup time grows with the path length, not with the number of routes
+func BenchmarkTriePathLength(b *testing.B) {
+	for _, routes := range []int{10, 10000} {
+		for _, depth := range []int{4, 16, 64} {
+			trie := NewTrie[string]()
+			segments := make([]string, depth)
+			for i := range depth {
+				segments[i] = fmt.Sprintf("s%d", i)
+			}
+			trie.Insert("/"+strings.Join(segments, "/"), "deep")
+			for i := range routes {
+				trie.Insert(fmt.Sprintf("/route%d/{id}", i), "other")
+			}
+			path := "/" + strings.Join(segments, "/")
+
+			b.Run(fmt.Sprintf("routes=%d/depth=%d", routes, depth), func(b *testing.B) {
+				b.ReportAllocs()
+				for b.Loop() {
+					trie.FindMatches(path)
+				}
+			})
+		}
+	}
+}
@@ -52,3 +52,12 @@ func CorsMiddleware(next http.Handler, getConfig func() *CORSConfig) http.Handle
 		next.ServeHTTP(w, r)
 	})
 }
+
+// ValueOrDefault returns value when it is positive and def otherwise,
+// so zero config values fall back to their defaults.
+func ValueOrDefault[T ~int | ~int64](value, def T) T {
+	if value > 0 {
+		return value
+	}
+	return def
+}
@@ -0,0 +1,10 @@
+-- Migration: Remove upstream targets and load balancing from configs
+-- Version: 003
+-- Description: Drops the targets and load_balancer columns from the configs table
+
+ALTER TABLE configs ALTER COLUMN target_url DROP DEFAULT;
+
+ALTER TABLE configs DROP COLUMN IF EXISTS load_balancer;
+ALTER TABLE configs DROP COLUMN IF EXISTS targets;
+
+COMMENT ON COLUMN configs.target_url IS 'Target URL to proxy requests to';
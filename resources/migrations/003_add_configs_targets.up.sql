@@ -0,0 +1,13 @@
+-- Migration: Add upstream targets and load balancing to configs
+-- Version: 003
+-- Description: Allows a route to forward to multiple weighted targets using a load balancing policy
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS targets JSONB NOT NULL DEFAULT '[]'::jsonb;
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS load_balancer JSONB;
+
+-- target_url is optional when targets are provided
+ALTER TABLE configs ALTER COLUMN target_url SET DEFAULT '';
+
+COMMENT ON COLUMN configs.target_url IS 'Target URL to proxy requests to, used when targets is empty';
+COMMENT ON COLUMN configs.targets IS 'JSON array of upstream targets ({"url", "weight"}) to load balance across';
+COMMENT ON COLUMN configs.load_balancer IS 'JSON object with the load balancing policy and consistent hash settings';
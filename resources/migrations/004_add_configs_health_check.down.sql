@@ -0,0 +1,5 @@
+-- Migration: Remove active health checks from configs
+-- Version: 004
+-- Description: Drops the health_check column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS health_check;
@@ -0,0 +1,7 @@
+-- Migration: Add active health checks to configs
+-- Version: 004
+-- Description: Allows a route to probe its upstream targets and take unhealthy ones out of rotation
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS health_check JSONB;
+
+COMMENT ON COLUMN configs.health_check IS 'JSON object with the active health check path, interval, timeout, thresholds and expected status';
@@ -0,0 +1,5 @@
+-- Migration: Remove circuit breaking from configs
+-- Version: 005
+-- Description: Drops the circuit_breaker column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS circuit_breaker;
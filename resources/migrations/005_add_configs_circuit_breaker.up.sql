@@ -0,0 +1,7 @@
+-- Migration: Add circuit breaking to configs
+-- Version: 005
+-- Description: Allows a route to eject upstream targets that keep failing proxied requests
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS circuit_breaker JSONB;
+
+COMMENT ON COLUMN configs.circuit_breaker IS 'JSON object with the circuit breaker failure thresholds, window and ejection duration';
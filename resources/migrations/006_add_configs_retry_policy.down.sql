@@ -0,0 +1,5 @@
+-- Migration: Remove retry policies from configs
+-- Version: 006
+-- Description: Drops the retry_policy column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS retry_policy;
@@ -0,0 +1,7 @@
+-- Migration: Add retry policies to configs
+-- Version: 006
+-- Description: Allows a route to retry failed upstream attempts of idempotent requests
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS retry_policy JSONB;
+
+COMMENT ON COLUMN configs.retry_policy IS 'JSON object with the retry attempts, conditions, per-try timeout, backoff, budget and body buffering limit';
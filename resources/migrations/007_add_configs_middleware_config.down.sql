@@ -0,0 +1,5 @@
+-- Migration: Remove middleware config from configs
+-- Version: 007
+-- Description: Drops the middleware_config column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS middleware_config;
@@ -0,0 +1,7 @@
+-- Migration: Add middleware config to configs
+-- Version: 007
+-- Description: Stores the per-route config of the middlewares listed in the middleware column
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS middleware_config JSONB;
+
+COMMENT ON COLUMN configs.middleware_config IS 'JSON object with the config of each middleware by name';
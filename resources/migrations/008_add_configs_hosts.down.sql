@@ -0,0 +1,5 @@
+-- Migration: Remove hosts from configs
+-- Version: 008
+-- Description: Drops the hosts column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS hosts;
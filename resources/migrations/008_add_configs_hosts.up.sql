@@ -0,0 +1,7 @@
+-- Migration: Add hosts to configs
+-- Version: 008
+-- Description: Stores the hosts a route is served on, routes without hosts are served on any host
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS hosts JSONB;
+
+COMMENT ON COLUMN configs.hosts IS 'JSON array of exact or wildcard (*.example.com) hosts the route is served on';
@@ -0,0 +1,6 @@
+-- Migration: Remove match conditions and priority from configs
+-- Version: 009
+-- Description: Drops the match_conditions and priority columns from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS priority;
+ALTER TABLE configs DROP COLUMN IF EXISTS match_conditions;
@@ -0,0 +1,9 @@
+-- Migration: Add match conditions and priority to configs
+-- Version: 009
+-- Description: Lets several routes share a path prefix, told apart by request conditions and ordered by priority
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS match_conditions JSONB;
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS priority INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN configs.match_conditions IS 'JSON object with the methods, headers and query params a request must match';
+COMMENT ON COLUMN configs.priority IS 'Higher priority routes of the same path prefix are tried first';
@@ -0,0 +1,5 @@
+-- Migration: Remove rewrite from configs
+-- Version: 010
+-- Description: Drops the rewrite column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS rewrite;
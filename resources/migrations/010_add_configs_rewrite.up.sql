@@ -0,0 +1,7 @@
+-- Migration: Add rewrite to configs
+-- Version: 010
+-- Description: Stores the per-route rewrite of the path and host sent upstream
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS rewrite JSONB;
+
+COMMENT ON COLUMN configs.rewrite IS 'JSON object with the prefix, regex or path template rewrite and the upstream host';
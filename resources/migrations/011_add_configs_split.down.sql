@@ -0,0 +1,5 @@
+-- Migration: Remove traffic split from configs
+-- Version: 011
+-- Description: Drops the split column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS split;
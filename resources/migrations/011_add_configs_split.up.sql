@@ -0,0 +1,7 @@
+-- Migration: Add traffic split to configs
+-- Version: 011
+-- Description: Stores the weighted backend versions of a route for canary releases
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS split JSONB;
+
+COMMENT ON COLUMN configs.split IS 'JSON object with the weighted versions of the backend and their sticky assignment';
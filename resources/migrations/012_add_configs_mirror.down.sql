@@ -0,0 +1,5 @@
+-- Migration: Remove traffic mirror from configs
+-- Version: 012
+-- Description: Drops the mirror column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS mirror;
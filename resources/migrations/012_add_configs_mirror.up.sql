@@ -0,0 +1,7 @@
+-- Migration: Add traffic mirror to configs
+-- Version: 012
+-- Description: Stores the secondary upstream a route shadows a sample of its requests to
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS mirror JSONB;
+
+COMMENT ON COLUMN configs.mirror IS 'JSON object with the mirror target, sampling percentage, body limit and timeout';
@@ -0,0 +1,7 @@
+-- Migration: Drop api_keys table
+-- Version: 013
+-- Description: Drops the api_keys table and associated objects
+
+DROP TRIGGER IF EXISTS update_api_keys_updated_at ON api_keys;
+
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,30 @@
+-- Migration: Create api_keys table
+-- Version: 013
+-- Description: Creates the api_keys table for the keys machine clients authenticate with
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id          BIGSERIAL PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    prefix      VARCHAR(32) NOT NULL,
+    key_hash    CHAR(64) NOT NULL UNIQUE,
+    owner       VARCHAR(255) NOT NULL DEFAULT '',
+    scopes      JSONB NOT NULL DEFAULT '[]'::jsonb,
+    expires_at  TIMESTAMP WITH TIME ZONE,
+    revoked_at  TIMESTAMP WITH TIME ZONE,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Create trigger to automatically update updated_at timestamp
+CREATE TRIGGER update_api_keys_updated_at
+    BEFORE UPDATE ON api_keys
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE api_keys IS 'Stores the API keys machine clients authenticate with';
+COMMENT ON COLUMN api_keys.prefix IS 'First characters of the key, shown to tell keys apart';
+COMMENT ON COLUMN api_keys.key_hash IS 'Hex encoded SHA-256 of the key, the key itself is never stored';
+COMMENT ON COLUMN api_keys.owner IS 'Team or client the key was issued to';
+COMMENT ON COLUMN api_keys.scopes IS 'JSON array of the scopes granted to the key';
+COMMENT ON COLUMN api_keys.expires_at IS 'When the key expires, never when NULL';
+COMMENT ON COLUMN api_keys.revoked_at IS 'When the key was revoked, active when NULL';
@@ -0,0 +1,5 @@
+-- Migration: Remove identity propagation from configs
+-- Version: 014
+-- Description: Drops the identity column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS identity;
@@ -0,0 +1,7 @@
+-- Migration: Add identity propagation to configs
+-- Version: 014
+-- Description: Stores which claims and static headers a route sends upstream and whether it forwards the token
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS identity JSONB;
+
+COMMENT ON COLUMN configs.identity IS 'JSON object with the claim headers, static headers and token forwarding of the route';
@@ -0,0 +1,5 @@
+-- Migration: Remove rate limiting from configs
+-- Version: 015
+-- Description: Drops the rate_limit column from the configs table
+
+ALTER TABLE configs DROP COLUMN IF EXISTS rate_limit;
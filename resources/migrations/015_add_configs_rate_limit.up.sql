@@ -0,0 +1,7 @@
+-- Migration: Add rate limiting to configs
+-- Version: 015
+-- Description: Stores the rate limit of a route
+
+ALTER TABLE configs ADD COLUMN IF NOT EXISTS rate_limit JSONB;
+
+COMMENT ON COLUMN configs.rate_limit IS 'JSON object with the algorithm, requests, window, burst and key of the rate limit of the route';
@@ -4,9 +4,11 @@ import { BrowserRouter, Routes, Route, Navigate } from 'react-router-dom'
 import DashboardIcon from '@mui/icons-material/Dashboard'
 import RouteIcon from '@mui/icons-material/AltRoute'
 import SettingsIcon from '@mui/icons-material/Settings'
+import KeyIcon from '@mui/icons-material/VpnKey'
 import { DashboardPage } from './pages/dashboard/DashboardPage'
 import { RoutesPage } from './pages/routes/RoutesPage'
 import { SettingsPage } from './pages/settings'
+import { APIKeysPage } from './pages/api-keys'
 import { authService, sessionManager } from './services'
 
 const LOGIN_URL = import.meta.env.VITE_LOGIN_URL as string
@@ -36,6 +38,12 @@ function App() {
       path: '/gateway/routes',
       icon: <RouteIcon />,
     },
+    {
+      id: 'api-keys',
+      label: 'API Keys',
+      path: '/gateway/api-keys',
+      icon: <KeyIcon />,
+    },
     {
       id: 'settings',
       label: 'Settings',
@@ -67,6 +75,7 @@ function App() {
             >
               <Route path="gateway/dashboard" element={<DashboardPage />} />
               <Route path="gateway/routes" element={<RoutesPage />} />
+              <Route path="gateway/api-keys" element={<APIKeysPage />} />
               <Route path="gateway/settings" element={<SettingsPage />} />
               <Route path="*" element={<Navigate to="/gateway/dashboard" replace />} />
             </Route>
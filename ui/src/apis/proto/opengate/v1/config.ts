@@ -6,13 +6,35 @@
 
 /* eslint-disable */
 import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
+import { Struct } from "../../../google/protobuf/struct";
 
 export const protobufPackage = "opengate.v1";
 
+/** ClaimRequirement is a predicate on a claim of the token */
+export interface ClaimRequirement {
+  /** Claim name, nested claims are joined by dots, e.g. org.tier */
+  name: string;
+  /** exists, equals (default), not_equals or contains */
+  operator: string;
+  values: string[];
+}
+
+/** Requirements are what an authenticated client must have, it's denied with a 403 otherwise */
+export interface Requirements {
+  /** All of them are required */
+  permissions: string[];
+  /** Profile IDs, one of them is required */
+  profiles: string[];
+  /** All of them must hold */
+  claims: ClaimRequirement[];
+}
+
 /** AuthenticationException defines paths/methods excepted from authentication rules */
 export interface AuthenticationException {
   path: string;
   methods: string[];
+  /** Always authenticates the paths/methods, with these requirements instead of the route's */
+  require: Requirements | undefined;
 }
 
 /** Authentication defines authentication settings for a route */
@@ -23,6 +45,183 @@ export interface Authentication {
    * if required is false, excepted paths/methods require authentication
    */
   except: AuthenticationException[];
+  /** Auth strategies tried in order, the gateway default when empty */
+  strategies: string[];
+  /** any (default) or all of the strategies must authenticate */
+  mode: string;
+  /** What authenticated clients must have */
+  require: Requirements | undefined;
+}
+
+/** Target is an upstream instance requests can be forwarded to */
+export interface Target {
+  url: string;
+  /** Relative weight, defaults to 1 */
+  weight: number;
+}
+
+/** RouteVersion is a version of a route's backend receiving a weighted share of its requests */
+export interface RouteVersion {
+  name: string;
+  /** Share of the requests relative to the other versions, 0 drains the version */
+  weight: number;
+  targets: Target[];
+}
+
+/** TrafficSplit splits the requests of a route between weighted versions of its backend */
+export interface TrafficSplit {
+  versions: RouteVersion[];
+  /** header, cookie or client_ip pinning a client to a version, random when empty */
+  stickyOn: string;
+  /** Header or cookie name */
+  stickyKey: string;
+}
+
+/** LoadBalancer defines how requests are spread across a route's targets */
+export interface LoadBalancer {
+  /** round_robin (default), weighted_round_robin, least_connections, random_two_choices or consistent_hash */
+  policy: string;
+  /** consistent_hash only: header, cookie or client_ip */
+  hashOn: string;
+  /** Header or cookie name to hash on */
+  hashKey: string;
+}
+
+/** HealthCheck defines the active health check probing each target of a route */
+export interface HealthCheck {
+  /** HTTP path probed with GET on every target */
+  path: string;
+  /** Interval in nanoseconds, default 10s */
+  interval: string;
+  /** Timeout in nanoseconds, default 2s */
+  timeout: string;
+  /** Consecutive successes to mark a target healthy, default 2 */
+  healthyThreshold: number;
+  /** Consecutive failures to mark a target unhealthy, default 3 */
+  unhealthyThreshold: number;
+  /** Expected response status, any 2xx if not set */
+  expectedStatus: number;
+}
+
+/** CircuitBreaker defines the passive outlier detection of the targets of a route */
+export interface CircuitBreaker {
+  /** Consecutive failures that open the breaker, 0 disables */
+  consecutiveFailures: number;
+  /** Failure percentage within the window that opens the breaker, 0 disables */
+  errorRateThreshold: number;
+  /** Requests within the window before the error rate is evaluated, default 20 */
+  minRequests: number;
+  /** Error rate window in nanoseconds, default 10s */
+  window: string;
+  /** How long the breaker stays open in nanoseconds, default 30s */
+  ejectionDuration: string;
+  /** Successful probe requests needed to close again, default 1 */
+  halfOpenRequests: number;
+}
+
+/** RetryPolicy defines when failed upstream attempts of a route are retried */
+export interface RetryPolicy {
+  /** Total attempts including the first one, default 2 */
+  attempts: number;
+  /** connect-failure, reset, timeout, 5xx, gateway-error or status codes such as "503" */
+  retryOn: string[];
+  /** How long each attempt may wait for the response headers in nanoseconds */
+  perTryTimeout: string;
+  /** Backoff before the first retry in nanoseconds, default 25ms */
+  backoffBase: string;
+  /** Upper bound of the backoff in nanoseconds, default 250ms */
+  backoffMax: string;
+  /** Retries allowed as a percentage of the route's requests, default 20 */
+  budget: number;
+  /** Larger request bodies are not retried, default 64KB */
+  maxBodyBytes: string;
+  /** Methods such as POST to retry besides the idempotent ones */
+  idempotentMethods: string[];
+}
+
+/** HeaderMatch matches a request header by exact value, regex or presence */
+export interface HeaderMatch {
+  name: string;
+  exact: string;
+  /** Matched against the whole value */
+  regex: string;
+  present: boolean;
+}
+
+/** QueryParamMatch matches a query parameter by exact value or presence */
+export interface QueryParamMatch {
+  name: string;
+  exact: string;
+  present: boolean;
+}
+
+/** RouteMatch holds the conditions a request must meet besides the path prefix */
+export interface RouteMatch {
+  /** Any method when empty */
+  methods: string[];
+  headers: HeaderMatch[];
+  queryParams: QueryParamMatch[];
+}
+
+/** Rewrite defines how the path and host sent upstream are rewritten */
+export interface Rewrite {
+  /** Replaces the matched part of the path, may use {params} */
+  prefix: string;
+  /** Rewrites the path matching it with replacement */
+  regex: string;
+  /** May use the capture groups as $1 or ${name} */
+  replacement: string;
+  /** Replaces the whole path, may use {params} */
+  path: string;
+  /** Host header sent upstream, may use {params} */
+  host: string;
+}
+
+/** Mirror shadows a sample of the requests of a route to a secondary upstream */
+export interface Mirror {
+  targetUrl: string;
+  /** Share of the requests mirrored, default 100 */
+  percentage: number;
+  /** Requests with larger bodies are not mirrored, default 64KB */
+  maxBodyBytes: string;
+  /** Timeout of the mirrored requests in nanoseconds, default 5s */
+  timeout: string;
+}
+
+/** ClaimHeader sends a claim of the client upstream as a header */
+export interface ClaimHeader {
+  /** Nested claims are joined by dots */
+  claim: string;
+  header: string;
+}
+
+/** Identity tells how the identity of a route's clients is sent upstream */
+export interface Identity {
+  claimHeaders: ClaimHeader[];
+  /** Don't send the user id, uuid, profile ids and permissions headers */
+  omitDefaultHeaders: boolean;
+  /** Send the client's Authorization header instead of stripping it */
+  forwardToken: boolean;
+  /** Headers set on every request of the route */
+  staticHeaders: KeyValue[];
+  /** Send a JWT minted by the gateway holding the verified claims */
+  internalToken: boolean;
+}
+
+/** RateLimit limits the requests the clients of a route send, each client being counted by its key */
+export interface RateLimit {
+  /** token_bucket (default) or sliding_window */
+  algorithm: string;
+  /** Requests allowed per window */
+  requests: string;
+  /** Window in nanoseconds, default 1s */
+  window: string;
+  /** token_bucket only, requests allowed at once, default requests */
+  burst: string;
+  /** client_ip (default), user_id, api_key or header */
+  key: string;
+  /** Header the key is read from with the header key */
+  header: string;
 }
 
 /** Config represents a service route configuration */
@@ -40,18 +239,58 @@ export interface Config {
   createdAt: string;
   /** Unix timestamp */
   updatedAt: string;
+  /** Takes precedence over target_url when set */
+  targets: Target[];
+  loadBalancer: LoadBalancer | undefined;
+  healthCheck: HealthCheck | undefined;
+  circuitBreaker: CircuitBreaker | undefined;
+  retryPolicy: RetryPolicy | undefined;
+  /** Config of the middlewares by name */
+  middlewareConfig: { [key: string]: any } | undefined;
+  /** Exact or wildcard (*.example.com) hosts, any host when empty */
+  hosts: string[];
+  match: RouteMatch | undefined;
+  /** Higher priority routes of the same prefix are tried first */
+  priority: number;
+  /** Can't be combined with strip_prefix */
+  rewrite: Rewrite | undefined;
+  /** Takes precedence over target_url and targets */
+  split: TrafficSplit | undefined;
+  mirror: Mirror | undefined;
+  identity: Identity | undefined;
+  rateLimit: RateLimit | undefined;
 }
 
 /** CreateConfigRequest is the request to create a new config */
 export interface CreateConfigRequest {
   name: string;
   pathPrefix: string;
+  /** Required unless targets or split are provided */
   targetUrl: string;
   stripPrefix: boolean;
   authentication: Authentication | undefined;
   middleware: string[];
   /** Timeout in nanoseconds, default 30s if not provided */
   timeout: string;
+  targets: Target[];
+  loadBalancer: LoadBalancer | undefined;
+  healthCheck: HealthCheck | undefined;
+  circuitBreaker: CircuitBreaker | undefined;
+  retryPolicy: RetryPolicy | undefined;
+  /** Config of the middlewares by name */
+  middlewareConfig: { [key: string]: any } | undefined;
+  /** Exact or wildcard (*.example.com) hosts, any host when empty */
+  hosts: string[];
+  match: RouteMatch | undefined;
+  /** Higher priority routes of the same prefix are tried first */
+  priority: number;
+  /** Can't be combined with strip_prefix */
+  rewrite: Rewrite | undefined;
+  /** Takes precedence over target_url and targets */
+  split: TrafficSplit | undefined;
+  mirror: Mirror | undefined;
+  identity: Identity | undefined;
+  rateLimit: RateLimit | undefined;
 }
 
 /** CreateConfigResponse is the response after creating a config */
@@ -101,6 +340,25 @@ export interface Route {
   middleware: string[];
   timeout: string;
   updatedAt: string;
+  targets: Target[];
+  loadBalancer: LoadBalancer | undefined;
+  healthCheck: HealthCheck | undefined;
+  circuitBreaker: CircuitBreaker | undefined;
+  retryPolicy: RetryPolicy | undefined;
+  /** Config of the middlewares by name */
+  middlewareConfig: { [key: string]: any } | undefined;
+  /** Exact or wildcard (*.example.com) hosts, any host when empty */
+  hosts: string[];
+  match: RouteMatch | undefined;
+  /** Higher priority routes of the same prefix are tried first */
+  priority: number;
+  /** Can't be combined with strip_prefix */
+  rewrite: Rewrite | undefined;
+  /** Takes precedence over target_url and targets */
+  split: TrafficSplit | undefined;
+  mirror: Mirror | undefined;
+  identity: Identity | undefined;
+  rateLimit: RateLimit | undefined;
 }
 
 /** GetRoutesResponse contains all routes for the routing manager */
@@ -109,16 +367,68 @@ export interface GetRoutesResponse {
   message: string;
 }
 
+/** KeyValue is a named value such as a header or a path parameter */
+export interface KeyValue {
+  key: string;
+  value: string;
+}
+
+/** TestRouteRequest describes a request to run through the routing table */
+export interface TestRouteRequest {
+  method: string;
+  host: string;
+  /** Path with an optional query string */
+  path: string;
+  headers: KeyValue[];
+}
+
+/** TestRouteResponse tells how the gateway would route the request */
+export interface TestRouteResponse {
+  matched: boolean;
+  /** Name of the selected route */
+  route: string;
+  pathParams: KeyValue[];
+  /** Part of the path matched by the route's pattern */
+  matchedPrefix: string;
+  /** Path sent upstream after strip_prefix and rewrite */
+  upstreamPath: string;
+  /** Host header sent upstream, empty to keep the client's */
+  upstreamHost: string;
+  /** Upstream targets the request may be sent to */
+  targets: string[];
+  message: string;
+}
+
 /** UpdateConfigRequest is the request to update an existing config */
 export interface UpdateConfigRequest {
   id: string;
   name: string;
   pathPrefix: string;
+  /** Required unless targets or split are provided */
   targetUrl: string;
   stripPrefix: boolean;
   authentication: Authentication | undefined;
   middleware: string[];
   timeout: string;
+  targets: Target[];
+  loadBalancer: LoadBalancer | undefined;
+  healthCheck: HealthCheck | undefined;
+  circuitBreaker: CircuitBreaker | undefined;
+  retryPolicy: RetryPolicy | undefined;
+  /** Config of the middlewares by name */
+  middlewareConfig: { [key: string]: any } | undefined;
+  /** Exact or wildcard (*.example.com) hosts, any host when empty */
+  hosts: string[];
+  match: RouteMatch | undefined;
+  /** Higher priority routes of the same prefix are tried first */
+  priority: number;
+  /** Can't be combined with strip_prefix */
+  rewrite: Rewrite | undefined;
+  /** Takes precedence over target_url and targets */
+  split: TrafficSplit | undefined;
+  mirror: Mirror | undefined;
+  identity: Identity | undefined;
+  rateLimit: RateLimit | undefined;
 }
 
 /** UpdateConfigResponse is the response after updating a config */
@@ -127,6 +437,25 @@ export interface UpdateConfigResponse {
   message: string;
 }
 
+/** VersionWeight is the new weight of a version of a split route */
+export interface VersionWeight {
+  version: string;
+  weight: number;
+}
+
+/** SetRouteWeightsRequest shifts the traffic of a split route between its versions */
+export interface SetRouteWeightsRequest {
+  id: string;
+  /** Versions left out keep their weight */
+  weights: VersionWeight[];
+}
+
+/** SetRouteWeightsResponse is the response after shifting the weights */
+export interface SetRouteWeightsResponse {
+  config: Config | undefined;
+  message: string;
+}
+
 /** DeleteConfigRequest is the request to delete a config */
 export interface DeleteConfigRequest {
   id: string;
@@ -145,10 +474,214 @@ export interface GetStatsRequest {
 export interface GetStatsResponse {
   totalRoutes: number;
   message: string;
+  /** Targets of health checked routes currently in rotation */
+  healthyTargets: number;
+  /** Targets of health checked routes removed from rotation */
+  unhealthyTargets: number;
+}
+
+function createBaseClaimRequirement(): ClaimRequirement {
+  return { name: "", operator: "", values: [] };
+}
+
+export const ClaimRequirement: MessageFns<ClaimRequirement> = {
+  encode(message: ClaimRequirement, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.operator !== "") {
+      writer.uint32(18).string(message.operator);
+    }
+    for (const v of message.values) {
+      writer.uint32(26).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ClaimRequirement {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseClaimRequirement();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.operator = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.values.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ClaimRequirement {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      operator: isSet(object.operator) ? globalThis.String(object.operator) : "",
+      values: globalThis.Array.isArray(object?.values) ? object.values.map((e: any) => globalThis.String(e)) : [],
+    };
+  },
+
+  toJSON(message: ClaimRequirement): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.operator !== "") {
+      obj.operator = message.operator;
+    }
+    if (message.values?.length) {
+      obj.values = message.values;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ClaimRequirement>, I>>(base?: I): ClaimRequirement {
+    return ClaimRequirement.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ClaimRequirement>, I>>(object: I): ClaimRequirement {
+    const message = createBaseClaimRequirement();
+    message.name = object.name ?? "";
+    message.operator = object.operator ?? "";
+    message.values = object.values?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseRequirements(): Requirements {
+  return { permissions: [], profiles: [], claims: [] };
 }
 
+export const Requirements: MessageFns<Requirements> = {
+  encode(message: Requirements, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.permissions) {
+      writer.uint32(10).string(v!);
+    }
+    writer.uint32(18).fork();
+    for (const v of message.profiles) {
+      writer.int64(v);
+    }
+    writer.join();
+    for (const v of message.claims) {
+      ClaimRequirement.encode(v!, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Requirements {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRequirements();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.permissions.push(reader.string());
+          continue;
+        }
+        case 2: {
+          if (tag === 16) {
+            message.profiles.push(reader.int64().toString());
+
+            continue;
+          }
+
+          if (tag === 18) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.profiles.push(reader.int64().toString());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.claims.push(ClaimRequirement.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Requirements {
+    return {
+      permissions: globalThis.Array.isArray(object?.permissions)
+        ? object.permissions.map((e: any) => globalThis.String(e))
+        : [],
+      profiles: globalThis.Array.isArray(object?.profiles) ? object.profiles.map((e: any) => globalThis.String(e)) : [],
+      claims: globalThis.Array.isArray(object?.claims)
+        ? object.claims.map((e: any) => ClaimRequirement.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: Requirements): unknown {
+    const obj: any = {};
+    if (message.permissions?.length) {
+      obj.permissions = message.permissions;
+    }
+    if (message.profiles?.length) {
+      obj.profiles = message.profiles;
+    }
+    if (message.claims?.length) {
+      obj.claims = message.claims.map((e) => ClaimRequirement.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Requirements>, I>>(base?: I): Requirements {
+    return Requirements.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Requirements>, I>>(object: I): Requirements {
+    const message = createBaseRequirements();
+    message.permissions = object.permissions?.map((e) => e) || [];
+    message.profiles = object.profiles?.map((e) => e) || [];
+    message.claims = object.claims?.map((e) => ClaimRequirement.fromPartial(e)) || [];
+    return message;
+  },
+};
+
 function createBaseAuthenticationException(): AuthenticationException {
-  return { path: "", methods: [] };
+  return { path: "", methods: [], require: undefined };
 }
 
 export const AuthenticationException: MessageFns<AuthenticationException> = {
@@ -159,6 +692,9 @@ export const AuthenticationException: MessageFns<AuthenticationException> = {
     for (const v of message.methods) {
       writer.uint32(18).string(v!);
     }
+    if (message.require !== undefined) {
+      Requirements.encode(message.require, writer.uint32(26).fork()).join();
+    }
     return writer;
   },
 
@@ -185,6 +721,14 @@ export const AuthenticationException: MessageFns<AuthenticationException> = {
           message.methods.push(reader.string());
           continue;
         }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.require = Requirements.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -198,6 +742,7 @@ export const AuthenticationException: MessageFns<AuthenticationException> = {
     return {
       path: isSet(object.path) ? globalThis.String(object.path) : "",
       methods: globalThis.Array.isArray(object?.methods) ? object.methods.map((e: any) => globalThis.String(e)) : [],
+      require: isSet(object.require) ? Requirements.fromJSON(object.require) : undefined,
     };
   },
 
@@ -209,6 +754,9 @@ export const AuthenticationException: MessageFns<AuthenticationException> = {
     if (message.methods?.length) {
       obj.methods = message.methods;
     }
+    if (message.require !== undefined) {
+      obj.require = Requirements.toJSON(message.require);
+    }
     return obj;
   },
 
@@ -219,12 +767,15 @@ export const AuthenticationException: MessageFns<AuthenticationException> = {
     const message = createBaseAuthenticationException();
     message.path = object.path ?? "";
     message.methods = object.methods?.map((e) => e) || [];
+    message.require = (object.require !== undefined && object.require !== null)
+      ? Requirements.fromPartial(object.require)
+      : undefined;
     return message;
   },
 };
 
 function createBaseAuthentication(): Authentication {
-  return { required: false, except: [] };
+  return { required: false, except: [], strategies: [], mode: "", require: undefined };
 }
 
 export const Authentication: MessageFns<Authentication> = {
@@ -235,6 +786,15 @@ export const Authentication: MessageFns<Authentication> = {
     for (const v of message.except) {
       AuthenticationException.encode(v!, writer.uint32(18).fork()).join();
     }
+    for (const v of message.strategies) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.mode !== "") {
+      writer.uint32(34).string(message.mode);
+    }
+    if (message.require !== undefined) {
+      Requirements.encode(message.require, writer.uint32(42).fork()).join();
+    }
     return writer;
   },
 
@@ -261,6 +821,30 @@ export const Authentication: MessageFns<Authentication> = {
           message.except.push(AuthenticationException.decode(reader, reader.uint32()));
           continue;
         }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.strategies.push(reader.string());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.mode = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.require = Requirements.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -276,6 +860,11 @@ export const Authentication: MessageFns<Authentication> = {
       except: globalThis.Array.isArray(object?.except)
         ? object.except.map((e: any) => AuthenticationException.fromJSON(e))
         : [],
+      strategies: globalThis.Array.isArray(object?.strategies)
+        ? object.strategies.map((e: any) => globalThis.String(e))
+        : [],
+      mode: isSet(object.mode) ? globalThis.String(object.mode) : "",
+      require: isSet(object.require) ? Requirements.fromJSON(object.require) : undefined,
     };
   },
 
@@ -287,6 +876,15 @@ export const Authentication: MessageFns<Authentication> = {
     if (message.except?.length) {
       obj.except = message.except.map((e) => AuthenticationException.toJSON(e));
     }
+    if (message.strategies?.length) {
+      obj.strategies = message.strategies;
+    }
+    if (message.mode !== "") {
+      obj.mode = message.mode;
+    }
+    if (message.require !== undefined) {
+      obj.require = Requirements.toJSON(message.require);
+    }
     return obj;
   },
 
@@ -297,73 +895,214 @@ export const Authentication: MessageFns<Authentication> = {
     const message = createBaseAuthentication();
     message.required = object.required ?? false;
     message.except = object.except?.map((e) => AuthenticationException.fromPartial(e)) || [];
+    message.strategies = object.strategies?.map((e) => e) || [];
+    message.mode = object.mode ?? "";
+    message.require = (object.require !== undefined && object.require !== null)
+      ? Requirements.fromPartial(object.require)
+      : undefined;
     return message;
   },
 };
 
-function createBaseConfig(): Config {
-  return {
-    id: "0",
-    name: "",
-    pathPrefix: "",
-    targetUrl: "",
-    stripPrefix: false,
-    authentication: undefined,
-    middleware: [],
-    timeout: "0",
-    createdAt: "0",
-    updatedAt: "0",
-  };
+function createBaseTarget(): Target {
+  return { url: "", weight: 0 };
 }
 
-export const Config: MessageFns<Config> = {
-  encode(message: Config, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== "0") {
-      writer.uint32(8).int64(message.id);
-    }
-    if (message.name !== "") {
-      writer.uint32(18).string(message.name);
+export const Target: MessageFns<Target> = {
+  encode(message: Target, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.url !== "") {
+      writer.uint32(10).string(message.url);
     }
-    if (message.pathPrefix !== "") {
-      writer.uint32(26).string(message.pathPrefix);
-    }
-    if (message.targetUrl !== "") {
-      writer.uint32(34).string(message.targetUrl);
-    }
-    if (message.stripPrefix !== false) {
-      writer.uint32(40).bool(message.stripPrefix);
+    if (message.weight !== 0) {
+      writer.uint32(16).int32(message.weight);
     }
-    if (message.authentication !== undefined) {
-      Authentication.encode(message.authentication, writer.uint32(50).fork()).join();
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Target {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTarget();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.weight = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
     }
-    for (const v of message.middleware) {
-      writer.uint32(58).string(v!);
+    return message;
+  },
+
+  fromJSON(object: any): Target {
+    return {
+      url: isSet(object.url) ? globalThis.String(object.url) : "",
+      weight: isSet(object.weight) ? globalThis.Number(object.weight) : 0,
+    };
+  },
+
+  toJSON(message: Target): unknown {
+    const obj: any = {};
+    if (message.url !== "") {
+      obj.url = message.url;
     }
-    if (message.timeout !== "0") {
-      writer.uint32(64).int64(message.timeout);
+    if (message.weight !== 0) {
+      obj.weight = Math.round(message.weight);
     }
-    if (message.createdAt !== "0") {
-      writer.uint32(72).int64(message.createdAt);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Target>, I>>(base?: I): Target {
+    return Target.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Target>, I>>(object: I): Target {
+    const message = createBaseTarget();
+    message.url = object.url ?? "";
+    message.weight = object.weight ?? 0;
+    return message;
+  },
+};
+
+function createBaseRouteVersion(): RouteVersion {
+  return { name: "", weight: 0, targets: [] };
+}
+
+export const RouteVersion: MessageFns<RouteVersion> = {
+  encode(message: RouteVersion, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.updatedAt !== "0") {
-      writer.uint32(80).int64(message.updatedAt);
+    if (message.weight !== 0) {
+      writer.uint32(16).int32(message.weight);
+    }
+    for (const v of message.targets) {
+      Target.encode(v!, writer.uint32(26).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Config {
+  decode(input: BinaryReader | Uint8Array, length?: number): RouteVersion {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseConfig();
+    const message = createBaseRouteVersion();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = reader.int64().toString();
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.weight = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.targets.push(Target.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RouteVersion {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      weight: isSet(object.weight) ? globalThis.Number(object.weight) : 0,
+      targets: globalThis.Array.isArray(object?.targets) ? object.targets.map((e: any) => Target.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: RouteVersion): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.weight !== 0) {
+      obj.weight = Math.round(message.weight);
+    }
+    if (message.targets?.length) {
+      obj.targets = message.targets.map((e) => Target.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<RouteVersion>, I>>(base?: I): RouteVersion {
+    return RouteVersion.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<RouteVersion>, I>>(object: I): RouteVersion {
+    const message = createBaseRouteVersion();
+    message.name = object.name ?? "";
+    message.weight = object.weight ?? 0;
+    message.targets = object.targets?.map((e) => Target.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTrafficSplit(): TrafficSplit {
+  return { versions: [], stickyOn: "", stickyKey: "" };
+}
+
+export const TrafficSplit: MessageFns<TrafficSplit> = {
+  encode(message: TrafficSplit, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.versions) {
+      RouteVersion.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.stickyOn !== "") {
+      writer.uint32(18).string(message.stickyOn);
+    }
+    if (message.stickyKey !== "") {
+      writer.uint32(26).string(message.stickyKey);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TrafficSplit {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrafficSplit();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.versions.push(RouteVersion.decode(reader, reader.uint32()));
           continue;
         }
         case 2: {
@@ -371,7 +1110,7 @@ export const Config: MessageFns<Config> = {
             break;
           }
 
-          message.name = reader.string();
+          message.stickyOn = reader.string();
           continue;
         }
         case 3: {
@@ -379,65 +1118,2971 @@ export const Config: MessageFns<Config> = {
             break;
           }
 
-          message.pathPrefix = reader.string();
+          message.stickyKey = reader.string();
           continue;
         }
-        case 4: {
-          if (tag !== 34) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrafficSplit {
+    return {
+      versions: globalThis.Array.isArray(object?.versions)
+        ? object.versions.map((e: any) => RouteVersion.fromJSON(e))
+        : [],
+      stickyOn: isSet(object.stickyOn)
+        ? globalThis.String(object.stickyOn)
+        : isSet(object.sticky_on)
+        ? globalThis.String(object.sticky_on)
+        : "",
+      stickyKey: isSet(object.stickyKey)
+        ? globalThis.String(object.stickyKey)
+        : isSet(object.sticky_key)
+        ? globalThis.String(object.sticky_key)
+        : "",
+    };
+  },
+
+  toJSON(message: TrafficSplit): unknown {
+    const obj: any = {};
+    if (message.versions?.length) {
+      obj.versions = message.versions.map((e) => RouteVersion.toJSON(e));
+    }
+    if (message.stickyOn !== "") {
+      obj.stickyOn = message.stickyOn;
+    }
+    if (message.stickyKey !== "") {
+      obj.stickyKey = message.stickyKey;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrafficSplit>, I>>(base?: I): TrafficSplit {
+    return TrafficSplit.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrafficSplit>, I>>(object: I): TrafficSplit {
+    const message = createBaseTrafficSplit();
+    message.versions = object.versions?.map((e) => RouteVersion.fromPartial(e)) || [];
+    message.stickyOn = object.stickyOn ?? "";
+    message.stickyKey = object.stickyKey ?? "";
+    return message;
+  },
+};
+
+function createBaseLoadBalancer(): LoadBalancer {
+  return { policy: "", hashOn: "", hashKey: "" };
+}
+
+export const LoadBalancer: MessageFns<LoadBalancer> = {
+  encode(message: LoadBalancer, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.policy !== "") {
+      writer.uint32(10).string(message.policy);
+    }
+    if (message.hashOn !== "") {
+      writer.uint32(18).string(message.hashOn);
+    }
+    if (message.hashKey !== "") {
+      writer.uint32(26).string(message.hashKey);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): LoadBalancer {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLoadBalancer();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
             break;
           }
 
-          message.targetUrl = reader.string();
+          message.policy = reader.string();
           continue;
         }
-        case 5: {
-          if (tag !== 40) {
+        case 2: {
+          if (tag !== 18) {
             break;
           }
 
-          message.stripPrefix = reader.bool();
+          message.hashOn = reader.string();
           continue;
         }
-        case 6: {
-          if (tag !== 50) {
+        case 3: {
+          if (tag !== 26) {
             break;
           }
 
-          message.authentication = Authentication.decode(reader, reader.uint32());
+          message.hashKey = reader.string();
           continue;
         }
-        case 7: {
-          if (tag !== 58) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LoadBalancer {
+    return {
+      policy: isSet(object.policy) ? globalThis.String(object.policy) : "",
+      hashOn: isSet(object.hashOn)
+        ? globalThis.String(object.hashOn)
+        : isSet(object.hash_on)
+        ? globalThis.String(object.hash_on)
+        : "",
+      hashKey: isSet(object.hashKey)
+        ? globalThis.String(object.hashKey)
+        : isSet(object.hash_key)
+        ? globalThis.String(object.hash_key)
+        : "",
+    };
+  },
+
+  toJSON(message: LoadBalancer): unknown {
+    const obj: any = {};
+    if (message.policy !== "") {
+      obj.policy = message.policy;
+    }
+    if (message.hashOn !== "") {
+      obj.hashOn = message.hashOn;
+    }
+    if (message.hashKey !== "") {
+      obj.hashKey = message.hashKey;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<LoadBalancer>, I>>(base?: I): LoadBalancer {
+    return LoadBalancer.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<LoadBalancer>, I>>(object: I): LoadBalancer {
+    const message = createBaseLoadBalancer();
+    message.policy = object.policy ?? "";
+    message.hashOn = object.hashOn ?? "";
+    message.hashKey = object.hashKey ?? "";
+    return message;
+  },
+};
+
+function createBaseHealthCheck(): HealthCheck {
+  return { path: "", interval: "0", timeout: "0", healthyThreshold: 0, unhealthyThreshold: 0, expectedStatus: 0 };
+}
+
+export const HealthCheck: MessageFns<HealthCheck> = {
+  encode(message: HealthCheck, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.path !== "") {
+      writer.uint32(10).string(message.path);
+    }
+    if (message.interval !== "0") {
+      writer.uint32(16).int64(message.interval);
+    }
+    if (message.timeout !== "0") {
+      writer.uint32(24).int64(message.timeout);
+    }
+    if (message.healthyThreshold !== 0) {
+      writer.uint32(32).int32(message.healthyThreshold);
+    }
+    if (message.unhealthyThreshold !== 0) {
+      writer.uint32(40).int32(message.unhealthyThreshold);
+    }
+    if (message.expectedStatus !== 0) {
+      writer.uint32(48).int32(message.expectedStatus);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): HealthCheck {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHealthCheck();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
             break;
           }
 
-          message.middleware.push(reader.string());
+          message.path = reader.string();
           continue;
         }
-        case 8: {
-          if (tag !== 64) {
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.interval = reader.int64().toString();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
             break;
           }
 
           message.timeout = reader.int64().toString();
           continue;
         }
-        case 9: {
-          if (tag !== 72) {
+        case 4: {
+          if (tag !== 32) {
             break;
           }
 
-          message.createdAt = reader.int64().toString();
-          continue;
-        }
-        case 10: {
-          if (tag !== 80) {
-            break;
-          }
+          message.healthyThreshold = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.unhealthyThreshold = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.expectedStatus = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): HealthCheck {
+    return {
+      path: isSet(object.path) ? globalThis.String(object.path) : "",
+      interval: isSet(object.interval) ? globalThis.String(object.interval) : "0",
+      timeout: isSet(object.timeout) ? globalThis.String(object.timeout) : "0",
+      healthyThreshold: isSet(object.healthyThreshold)
+        ? globalThis.Number(object.healthyThreshold)
+        : isSet(object.healthy_threshold)
+        ? globalThis.Number(object.healthy_threshold)
+        : 0,
+      unhealthyThreshold: isSet(object.unhealthyThreshold)
+        ? globalThis.Number(object.unhealthyThreshold)
+        : isSet(object.unhealthy_threshold)
+        ? globalThis.Number(object.unhealthy_threshold)
+        : 0,
+      expectedStatus: isSet(object.expectedStatus)
+        ? globalThis.Number(object.expectedStatus)
+        : isSet(object.expected_status)
+        ? globalThis.Number(object.expected_status)
+        : 0,
+    };
+  },
+
+  toJSON(message: HealthCheck): unknown {
+    const obj: any = {};
+    if (message.path !== "") {
+      obj.path = message.path;
+    }
+    if (message.interval !== "0") {
+      obj.interval = message.interval;
+    }
+    if (message.timeout !== "0") {
+      obj.timeout = message.timeout;
+    }
+    if (message.healthyThreshold !== 0) {
+      obj.healthyThreshold = Math.round(message.healthyThreshold);
+    }
+    if (message.unhealthyThreshold !== 0) {
+      obj.unhealthyThreshold = Math.round(message.unhealthyThreshold);
+    }
+    if (message.expectedStatus !== 0) {
+      obj.expectedStatus = Math.round(message.expectedStatus);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HealthCheck>, I>>(base?: I): HealthCheck {
+    return HealthCheck.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<HealthCheck>, I>>(object: I): HealthCheck {
+    const message = createBaseHealthCheck();
+    message.path = object.path ?? "";
+    message.interval = object.interval ?? "0";
+    message.timeout = object.timeout ?? "0";
+    message.healthyThreshold = object.healthyThreshold ?? 0;
+    message.unhealthyThreshold = object.unhealthyThreshold ?? 0;
+    message.expectedStatus = object.expectedStatus ?? 0;
+    return message;
+  },
+};
+
+function createBaseCircuitBreaker(): CircuitBreaker {
+  return {
+    consecutiveFailures: 0,
+    errorRateThreshold: 0,
+    minRequests: 0,
+    window: "0",
+    ejectionDuration: "0",
+    halfOpenRequests: 0,
+  };
+}
+
+export const CircuitBreaker: MessageFns<CircuitBreaker> = {
+  encode(message: CircuitBreaker, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.consecutiveFailures !== 0) {
+      writer.uint32(8).int32(message.consecutiveFailures);
+    }
+    if (message.errorRateThreshold !== 0) {
+      writer.uint32(16).int32(message.errorRateThreshold);
+    }
+    if (message.minRequests !== 0) {
+      writer.uint32(24).int32(message.minRequests);
+    }
+    if (message.window !== "0") {
+      writer.uint32(32).int64(message.window);
+    }
+    if (message.ejectionDuration !== "0") {
+      writer.uint32(40).int64(message.ejectionDuration);
+    }
+    if (message.halfOpenRequests !== 0) {
+      writer.uint32(48).int32(message.halfOpenRequests);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CircuitBreaker {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCircuitBreaker();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.consecutiveFailures = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.errorRateThreshold = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.minRequests = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.window = reader.int64().toString();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.ejectionDuration = reader.int64().toString();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.halfOpenRequests = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CircuitBreaker {
+    return {
+      consecutiveFailures: isSet(object.consecutiveFailures)
+        ? globalThis.Number(object.consecutiveFailures)
+        : isSet(object.consecutive_failures)
+        ? globalThis.Number(object.consecutive_failures)
+        : 0,
+      errorRateThreshold: isSet(object.errorRateThreshold)
+        ? globalThis.Number(object.errorRateThreshold)
+        : isSet(object.error_rate_threshold)
+        ? globalThis.Number(object.error_rate_threshold)
+        : 0,
+      minRequests: isSet(object.minRequests)
+        ? globalThis.Number(object.minRequests)
+        : isSet(object.min_requests)
+        ? globalThis.Number(object.min_requests)
+        : 0,
+      window: isSet(object.window) ? globalThis.String(object.window) : "0",
+      ejectionDuration: isSet(object.ejectionDuration)
+        ? globalThis.String(object.ejectionDuration)
+        : isSet(object.ejection_duration)
+        ? globalThis.String(object.ejection_duration)
+        : "0",
+      halfOpenRequests: isSet(object.halfOpenRequests)
+        ? globalThis.Number(object.halfOpenRequests)
+        : isSet(object.half_open_requests)
+        ? globalThis.Number(object.half_open_requests)
+        : 0,
+    };
+  },
+
+  toJSON(message: CircuitBreaker): unknown {
+    const obj: any = {};
+    if (message.consecutiveFailures !== 0) {
+      obj.consecutiveFailures = Math.round(message.consecutiveFailures);
+    }
+    if (message.errorRateThreshold !== 0) {
+      obj.errorRateThreshold = Math.round(message.errorRateThreshold);
+    }
+    if (message.minRequests !== 0) {
+      obj.minRequests = Math.round(message.minRequests);
+    }
+    if (message.window !== "0") {
+      obj.window = message.window;
+    }
+    if (message.ejectionDuration !== "0") {
+      obj.ejectionDuration = message.ejectionDuration;
+    }
+    if (message.halfOpenRequests !== 0) {
+      obj.halfOpenRequests = Math.round(message.halfOpenRequests);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CircuitBreaker>, I>>(base?: I): CircuitBreaker {
+    return CircuitBreaker.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CircuitBreaker>, I>>(object: I): CircuitBreaker {
+    const message = createBaseCircuitBreaker();
+    message.consecutiveFailures = object.consecutiveFailures ?? 0;
+    message.errorRateThreshold = object.errorRateThreshold ?? 0;
+    message.minRequests = object.minRequests ?? 0;
+    message.window = object.window ?? "0";
+    message.ejectionDuration = object.ejectionDuration ?? "0";
+    message.halfOpenRequests = object.halfOpenRequests ?? 0;
+    return message;
+  },
+};
+
+function createBaseRetryPolicy(): RetryPolicy {
+  return {
+    attempts: 0,
+    retryOn: [],
+    perTryTimeout: "0",
+    backoffBase: "0",
+    backoffMax: "0",
+    budget: 0,
+    maxBodyBytes: "0",
+    idempotentMethods: [],
+  };
+}
+
+export const RetryPolicy: MessageFns<RetryPolicy> = {
+  encode(message: RetryPolicy, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.attempts !== 0) {
+      writer.uint32(8).int32(message.attempts);
+    }
+    for (const v of message.retryOn) {
+      writer.uint32(18).string(v!);
+    }
+    if (message.perTryTimeout !== "0") {
+      writer.uint32(24).int64(message.perTryTimeout);
+    }
+    if (message.backoffBase !== "0") {
+      writer.uint32(32).int64(message.backoffBase);
+    }
+    if (message.backoffMax !== "0") {
+      writer.uint32(40).int64(message.backoffMax);
+    }
+    if (message.budget !== 0) {
+      writer.uint32(48).int32(message.budget);
+    }
+    if (message.maxBodyBytes !== "0") {
+      writer.uint32(56).int64(message.maxBodyBytes);
+    }
+    for (const v of message.idempotentMethods) {
+      writer.uint32(66).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RetryPolicy {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRetryPolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.attempts = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.retryOn.push(reader.string());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.perTryTimeout = reader.int64().toString();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.backoffBase = reader.int64().toString();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.backoffMax = reader.int64().toString();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.budget = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.maxBodyBytes = reader.int64().toString();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.idempotentMethods.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RetryPolicy {
+    return {
+      attempts: isSet(object.attempts) ? globalThis.Number(object.attempts) : 0,
+      retryOn: globalThis.Array.isArray(object?.retryOn)
+        ? object.retryOn.map((e: any) => globalThis.String(e))
+        : globalThis.Array.isArray(object?.retry_on)
+        ? object.retry_on.map((e: any) => globalThis.String(e))
+        : [],
+      perTryTimeout: isSet(object.perTryTimeout)
+        ? globalThis.String(object.perTryTimeout)
+        : isSet(object.per_try_timeout)
+        ? globalThis.String(object.per_try_timeout)
+        : "0",
+      backoffBase: isSet(object.backoffBase)
+        ? globalThis.String(object.backoffBase)
+        : isSet(object.backoff_base)
+        ? globalThis.String(object.backoff_base)
+        : "0",
+      backoffMax: isSet(object.backoffMax)
+        ? globalThis.String(object.backoffMax)
+        : isSet(object.backoff_max)
+        ? globalThis.String(object.backoff_max)
+        : "0",
+      budget: isSet(object.budget) ? globalThis.Number(object.budget) : 0,
+      maxBodyBytes: isSet(object.maxBodyBytes)
+        ? globalThis.String(object.maxBodyBytes)
+        : isSet(object.max_body_bytes)
+        ? globalThis.String(object.max_body_bytes)
+        : "0",
+      idempotentMethods: globalThis.Array.isArray(object?.idempotentMethods)
+        ? object.idempotentMethods.map((e: any) => globalThis.String(e))
+        : globalThis.Array.isArray(object?.idempotent_methods)
+        ? object.idempotent_methods.map((e: any) => globalThis.String(e))
+        : [],
+    };
+  },
+
+  toJSON(message: RetryPolicy): unknown {
+    const obj: any = {};
+    if (message.attempts !== 0) {
+      obj.attempts = Math.round(message.attempts);
+    }
+    if (message.retryOn?.length) {
+      obj.retryOn = message.retryOn;
+    }
+    if (message.perTryTimeout !== "0") {
+      obj.perTryTimeout = message.perTryTimeout;
+    }
+    if (message.backoffBase !== "0") {
+      obj.backoffBase = message.backoffBase;
+    }
+    if (message.backoffMax !== "0") {
+      obj.backoffMax = message.backoffMax;
+    }
+    if (message.budget !== 0) {
+      obj.budget = Math.round(message.budget);
+    }
+    if (message.maxBodyBytes !== "0") {
+      obj.maxBodyBytes = message.maxBodyBytes;
+    }
+    if (message.idempotentMethods?.length) {
+      obj.idempotentMethods = message.idempotentMethods;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<RetryPolicy>, I>>(base?: I): RetryPolicy {
+    return RetryPolicy.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<RetryPolicy>, I>>(object: I): RetryPolicy {
+    const message = createBaseRetryPolicy();
+    message.attempts = object.attempts ?? 0;
+    message.retryOn = object.retryOn?.map((e) => e) || [];
+    message.perTryTimeout = object.perTryTimeout ?? "0";
+    message.backoffBase = object.backoffBase ?? "0";
+    message.backoffMax = object.backoffMax ?? "0";
+    message.budget = object.budget ?? 0;
+    message.maxBodyBytes = object.maxBodyBytes ?? "0";
+    message.idempotentMethods = object.idempotentMethods?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseHeaderMatch(): HeaderMatch {
+  return { name: "", exact: "", regex: "", present: false };
+}
+
+export const HeaderMatch: MessageFns<HeaderMatch> = {
+  encode(message: HeaderMatch, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.exact !== "") {
+      writer.uint32(18).string(message.exact);
+    }
+    if (message.regex !== "") {
+      writer.uint32(26).string(message.regex);
+    }
+    if (message.present !== false) {
+      writer.uint32(32).bool(message.present);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): HeaderMatch {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHeaderMatch();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.exact = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.regex = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.present = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): HeaderMatch {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      exact: isSet(object.exact) ? globalThis.String(object.exact) : "",
+      regex: isSet(object.regex) ? globalThis.String(object.regex) : "",
+      present: isSet(object.present) ? globalThis.Boolean(object.present) : false,
+    };
+  },
+
+  toJSON(message: HeaderMatch): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.exact !== "") {
+      obj.exact = message.exact;
+    }
+    if (message.regex !== "") {
+      obj.regex = message.regex;
+    }
+    if (message.present !== false) {
+      obj.present = message.present;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HeaderMatch>, I>>(base?: I): HeaderMatch {
+    return HeaderMatch.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<HeaderMatch>, I>>(object: I): HeaderMatch {
+    const message = createBaseHeaderMatch();
+    message.name = object.name ?? "";
+    message.exact = object.exact ?? "";
+    message.regex = object.regex ?? "";
+    message.present = object.present ?? false;
+    return message;
+  },
+};
+
+function createBaseQueryParamMatch(): QueryParamMatch {
+  return { name: "", exact: "", present: false };
+}
+
+export const QueryParamMatch: MessageFns<QueryParamMatch> = {
+  encode(message: QueryParamMatch, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.exact !== "") {
+      writer.uint32(18).string(message.exact);
+    }
+    if (message.present !== false) {
+      writer.uint32(24).bool(message.present);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): QueryParamMatch {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryParamMatch();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.exact = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.present = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QueryParamMatch {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      exact: isSet(object.exact) ? globalThis.String(object.exact) : "",
+      present: isSet(object.present) ? globalThis.Boolean(object.present) : false,
+    };
+  },
+
+  toJSON(message: QueryParamMatch): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.exact !== "") {
+      obj.exact = message.exact;
+    }
+    if (message.present !== false) {
+      obj.present = message.present;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QueryParamMatch>, I>>(base?: I): QueryParamMatch {
+    return QueryParamMatch.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<QueryParamMatch>, I>>(object: I): QueryParamMatch {
+    const message = createBaseQueryParamMatch();
+    message.name = object.name ?? "";
+    message.exact = object.exact ?? "";
+    message.present = object.present ?? false;
+    return message;
+  },
+};
+
+function createBaseRouteMatch(): RouteMatch {
+  return { methods: [], headers: [], queryParams: [] };
+}
+
+export const RouteMatch: MessageFns<RouteMatch> = {
+  encode(message: RouteMatch, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.methods) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.headers) {
+      HeaderMatch.encode(v!, writer.uint32(18).fork()).join();
+    }
+    for (const v of message.queryParams) {
+      QueryParamMatch.encode(v!, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RouteMatch {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRouteMatch();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.methods.push(reader.string());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.headers.push(HeaderMatch.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.queryParams.push(QueryParamMatch.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RouteMatch {
+    return {
+      methods: globalThis.Array.isArray(object?.methods) ? object.methods.map((e: any) => globalThis.String(e)) : [],
+      headers: globalThis.Array.isArray(object?.headers) ? object.headers.map((e: any) => HeaderMatch.fromJSON(e)) : [],
+      queryParams: globalThis.Array.isArray(object?.queryParams)
+        ? object.queryParams.map((e: any) => QueryParamMatch.fromJSON(e))
+        : globalThis.Array.isArray(object?.query_params)
+        ? object.query_params.map((e: any) => QueryParamMatch.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: RouteMatch): unknown {
+    const obj: any = {};
+    if (message.methods?.length) {
+      obj.methods = message.methods;
+    }
+    if (message.headers?.length) {
+      obj.headers = message.headers.map((e) => HeaderMatch.toJSON(e));
+    }
+    if (message.queryParams?.length) {
+      obj.queryParams = message.queryParams.map((e) => QueryParamMatch.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<RouteMatch>, I>>(base?: I): RouteMatch {
+    return RouteMatch.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<RouteMatch>, I>>(object: I): RouteMatch {
+    const message = createBaseRouteMatch();
+    message.methods = object.methods?.map((e) => e) || [];
+    message.headers = object.headers?.map((e) => HeaderMatch.fromPartial(e)) || [];
+    message.queryParams = object.queryParams?.map((e) => QueryParamMatch.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRewrite(): Rewrite {
+  return { prefix: "", regex: "", replacement: "", path: "", host: "" };
+}
+
+export const Rewrite: MessageFns<Rewrite> = {
+  encode(message: Rewrite, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.prefix !== "") {
+      writer.uint32(10).string(message.prefix);
+    }
+    if (message.regex !== "") {
+      writer.uint32(18).string(message.regex);
+    }
+    if (message.replacement !== "") {
+      writer.uint32(26).string(message.replacement);
+    }
+    if (message.path !== "") {
+      writer.uint32(34).string(message.path);
+    }
+    if (message.host !== "") {
+      writer.uint32(42).string(message.host);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Rewrite {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRewrite();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.prefix = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.regex = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.replacement = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.path = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.host = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Rewrite {
+    return {
+      prefix: isSet(object.prefix) ? globalThis.String(object.prefix) : "",
+      regex: isSet(object.regex) ? globalThis.String(object.regex) : "",
+      replacement: isSet(object.replacement) ? globalThis.String(object.replacement) : "",
+      path: isSet(object.path) ? globalThis.String(object.path) : "",
+      host: isSet(object.host) ? globalThis.String(object.host) : "",
+    };
+  },
+
+  toJSON(message: Rewrite): unknown {
+    const obj: any = {};
+    if (message.prefix !== "") {
+      obj.prefix = message.prefix;
+    }
+    if (message.regex !== "") {
+      obj.regex = message.regex;
+    }
+    if (message.replacement !== "") {
+      obj.replacement = message.replacement;
+    }
+    if (message.path !== "") {
+      obj.path = message.path;
+    }
+    if (message.host !== "") {
+      obj.host = message.host;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Rewrite>, I>>(base?: I): Rewrite {
+    return Rewrite.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Rewrite>, I>>(object: I): Rewrite {
+    const message = createBaseRewrite();
+    message.prefix = object.prefix ?? "";
+    message.regex = object.regex ?? "";
+    message.replacement = object.replacement ?? "";
+    message.path = object.path ?? "";
+    message.host = object.host ?? "";
+    return message;
+  },
+};
+
+function createBaseMirror(): Mirror {
+  return { targetUrl: "", percentage: 0, maxBodyBytes: "0", timeout: "0" };
+}
+
+export const Mirror: MessageFns<Mirror> = {
+  encode(message: Mirror, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.targetUrl !== "") {
+      writer.uint32(10).string(message.targetUrl);
+    }
+    if (message.percentage !== 0) {
+      writer.uint32(17).double(message.percentage);
+    }
+    if (message.maxBodyBytes !== "0") {
+      writer.uint32(24).int64(message.maxBodyBytes);
+    }
+    if (message.timeout !== "0") {
+      writer.uint32(32).int64(message.timeout);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Mirror {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMirror();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.targetUrl = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.percentage = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxBodyBytes = reader.int64().toString();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.timeout = reader.int64().toString();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Mirror {
+    return {
+      targetUrl: isSet(object.targetUrl)
+        ? globalThis.String(object.targetUrl)
+        : isSet(object.target_url)
+        ? globalThis.String(object.target_url)
+        : "",
+      percentage: isSet(object.percentage) ? globalThis.Number(object.percentage) : 0,
+      maxBodyBytes: isSet(object.maxBodyBytes)
+        ? globalThis.String(object.maxBodyBytes)
+        : isSet(object.max_body_bytes)
+        ? globalThis.String(object.max_body_bytes)
+        : "0",
+      timeout: isSet(object.timeout) ? globalThis.String(object.timeout) : "0",
+    };
+  },
+
+  toJSON(message: Mirror): unknown {
+    const obj: any = {};
+    if (message.targetUrl !== "") {
+      obj.targetUrl = message.targetUrl;
+    }
+    if (message.percentage !== 0) {
+      obj.percentage = message.percentage;
+    }
+    if (message.maxBodyBytes !== "0") {
+      obj.maxBodyBytes = message.maxBodyBytes;
+    }
+    if (message.timeout !== "0") {
+      obj.timeout = message.timeout;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Mirror>, I>>(base?: I): Mirror {
+    return Mirror.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Mirror>, I>>(object: I): Mirror {
+    const message = createBaseMirror();
+    message.targetUrl = object.targetUrl ?? "";
+    message.percentage = object.percentage ?? 0;
+    message.maxBodyBytes = object.maxBodyBytes ?? "0";
+    message.timeout = object.timeout ?? "0";
+    return message;
+  },
+};
+
+function createBaseClaimHeader(): ClaimHeader {
+  return { claim: "", header: "" };
+}
+
+export const ClaimHeader: MessageFns<ClaimHeader> = {
+  encode(message: ClaimHeader, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.claim !== "") {
+      writer.uint32(10).string(message.claim);
+    }
+    if (message.header !== "") {
+      writer.uint32(18).string(message.header);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ClaimHeader {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseClaimHeader();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.claim = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.header = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ClaimHeader {
+    return {
+      claim: isSet(object.claim) ? globalThis.String(object.claim) : "",
+      header: isSet(object.header) ? globalThis.String(object.header) : "",
+    };
+  },
+
+  toJSON(message: ClaimHeader): unknown {
+    const obj: any = {};
+    if (message.claim !== "") {
+      obj.claim = message.claim;
+    }
+    if (message.header !== "") {
+      obj.header = message.header;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ClaimHeader>, I>>(base?: I): ClaimHeader {
+    return ClaimHeader.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ClaimHeader>, I>>(object: I): ClaimHeader {
+    const message = createBaseClaimHeader();
+    message.claim = object.claim ?? "";
+    message.header = object.header ?? "";
+    return message;
+  },
+};
+
+function createBaseIdentity(): Identity {
+  return { claimHeaders: [], omitDefaultHeaders: false, forwardToken: false, staticHeaders: [], internalToken: false };
+}
+
+export const Identity: MessageFns<Identity> = {
+  encode(message: Identity, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.claimHeaders) {
+      ClaimHeader.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.omitDefaultHeaders !== false) {
+      writer.uint32(16).bool(message.omitDefaultHeaders);
+    }
+    if (message.forwardToken !== false) {
+      writer.uint32(24).bool(message.forwardToken);
+    }
+    for (const v of message.staticHeaders) {
+      KeyValue.encode(v!, writer.uint32(34).fork()).join();
+    }
+    if (message.internalToken !== false) {
+      writer.uint32(40).bool(message.internalToken);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Identity {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentity();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.claimHeaders.push(ClaimHeader.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.omitDefaultHeaders = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.forwardToken = reader.bool();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.staticHeaders.push(KeyValue.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.internalToken = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Identity {
+    return {
+      claimHeaders: globalThis.Array.isArray(object?.claimHeaders)
+        ? object.claimHeaders.map((e: any) => ClaimHeader.fromJSON(e))
+        : globalThis.Array.isArray(object?.claim_headers)
+        ? object.claim_headers.map((e: any) => ClaimHeader.fromJSON(e))
+        : [],
+      omitDefaultHeaders: isSet(object.omitDefaultHeaders)
+        ? globalThis.Boolean(object.omitDefaultHeaders)
+        : isSet(object.omit_default_headers)
+        ? globalThis.Boolean(object.omit_default_headers)
+        : false,
+      forwardToken: isSet(object.forwardToken)
+        ? globalThis.Boolean(object.forwardToken)
+        : isSet(object.forward_token)
+        ? globalThis.Boolean(object.forward_token)
+        : false,
+      staticHeaders: globalThis.Array.isArray(object?.staticHeaders)
+        ? object.staticHeaders.map((e: any) => KeyValue.fromJSON(e))
+        : globalThis.Array.isArray(object?.static_headers)
+        ? object.static_headers.map((e: any) => KeyValue.fromJSON(e))
+        : [],
+      internalToken: isSet(object.internalToken)
+        ? globalThis.Boolean(object.internalToken)
+        : isSet(object.internal_token)
+        ? globalThis.Boolean(object.internal_token)
+        : false,
+    };
+  },
+
+  toJSON(message: Identity): unknown {
+    const obj: any = {};
+    if (message.claimHeaders?.length) {
+      obj.claimHeaders = message.claimHeaders.map((e) => ClaimHeader.toJSON(e));
+    }
+    if (message.omitDefaultHeaders !== false) {
+      obj.omitDefaultHeaders = message.omitDefaultHeaders;
+    }
+    if (message.forwardToken !== false) {
+      obj.forwardToken = message.forwardToken;
+    }
+    if (message.staticHeaders?.length) {
+      obj.staticHeaders = message.staticHeaders.map((e) => KeyValue.toJSON(e));
+    }
+    if (message.internalToken !== false) {
+      obj.internalToken = message.internalToken;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Identity>, I>>(base?: I): Identity {
+    return Identity.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Identity>, I>>(object: I): Identity {
+    const message = createBaseIdentity();
+    message.claimHeaders = object.claimHeaders?.map((e) => ClaimHeader.fromPartial(e)) || [];
+    message.omitDefaultHeaders = object.omitDefaultHeaders ?? false;
+    message.forwardToken = object.forwardToken ?? false;
+    message.staticHeaders = object.staticHeaders?.map((e) => KeyValue.fromPartial(e)) || [];
+    message.internalToken = object.internalToken ?? false;
+    return message;
+  },
+};
+
+function createBaseRateLimit(): RateLimit {
+  return { algorithm: "", requests: "0", window: "0", burst: "0", key: "", header: "" };
+}
+
+export const RateLimit: MessageFns<RateLimit> = {
+  encode(message: RateLimit, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.algorithm !== "") {
+      writer.uint32(10).string(message.algorithm);
+    }
+    if (message.requests !== "0") {
+      writer.uint32(16).int64(message.requests);
+    }
+    if (message.window !== "0") {
+      writer.uint32(24).int64(message.window);
+    }
+    if (message.burst !== "0") {
+      writer.uint32(32).int64(message.burst);
+    }
+    if (message.key !== "") {
+      writer.uint32(42).string(message.key);
+    }
+    if (message.header !== "") {
+      writer.uint32(50).string(message.header);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RateLimit {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRateLimit();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.algorithm = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.requests = reader.int64().toString();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.window = reader.int64().toString();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.burst = reader.int64().toString();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.header = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RateLimit {
+    return {
+      algorithm: isSet(object.algorithm) ? globalThis.String(object.algorithm) : "",
+      requests: isSet(object.requests) ? globalThis.String(object.requests) : "0",
+      window: isSet(object.window) ? globalThis.String(object.window) : "0",
+      burst: isSet(object.burst) ? globalThis.String(object.burst) : "0",
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      header: isSet(object.header) ? globalThis.String(object.header) : "",
+    };
+  },
+
+  toJSON(message: RateLimit): unknown {
+    const obj: any = {};
+    if (message.algorithm !== "") {
+      obj.algorithm = message.algorithm;
+    }
+    if (message.requests !== "0") {
+      obj.requests = message.requests;
+    }
+    if (message.window !== "0") {
+      obj.window = message.window;
+    }
+    if (message.burst !== "0") {
+      obj.burst = message.burst;
+    }
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.header !== "") {
+      obj.header = message.header;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<RateLimit>, I>>(base?: I): RateLimit {
+    return RateLimit.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<RateLimit>, I>>(object: I): RateLimit {
+    const message = createBaseRateLimit();
+    message.algorithm = object.algorithm ?? "";
+    message.requests = object.requests ?? "0";
+    message.window = object.window ?? "0";
+    message.burst = object.burst ?? "0";
+    message.key = object.key ?? "";
+    message.header = object.header ?? "";
+    return message;
+  },
+};
+
+function createBaseConfig(): Config {
+  return {
+    id: "0",
+    name: "",
+    pathPrefix: "",
+    targetUrl: "",
+    stripPrefix: false,
+    authentication: undefined,
+    middleware: [],
+    timeout: "0",
+    createdAt: "0",
+    updatedAt: "0",
+    targets: [],
+    loadBalancer: undefined,
+    healthCheck: undefined,
+    circuitBreaker: undefined,
+    retryPolicy: undefined,
+    middlewareConfig: undefined,
+    hosts: [],
+    match: undefined,
+    priority: 0,
+    rewrite: undefined,
+    split: undefined,
+    mirror: undefined,
+    identity: undefined,
+    rateLimit: undefined,
+  };
+}
+
+export const Config: MessageFns<Config> = {
+  encode(message: Config, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "0") {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.pathPrefix !== "") {
+      writer.uint32(26).string(message.pathPrefix);
+    }
+    if (message.targetUrl !== "") {
+      writer.uint32(34).string(message.targetUrl);
+    }
+    if (message.stripPrefix !== false) {
+      writer.uint32(40).bool(message.stripPrefix);
+    }
+    if (message.authentication !== undefined) {
+      Authentication.encode(message.authentication, writer.uint32(50).fork()).join();
+    }
+    for (const v of message.middleware) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.timeout !== "0") {
+      writer.uint32(64).int64(message.timeout);
+    }
+    if (message.createdAt !== "0") {
+      writer.uint32(72).int64(message.createdAt);
+    }
+    if (message.updatedAt !== "0") {
+      writer.uint32(80).int64(message.updatedAt);
+    }
+    for (const v of message.targets) {
+      Target.encode(v!, writer.uint32(90).fork()).join();
+    }
+    if (message.loadBalancer !== undefined) {
+      LoadBalancer.encode(message.loadBalancer, writer.uint32(98).fork()).join();
+    }
+    if (message.healthCheck !== undefined) {
+      HealthCheck.encode(message.healthCheck, writer.uint32(106).fork()).join();
+    }
+    if (message.circuitBreaker !== undefined) {
+      CircuitBreaker.encode(message.circuitBreaker, writer.uint32(114).fork()).join();
+    }
+    if (message.retryPolicy !== undefined) {
+      RetryPolicy.encode(message.retryPolicy, writer.uint32(122).fork()).join();
+    }
+    if (message.middlewareConfig !== undefined) {
+      Struct.encode(Struct.wrap(message.middlewareConfig), writer.uint32(130).fork()).join();
+    }
+    for (const v of message.hosts) {
+      writer.uint32(138).string(v!);
+    }
+    if (message.match !== undefined) {
+      RouteMatch.encode(message.match, writer.uint32(146).fork()).join();
+    }
+    if (message.priority !== 0) {
+      writer.uint32(152).int32(message.priority);
+    }
+    if (message.rewrite !== undefined) {
+      Rewrite.encode(message.rewrite, writer.uint32(162).fork()).join();
+    }
+    if (message.split !== undefined) {
+      TrafficSplit.encode(message.split, writer.uint32(170).fork()).join();
+    }
+    if (message.mirror !== undefined) {
+      Mirror.encode(message.mirror, writer.uint32(178).fork()).join();
+    }
+    if (message.identity !== undefined) {
+      Identity.encode(message.identity, writer.uint32(186).fork()).join();
+    }
+    if (message.rateLimit !== undefined) {
+      RateLimit.encode(message.rateLimit, writer.uint32(194).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Config {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int64().toString();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pathPrefix = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.targetUrl = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.stripPrefix = reader.bool();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.authentication = Authentication.decode(reader, reader.uint32());
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.middleware.push(reader.string());
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.timeout = reader.int64().toString();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.createdAt = reader.int64().toString();
+          continue;
+        }
+        case 10: {
+          if (tag !== 80) {
+            break;
+          }
+
+          message.updatedAt = reader.int64().toString();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.targets.push(Target.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.loadBalancer = LoadBalancer.decode(reader, reader.uint32());
+          continue;
+        }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.healthCheck = HealthCheck.decode(reader, reader.uint32());
+          continue;
+        }
+        case 14: {
+          if (tag !== 114) {
+            break;
+          }
+
+          message.circuitBreaker = CircuitBreaker.decode(reader, reader.uint32());
+          continue;
+        }
+        case 15: {
+          if (tag !== 122) {
+            break;
+          }
+
+          message.retryPolicy = RetryPolicy.decode(reader, reader.uint32());
+          continue;
+        }
+        case 16: {
+          if (tag !== 130) {
+            break;
+          }
+
+          message.middlewareConfig = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 17: {
+          if (tag !== 138) {
+            break;
+          }
+
+          message.hosts.push(reader.string());
+          continue;
+        }
+        case 18: {
+          if (tag !== 146) {
+            break;
+          }
+
+          message.match = RouteMatch.decode(reader, reader.uint32());
+          continue;
+        }
+        case 19: {
+          if (tag !== 152) {
+            break;
+          }
+
+          message.priority = reader.int32();
+          continue;
+        }
+        case 20: {
+          if (tag !== 162) {
+            break;
+          }
+
+          message.rewrite = Rewrite.decode(reader, reader.uint32());
+          continue;
+        }
+        case 21: {
+          if (tag !== 170) {
+            break;
+          }
+
+          message.split = TrafficSplit.decode(reader, reader.uint32());
+          continue;
+        }
+        case 22: {
+          if (tag !== 178) {
+            break;
+          }
+
+          message.mirror = Mirror.decode(reader, reader.uint32());
+          continue;
+        }
+        case 23: {
+          if (tag !== 186) {
+            break;
+          }
+
+          message.identity = Identity.decode(reader, reader.uint32());
+          continue;
+        }
+        case 24: {
+          if (tag !== 194) {
+            break;
+          }
+
+          message.rateLimit = RateLimit.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Config {
+    return {
+      id: isSet(object.id) ? globalThis.String(object.id) : "0",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      pathPrefix: isSet(object.pathPrefix)
+        ? globalThis.String(object.pathPrefix)
+        : isSet(object.path_prefix)
+        ? globalThis.String(object.path_prefix)
+        : "",
+      targetUrl: isSet(object.targetUrl)
+        ? globalThis.String(object.targetUrl)
+        : isSet(object.target_url)
+        ? globalThis.String(object.target_url)
+        : "",
+      stripPrefix: isSet(object.stripPrefix)
+        ? globalThis.Boolean(object.stripPrefix)
+        : isSet(object.strip_prefix)
+        ? globalThis.Boolean(object.strip_prefix)
+        : false,
+      authentication: isSet(object.authentication) ? Authentication.fromJSON(object.authentication) : undefined,
+      middleware: globalThis.Array.isArray(object?.middleware)
+        ? object.middleware.map((e: any) => globalThis.String(e))
+        : [],
+      timeout: isSet(object.timeout) ? globalThis.String(object.timeout) : "0",
+      createdAt: isSet(object.createdAt)
+        ? globalThis.String(object.createdAt)
+        : isSet(object.created_at)
+        ? globalThis.String(object.created_at)
+        : "0",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "0",
+      targets: globalThis.Array.isArray(object?.targets) ? object.targets.map((e: any) => Target.fromJSON(e)) : [],
+      loadBalancer: isSet(object.loadBalancer)
+        ? LoadBalancer.fromJSON(object.loadBalancer)
+        : isSet(object.load_balancer)
+        ? LoadBalancer.fromJSON(object.load_balancer)
+        : undefined,
+      healthCheck: isSet(object.healthCheck)
+        ? HealthCheck.fromJSON(object.healthCheck)
+        : isSet(object.health_check)
+        ? HealthCheck.fromJSON(object.health_check)
+        : undefined,
+      circuitBreaker: isSet(object.circuitBreaker)
+        ? CircuitBreaker.fromJSON(object.circuitBreaker)
+        : isSet(object.circuit_breaker)
+        ? CircuitBreaker.fromJSON(object.circuit_breaker)
+        : undefined,
+      retryPolicy: isSet(object.retryPolicy)
+        ? RetryPolicy.fromJSON(object.retryPolicy)
+        : isSet(object.retry_policy)
+        ? RetryPolicy.fromJSON(object.retry_policy)
+        : undefined,
+      middlewareConfig: isObject(object.middlewareConfig) ? object.middlewareConfig : undefined,
+      hosts: globalThis.Array.isArray(object?.hosts) ? object.hosts.map((e: any) => globalThis.String(e)) : [],
+      match: isSet(object.match) ? RouteMatch.fromJSON(object.match) : undefined,
+      priority: isSet(object.priority) ? globalThis.Number(object.priority) : 0,
+      rewrite: isSet(object.rewrite) ? Rewrite.fromJSON(object.rewrite) : undefined,
+      split: isSet(object.split) ? TrafficSplit.fromJSON(object.split) : undefined,
+      mirror: isSet(object.mirror) ? Mirror.fromJSON(object.mirror) : undefined,
+      identity: isSet(object.identity) ? Identity.fromJSON(object.identity) : undefined,
+      rateLimit: isSet(object.rateLimit)
+        ? RateLimit.fromJSON(object.rateLimit)
+        : isSet(object.rate_limit)
+        ? RateLimit.fromJSON(object.rate_limit)
+        : undefined,
+    };
+  },
+
+  toJSON(message: Config): unknown {
+    const obj: any = {};
+    if (message.id !== "0") {
+      obj.id = message.id;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.pathPrefix !== "") {
+      obj.pathPrefix = message.pathPrefix;
+    }
+    if (message.targetUrl !== "") {
+      obj.targetUrl = message.targetUrl;
+    }
+    if (message.stripPrefix !== false) {
+      obj.stripPrefix = message.stripPrefix;
+    }
+    if (message.authentication !== undefined) {
+      obj.authentication = Authentication.toJSON(message.authentication);
+    }
+    if (message.middleware?.length) {
+      obj.middleware = message.middleware;
+    }
+    if (message.timeout !== "0") {
+      obj.timeout = message.timeout;
+    }
+    if (message.createdAt !== "0") {
+      obj.createdAt = message.createdAt;
+    }
+    if (message.updatedAt !== "0") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.targets?.length) {
+      obj.targets = message.targets.map((e) => Target.toJSON(e));
+    }
+    if (message.loadBalancer !== undefined) {
+      obj.loadBalancer = LoadBalancer.toJSON(message.loadBalancer);
+    }
+    if (message.healthCheck !== undefined) {
+      obj.healthCheck = HealthCheck.toJSON(message.healthCheck);
+    }
+    if (message.circuitBreaker !== undefined) {
+      obj.circuitBreaker = CircuitBreaker.toJSON(message.circuitBreaker);
+    }
+    if (message.retryPolicy !== undefined) {
+      obj.retryPolicy = RetryPolicy.toJSON(message.retryPolicy);
+    }
+    if (message.middlewareConfig !== undefined) {
+      obj.middlewareConfig = message.middlewareConfig;
+    }
+    if (message.hosts?.length) {
+      obj.hosts = message.hosts;
+    }
+    if (message.match !== undefined) {
+      obj.match = RouteMatch.toJSON(message.match);
+    }
+    if (message.priority !== 0) {
+      obj.priority = Math.round(message.priority);
+    }
+    if (message.rewrite !== undefined) {
+      obj.rewrite = Rewrite.toJSON(message.rewrite);
+    }
+    if (message.split !== undefined) {
+      obj.split = TrafficSplit.toJSON(message.split);
+    }
+    if (message.mirror !== undefined) {
+      obj.mirror = Mirror.toJSON(message.mirror);
+    }
+    if (message.identity !== undefined) {
+      obj.identity = Identity.toJSON(message.identity);
+    }
+    if (message.rateLimit !== undefined) {
+      obj.rateLimit = RateLimit.toJSON(message.rateLimit);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Config>, I>>(base?: I): Config {
+    return Config.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Config>, I>>(object: I): Config {
+    const message = createBaseConfig();
+    message.id = object.id ?? "0";
+    message.name = object.name ?? "";
+    message.pathPrefix = object.pathPrefix ?? "";
+    message.targetUrl = object.targetUrl ?? "";
+    message.stripPrefix = object.stripPrefix ?? false;
+    message.authentication = (object.authentication !== undefined && object.authentication !== null)
+      ? Authentication.fromPartial(object.authentication)
+      : undefined;
+    message.middleware = object.middleware?.map((e) => e) || [];
+    message.timeout = object.timeout ?? "0";
+    message.createdAt = object.createdAt ?? "0";
+    message.updatedAt = object.updatedAt ?? "0";
+    message.targets = object.targets?.map((e) => Target.fromPartial(e)) || [];
+    message.loadBalancer = (object.loadBalancer !== undefined && object.loadBalancer !== null)
+      ? LoadBalancer.fromPartial(object.loadBalancer)
+      : undefined;
+    message.healthCheck = (object.healthCheck !== undefined && object.healthCheck !== null)
+      ? HealthCheck.fromPartial(object.healthCheck)
+      : undefined;
+    message.circuitBreaker = (object.circuitBreaker !== undefined && object.circuitBreaker !== null)
+      ? CircuitBreaker.fromPartial(object.circuitBreaker)
+      : undefined;
+    message.retryPolicy = (object.retryPolicy !== undefined && object.retryPolicy !== null)
+      ? RetryPolicy.fromPartial(object.retryPolicy)
+      : undefined;
+    message.middlewareConfig = object.middlewareConfig ?? undefined;
+    message.hosts = object.hosts?.map((e) => e) || [];
+    message.match = (object.match !== undefined && object.match !== null)
+      ? RouteMatch.fromPartial(object.match)
+      : undefined;
+    message.priority = object.priority ?? 0;
+    message.rewrite = (object.rewrite !== undefined && object.rewrite !== null)
+      ? Rewrite.fromPartial(object.rewrite)
+      : undefined;
+    message.split = (object.split !== undefined && object.split !== null)
+      ? TrafficSplit.fromPartial(object.split)
+      : undefined;
+    message.mirror = (object.mirror !== undefined && object.mirror !== null)
+      ? Mirror.fromPartial(object.mirror)
+      : undefined;
+    message.identity = (object.identity !== undefined && object.identity !== null)
+      ? Identity.fromPartial(object.identity)
+      : undefined;
+    message.rateLimit = (object.rateLimit !== undefined && object.rateLimit !== null)
+      ? RateLimit.fromPartial(object.rateLimit)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseCreateConfigRequest(): CreateConfigRequest {
+  return {
+    name: "",
+    pathPrefix: "",
+    targetUrl: "",
+    stripPrefix: false,
+    authentication: undefined,
+    middleware: [],
+    timeout: "0",
+    targets: [],
+    loadBalancer: undefined,
+    healthCheck: undefined,
+    circuitBreaker: undefined,
+    retryPolicy: undefined,
+    middlewareConfig: undefined,
+    hosts: [],
+    match: undefined,
+    priority: 0,
+    rewrite: undefined,
+    split: undefined,
+    mirror: undefined,
+    identity: undefined,
+    rateLimit: undefined,
+  };
+}
+
+export const CreateConfigRequest: MessageFns<CreateConfigRequest> = {
+  encode(message: CreateConfigRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.pathPrefix !== "") {
+      writer.uint32(18).string(message.pathPrefix);
+    }
+    if (message.targetUrl !== "") {
+      writer.uint32(26).string(message.targetUrl);
+    }
+    if (message.stripPrefix !== false) {
+      writer.uint32(32).bool(message.stripPrefix);
+    }
+    if (message.authentication !== undefined) {
+      Authentication.encode(message.authentication, writer.uint32(42).fork()).join();
+    }
+    for (const v of message.middleware) {
+      writer.uint32(50).string(v!);
+    }
+    if (message.timeout !== "0") {
+      writer.uint32(56).int64(message.timeout);
+    }
+    for (const v of message.targets) {
+      Target.encode(v!, writer.uint32(66).fork()).join();
+    }
+    if (message.loadBalancer !== undefined) {
+      LoadBalancer.encode(message.loadBalancer, writer.uint32(74).fork()).join();
+    }
+    if (message.healthCheck !== undefined) {
+      HealthCheck.encode(message.healthCheck, writer.uint32(82).fork()).join();
+    }
+    if (message.circuitBreaker !== undefined) {
+      CircuitBreaker.encode(message.circuitBreaker, writer.uint32(90).fork()).join();
+    }
+    if (message.retryPolicy !== undefined) {
+      RetryPolicy.encode(message.retryPolicy, writer.uint32(98).fork()).join();
+    }
+    if (message.middlewareConfig !== undefined) {
+      Struct.encode(Struct.wrap(message.middlewareConfig), writer.uint32(106).fork()).join();
+    }
+    for (const v of message.hosts) {
+      writer.uint32(114).string(v!);
+    }
+    if (message.match !== undefined) {
+      RouteMatch.encode(message.match, writer.uint32(122).fork()).join();
+    }
+    if (message.priority !== 0) {
+      writer.uint32(128).int32(message.priority);
+    }
+    if (message.rewrite !== undefined) {
+      Rewrite.encode(message.rewrite, writer.uint32(138).fork()).join();
+    }
+    if (message.split !== undefined) {
+      TrafficSplit.encode(message.split, writer.uint32(146).fork()).join();
+    }
+    if (message.mirror !== undefined) {
+      Mirror.encode(message.mirror, writer.uint32(154).fork()).join();
+    }
+    if (message.identity !== undefined) {
+      Identity.encode(message.identity, writer.uint32(162).fork()).join();
+    }
+    if (message.rateLimit !== undefined) {
+      RateLimit.encode(message.rateLimit, writer.uint32(170).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateConfigRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateConfigRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pathPrefix = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.targetUrl = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.stripPrefix = reader.bool();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.authentication = Authentication.decode(reader, reader.uint32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.middleware.push(reader.string());
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.timeout = reader.int64().toString();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.targets.push(Target.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.loadBalancer = LoadBalancer.decode(reader, reader.uint32());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.healthCheck = HealthCheck.decode(reader, reader.uint32());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.circuitBreaker = CircuitBreaker.decode(reader, reader.uint32());
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.retryPolicy = RetryPolicy.decode(reader, reader.uint32());
+          continue;
+        }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.middlewareConfig = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 14: {
+          if (tag !== 114) {
+            break;
+          }
+
+          message.hosts.push(reader.string());
+          continue;
+        }
+        case 15: {
+          if (tag !== 122) {
+            break;
+          }
+
+          message.match = RouteMatch.decode(reader, reader.uint32());
+          continue;
+        }
+        case 16: {
+          if (tag !== 128) {
+            break;
+          }
+
+          message.priority = reader.int32();
+          continue;
+        }
+        case 17: {
+          if (tag !== 138) {
+            break;
+          }
+
+          message.rewrite = Rewrite.decode(reader, reader.uint32());
+          continue;
+        }
+        case 18: {
+          if (tag !== 146) {
+            break;
+          }
+
+          message.split = TrafficSplit.decode(reader, reader.uint32());
+          continue;
+        }
+        case 19: {
+          if (tag !== 154) {
+            break;
+          }
+
+          message.mirror = Mirror.decode(reader, reader.uint32());
+          continue;
+        }
+        case 20: {
+          if (tag !== 162) {
+            break;
+          }
+
+          message.identity = Identity.decode(reader, reader.uint32());
+          continue;
+        }
+        case 21: {
+          if (tag !== 170) {
+            break;
+          }
+
+          message.rateLimit = RateLimit.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateConfigRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      pathPrefix: isSet(object.pathPrefix)
+        ? globalThis.String(object.pathPrefix)
+        : isSet(object.path_prefix)
+        ? globalThis.String(object.path_prefix)
+        : "",
+      targetUrl: isSet(object.targetUrl)
+        ? globalThis.String(object.targetUrl)
+        : isSet(object.target_url)
+        ? globalThis.String(object.target_url)
+        : "",
+      stripPrefix: isSet(object.stripPrefix)
+        ? globalThis.Boolean(object.stripPrefix)
+        : isSet(object.strip_prefix)
+        ? globalThis.Boolean(object.strip_prefix)
+        : false,
+      authentication: isSet(object.authentication) ? Authentication.fromJSON(object.authentication) : undefined,
+      middleware: globalThis.Array.isArray(object?.middleware)
+        ? object.middleware.map((e: any) => globalThis.String(e))
+        : [],
+      timeout: isSet(object.timeout) ? globalThis.String(object.timeout) : "0",
+      targets: globalThis.Array.isArray(object?.targets) ? object.targets.map((e: any) => Target.fromJSON(e)) : [],
+      loadBalancer: isSet(object.loadBalancer)
+        ? LoadBalancer.fromJSON(object.loadBalancer)
+        : isSet(object.load_balancer)
+        ? LoadBalancer.fromJSON(object.load_balancer)
+        : undefined,
+      healthCheck: isSet(object.healthCheck)
+        ? HealthCheck.fromJSON(object.healthCheck)
+        : isSet(object.health_check)
+        ? HealthCheck.fromJSON(object.health_check)
+        : undefined,
+      circuitBreaker: isSet(object.circuitBreaker)
+        ? CircuitBreaker.fromJSON(object.circuitBreaker)
+        : isSet(object.circuit_breaker)
+        ? CircuitBreaker.fromJSON(object.circuit_breaker)
+        : undefined,
+      retryPolicy: isSet(object.retryPolicy)
+        ? RetryPolicy.fromJSON(object.retryPolicy)
+        : isSet(object.retry_policy)
+        ? RetryPolicy.fromJSON(object.retry_policy)
+        : undefined,
+      middlewareConfig: isObject(object.middlewareConfig) ? object.middlewareConfig : undefined,
+      hosts: globalThis.Array.isArray(object?.hosts) ? object.hosts.map((e: any) => globalThis.String(e)) : [],
+      match: isSet(object.match) ? RouteMatch.fromJSON(object.match) : undefined,
+      priority: isSet(object.priority) ? globalThis.Number(object.priority) : 0,
+      rewrite: isSet(object.rewrite) ? Rewrite.fromJSON(object.rewrite) : undefined,
+      split: isSet(object.split) ? TrafficSplit.fromJSON(object.split) : undefined,
+      mirror: isSet(object.mirror) ? Mirror.fromJSON(object.mirror) : undefined,
+      identity: isSet(object.identity) ? Identity.fromJSON(object.identity) : undefined,
+      rateLimit: isSet(object.rateLimit)
+        ? RateLimit.fromJSON(object.rateLimit)
+        : isSet(object.rate_limit)
+        ? RateLimit.fromJSON(object.rate_limit)
+        : undefined,
+    };
+  },
+
+  toJSON(message: CreateConfigRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.pathPrefix !== "") {
+      obj.pathPrefix = message.pathPrefix;
+    }
+    if (message.targetUrl !== "") {
+      obj.targetUrl = message.targetUrl;
+    }
+    if (message.stripPrefix !== false) {
+      obj.stripPrefix = message.stripPrefix;
+    }
+    if (message.authentication !== undefined) {
+      obj.authentication = Authentication.toJSON(message.authentication);
+    }
+    if (message.middleware?.length) {
+      obj.middleware = message.middleware;
+    }
+    if (message.timeout !== "0") {
+      obj.timeout = message.timeout;
+    }
+    if (message.targets?.length) {
+      obj.targets = message.targets.map((e) => Target.toJSON(e));
+    }
+    if (message.loadBalancer !== undefined) {
+      obj.loadBalancer = LoadBalancer.toJSON(message.loadBalancer);
+    }
+    if (message.healthCheck !== undefined) {
+      obj.healthCheck = HealthCheck.toJSON(message.healthCheck);
+    }
+    if (message.circuitBreaker !== undefined) {
+      obj.circuitBreaker = CircuitBreaker.toJSON(message.circuitBreaker);
+    }
+    if (message.retryPolicy !== undefined) {
+      obj.retryPolicy = RetryPolicy.toJSON(message.retryPolicy);
+    }
+    if (message.middlewareConfig !== undefined) {
+      obj.middlewareConfig = message.middlewareConfig;
+    }
+    if (message.hosts?.length) {
+      obj.hosts = message.hosts;
+    }
+    if (message.match !== undefined) {
+      obj.match = RouteMatch.toJSON(message.match);
+    }
+    if (message.priority !== 0) {
+      obj.priority = Math.round(message.priority);
+    }
+    if (message.rewrite !== undefined) {
+      obj.rewrite = Rewrite.toJSON(message.rewrite);
+    }
+    if (message.split !== undefined) {
+      obj.split = TrafficSplit.toJSON(message.split);
+    }
+    if (message.mirror !== undefined) {
+      obj.mirror = Mirror.toJSON(message.mirror);
+    }
+    if (message.identity !== undefined) {
+      obj.identity = Identity.toJSON(message.identity);
+    }
+    if (message.rateLimit !== undefined) {
+      obj.rateLimit = RateLimit.toJSON(message.rateLimit);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateConfigRequest>, I>>(base?: I): CreateConfigRequest {
+    return CreateConfigRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateConfigRequest>, I>>(object: I): CreateConfigRequest {
+    const message = createBaseCreateConfigRequest();
+    message.name = object.name ?? "";
+    message.pathPrefix = object.pathPrefix ?? "";
+    message.targetUrl = object.targetUrl ?? "";
+    message.stripPrefix = object.stripPrefix ?? false;
+    message.authentication = (object.authentication !== undefined && object.authentication !== null)
+      ? Authentication.fromPartial(object.authentication)
+      : undefined;
+    message.middleware = object.middleware?.map((e) => e) || [];
+    message.timeout = object.timeout ?? "0";
+    message.targets = object.targets?.map((e) => Target.fromPartial(e)) || [];
+    message.loadBalancer = (object.loadBalancer !== undefined && object.loadBalancer !== null)
+      ? LoadBalancer.fromPartial(object.loadBalancer)
+      : undefined;
+    message.healthCheck = (object.healthCheck !== undefined && object.healthCheck !== null)
+      ? HealthCheck.fromPartial(object.healthCheck)
+      : undefined;
+    message.circuitBreaker = (object.circuitBreaker !== undefined && object.circuitBreaker !== null)
+      ? CircuitBreaker.fromPartial(object.circuitBreaker)
+      : undefined;
+    message.retryPolicy = (object.retryPolicy !== undefined && object.retryPolicy !== null)
+      ? RetryPolicy.fromPartial(object.retryPolicy)
+      : undefined;
+    message.middlewareConfig = object.middlewareConfig ?? undefined;
+    message.hosts = object.hosts?.map((e) => e) || [];
+    message.match = (object.match !== undefined && object.match !== null)
+      ? RouteMatch.fromPartial(object.match)
+      : undefined;
+    message.priority = object.priority ?? 0;
+    message.rewrite = (object.rewrite !== undefined && object.rewrite !== null)
+      ? Rewrite.fromPartial(object.rewrite)
+      : undefined;
+    message.split = (object.split !== undefined && object.split !== null)
+      ? TrafficSplit.fromPartial(object.split)
+      : undefined;
+    message.mirror = (object.mirror !== undefined && object.mirror !== null)
+      ? Mirror.fromPartial(object.mirror)
+      : undefined;
+    message.identity = (object.identity !== undefined && object.identity !== null)
+      ? Identity.fromPartial(object.identity)
+      : undefined;
+    message.rateLimit = (object.rateLimit !== undefined && object.rateLimit !== null)
+      ? RateLimit.fromPartial(object.rateLimit)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseCreateConfigResponse(): CreateConfigResponse {
+  return { config: undefined, message: "" };
+}
+
+export const CreateConfigResponse: MessageFns<CreateConfigResponse> = {
+  encode(message: CreateConfigResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.config !== undefined) {
+      Config.encode(message.config, writer.uint32(10).fork()).join();
+    }
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateConfigResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateConfigResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.config = Config.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateConfigResponse {
+    return {
+      config: isSet(object.config) ? Config.fromJSON(object.config) : undefined,
+      message: isSet(object.message) ? globalThis.String(object.message) : "",
+    };
+  },
+
+  toJSON(message: CreateConfigResponse): unknown {
+    const obj: any = {};
+    if (message.config !== undefined) {
+      obj.config = Config.toJSON(message.config);
+    }
+    if (message.message !== "") {
+      obj.message = message.message;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateConfigResponse>, I>>(base?: I): CreateConfigResponse {
+    return CreateConfigResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateConfigResponse>, I>>(object: I): CreateConfigResponse {
+    const message = createBaseCreateConfigResponse();
+    message.config = (object.config !== undefined && object.config !== null)
+      ? Config.fromPartial(object.config)
+      : undefined;
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBaseGetConfigRequest(): GetConfigRequest {
+  return { id: "0" };
+}
+
+export const GetConfigRequest: MessageFns<GetConfigRequest> = {
+  encode(message: GetConfigRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "0") {
+      writer.uint32(8).int64(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetConfigRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetConfigRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int64().toString();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetConfigRequest {
+    return { id: isSet(object.id) ? globalThis.String(object.id) : "0" };
+  },
+
+  toJSON(message: GetConfigRequest): unknown {
+    const obj: any = {};
+    if (message.id !== "0") {
+      obj.id = message.id;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GetConfigRequest>, I>>(base?: I): GetConfigRequest {
+    return GetConfigRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GetConfigRequest>, I>>(object: I): GetConfigRequest {
+    const message = createBaseGetConfigRequest();
+    message.id = object.id ?? "0";
+    return message;
+  },
+};
+
+function createBaseGetConfigResponse(): GetConfigResponse {
+  return { config: undefined, message: "" };
+}
+
+export const GetConfigResponse: MessageFns<GetConfigResponse> = {
+  encode(message: GetConfigResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.config !== undefined) {
+      Config.encode(message.config, writer.uint32(10).fork()).join();
+    }
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetConfigResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetConfigResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.config = Config.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetConfigResponse {
+    return {
+      config: isSet(object.config) ? Config.fromJSON(object.config) : undefined,
+      message: isSet(object.message) ? globalThis.String(object.message) : "",
+    };
+  },
+
+  toJSON(message: GetConfigResponse): unknown {
+    const obj: any = {};
+    if (message.config !== undefined) {
+      obj.config = Config.toJSON(message.config);
+    }
+    if (message.message !== "") {
+      obj.message = message.message;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GetConfigResponse>, I>>(base?: I): GetConfigResponse {
+    return GetConfigResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GetConfigResponse>, I>>(object: I): GetConfigResponse {
+    const message = createBaseGetConfigResponse();
+    message.config = (object.config !== undefined && object.config !== null)
+      ? Config.fromPartial(object.config)
+      : undefined;
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBaseListConfigsRequest(): ListConfigsRequest {
+  return { limit: 0, offset: 0, search: "" };
+}
+
+export const ListConfigsRequest: MessageFns<ListConfigsRequest> = {
+  encode(message: ListConfigsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.limit !== 0) {
+      writer.uint32(8).int32(message.limit);
+    }
+    if (message.offset !== 0) {
+      writer.uint32(16).int32(message.offset);
+    }
+    if (message.search !== "") {
+      writer.uint32(26).string(message.search);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListConfigsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListConfigsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.offset = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.search = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListConfigsRequest {
+    return {
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+      offset: isSet(object.offset) ? globalThis.Number(object.offset) : 0,
+      search: isSet(object.search) ? globalThis.String(object.search) : "",
+    };
+  },
+
+  toJSON(message: ListConfigsRequest): unknown {
+    const obj: any = {};
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    if (message.offset !== 0) {
+      obj.offset = Math.round(message.offset);
+    }
+    if (message.search !== "") {
+      obj.search = message.search;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListConfigsRequest>, I>>(base?: I): ListConfigsRequest {
+    return ListConfigsRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListConfigsRequest>, I>>(object: I): ListConfigsRequest {
+    const message = createBaseListConfigsRequest();
+    message.limit = object.limit ?? 0;
+    message.offset = object.offset ?? 0;
+    message.search = object.search ?? "";
+    return message;
+  },
+};
+
+function createBaseListConfigsResponse(): ListConfigsResponse {
+  return { configs: [], total: 0, message: "" };
+}
+
+export const ListConfigsResponse: MessageFns<ListConfigsResponse> = {
+  encode(message: ListConfigsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.configs) {
+      Config.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.total !== 0) {
+      writer.uint32(16).int32(message.total);
+    }
+    if (message.message !== "") {
+      writer.uint32(26).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListConfigsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListConfigsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.configs.push(Config.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.total = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListConfigsResponse {
+    return {
+      configs: globalThis.Array.isArray(object?.configs) ? object.configs.map((e: any) => Config.fromJSON(e)) : [],
+      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
+      message: isSet(object.message) ? globalThis.String(object.message) : "",
+    };
+  },
+
+  toJSON(message: ListConfigsResponse): unknown {
+    const obj: any = {};
+    if (message.configs?.length) {
+      obj.configs = message.configs.map((e) => Config.toJSON(e));
+    }
+    if (message.total !== 0) {
+      obj.total = Math.round(message.total);
+    }
+    if (message.message !== "") {
+      obj.message = message.message;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListConfigsResponse>, I>>(base?: I): ListConfigsResponse {
+    return ListConfigsResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListConfigsResponse>, I>>(object: I): ListConfigsResponse {
+    const message = createBaseListConfigsResponse();
+    message.configs = object.configs?.map((e) => Config.fromPartial(e)) || [];
+    message.total = object.total ?? 0;
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBaseGetRoutesRequest(): GetRoutesRequest {
+  return {};
+}
 
-          message.updatedAt = reader.int64().toString();
-          continue;
-        }
+export const GetRoutesRequest: MessageFns<GetRoutesRequest> = {
+  encode(_: GetRoutesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetRoutesRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetRoutesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -447,100 +4092,25 @@ export const Config: MessageFns<Config> = {
     return message;
   },
 
-  fromJSON(object: any): Config {
-    return {
-      id: isSet(object.id) ? globalThis.String(object.id) : "0",
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      pathPrefix: isSet(object.pathPrefix)
-        ? globalThis.String(object.pathPrefix)
-        : isSet(object.path_prefix)
-        ? globalThis.String(object.path_prefix)
-        : "",
-      targetUrl: isSet(object.targetUrl)
-        ? globalThis.String(object.targetUrl)
-        : isSet(object.target_url)
-        ? globalThis.String(object.target_url)
-        : "",
-      stripPrefix: isSet(object.stripPrefix)
-        ? globalThis.Boolean(object.stripPrefix)
-        : isSet(object.strip_prefix)
-        ? globalThis.Boolean(object.strip_prefix)
-        : false,
-      authentication: isSet(object.authentication) ? Authentication.fromJSON(object.authentication) : undefined,
-      middleware: globalThis.Array.isArray(object?.middleware)
-        ? object.middleware.map((e: any) => globalThis.String(e))
-        : [],
-      timeout: isSet(object.timeout) ? globalThis.String(object.timeout) : "0",
-      createdAt: isSet(object.createdAt)
-        ? globalThis.String(object.createdAt)
-        : isSet(object.created_at)
-        ? globalThis.String(object.created_at)
-        : "0",
-      updatedAt: isSet(object.updatedAt)
-        ? globalThis.String(object.updatedAt)
-        : isSet(object.updated_at)
-        ? globalThis.String(object.updated_at)
-        : "0",
-    };
+  fromJSON(_: any): GetRoutesRequest {
+    return {};
   },
 
-  toJSON(message: Config): unknown {
+  toJSON(_: GetRoutesRequest): unknown {
     const obj: any = {};
-    if (message.id !== "0") {
-      obj.id = message.id;
-    }
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.pathPrefix !== "") {
-      obj.pathPrefix = message.pathPrefix;
-    }
-    if (message.targetUrl !== "") {
-      obj.targetUrl = message.targetUrl;
-    }
-    if (message.stripPrefix !== false) {
-      obj.stripPrefix = message.stripPrefix;
-    }
-    if (message.authentication !== undefined) {
-      obj.authentication = Authentication.toJSON(message.authentication);
-    }
-    if (message.middleware?.length) {
-      obj.middleware = message.middleware;
-    }
-    if (message.timeout !== "0") {
-      obj.timeout = message.timeout;
-    }
-    if (message.createdAt !== "0") {
-      obj.createdAt = message.createdAt;
-    }
-    if (message.updatedAt !== "0") {
-      obj.updatedAt = message.updatedAt;
-    }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Config>, I>>(base?: I): Config {
-    return Config.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<GetRoutesRequest>, I>>(base?: I): GetRoutesRequest {
+    return GetRoutesRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Config>, I>>(object: I): Config {
-    const message = createBaseConfig();
-    message.id = object.id ?? "0";
-    message.name = object.name ?? "";
-    message.pathPrefix = object.pathPrefix ?? "";
-    message.targetUrl = object.targetUrl ?? "";
-    message.stripPrefix = object.stripPrefix ?? false;
-    message.authentication = (object.authentication !== undefined && object.authentication !== null)
-      ? Authentication.fromPartial(object.authentication)
-      : undefined;
-    message.middleware = object.middleware?.map((e) => e) || [];
-    message.timeout = object.timeout ?? "0";
-    message.createdAt = object.createdAt ?? "0";
-    message.updatedAt = object.updatedAt ?? "0";
+  fromPartial<I extends Exact<DeepPartial<GetRoutesRequest>, I>>(_: I): GetRoutesRequest {
+    const message = createBaseGetRoutesRequest();
     return message;
   },
 };
 
-function createBaseCreateConfigRequest(): CreateConfigRequest {
+function createBaseRoute(): Route {
   return {
     name: "",
     pathPrefix: "",
@@ -549,11 +4119,26 @@ function createBaseCreateConfigRequest(): CreateConfigRequest {
     authentication: undefined,
     middleware: [],
     timeout: "0",
+    updatedAt: "0",
+    targets: [],
+    loadBalancer: undefined,
+    healthCheck: undefined,
+    circuitBreaker: undefined,
+    retryPolicy: undefined,
+    middlewareConfig: undefined,
+    hosts: [],
+    match: undefined,
+    priority: 0,
+    rewrite: undefined,
+    split: undefined,
+    mirror: undefined,
+    identity: undefined,
+    rateLimit: undefined,
   };
 }
 
-export const CreateConfigRequest: MessageFns<CreateConfigRequest> = {
-  encode(message: CreateConfigRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const Route: MessageFns<Route> = {
+  encode(message: Route, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
@@ -575,13 +4160,58 @@ export const CreateConfigRequest: MessageFns<CreateConfigRequest> = {
     if (message.timeout !== "0") {
       writer.uint32(56).int64(message.timeout);
     }
+    if (message.updatedAt !== "0") {
+      writer.uint32(64).int64(message.updatedAt);
+    }
+    for (const v of message.targets) {
+      Target.encode(v!, writer.uint32(74).fork()).join();
+    }
+    if (message.loadBalancer !== undefined) {
+      LoadBalancer.encode(message.loadBalancer, writer.uint32(82).fork()).join();
+    }
+    if (message.healthCheck !== undefined) {
+      HealthCheck.encode(message.healthCheck, writer.uint32(90).fork()).join();
+    }
+    if (message.circuitBreaker !== undefined) {
+      CircuitBreaker.encode(message.circuitBreaker, writer.uint32(98).fork()).join();
+    }
+    if (message.retryPolicy !== undefined) {
+      RetryPolicy.encode(message.retryPolicy, writer.uint32(106).fork()).join();
+    }
+    if (message.middlewareConfig !== undefined) {
+      Struct.encode(Struct.wrap(message.middlewareConfig), writer.uint32(114).fork()).join();
+    }
+    for (const v of message.hosts) {
+      writer.uint32(122).string(v!);
+    }
+    if (message.match !== undefined) {
+      RouteMatch.encode(message.match, writer.uint32(130).fork()).join();
+    }
+    if (message.priority !== 0) {
+      writer.uint32(136).int32(message.priority);
+    }
+    if (message.rewrite !== undefined) {
+      Rewrite.encode(message.rewrite, writer.uint32(146).fork()).join();
+    }
+    if (message.split !== undefined) {
+      TrafficSplit.encode(message.split, writer.uint32(154).fork()).join();
+    }
+    if (message.mirror !== undefined) {
+      Mirror.encode(message.mirror, writer.uint32(162).fork()).join();
+    }
+    if (message.identity !== undefined) {
+      Identity.encode(message.identity, writer.uint32(170).fork()).join();
+    }
+    if (message.rateLimit !== undefined) {
+      RateLimit.encode(message.rateLimit, writer.uint32(178).fork()).join();
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): CreateConfigRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): Route {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCreateConfigRequest();
+    const message = createBaseRoute();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -641,6 +4271,126 @@ export const CreateConfigRequest: MessageFns<CreateConfigRequest> = {
           message.timeout = reader.int64().toString();
           continue;
         }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.updatedAt = reader.int64().toString();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.targets.push(Target.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.loadBalancer = LoadBalancer.decode(reader, reader.uint32());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.healthCheck = HealthCheck.decode(reader, reader.uint32());
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.circuitBreaker = CircuitBreaker.decode(reader, reader.uint32());
+          continue;
+        }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.retryPolicy = RetryPolicy.decode(reader, reader.uint32());
+          continue;
+        }
+        case 14: {
+          if (tag !== 114) {
+            break;
+          }
+
+          message.middlewareConfig = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 15: {
+          if (tag !== 122) {
+            break;
+          }
+
+          message.hosts.push(reader.string());
+          continue;
+        }
+        case 16: {
+          if (tag !== 130) {
+            break;
+          }
+
+          message.match = RouteMatch.decode(reader, reader.uint32());
+          continue;
+        }
+        case 17: {
+          if (tag !== 136) {
+            break;
+          }
+
+          message.priority = reader.int32();
+          continue;
+        }
+        case 18: {
+          if (tag !== 146) {
+            break;
+          }
+
+          message.rewrite = Rewrite.decode(reader, reader.uint32());
+          continue;
+        }
+        case 19: {
+          if (tag !== 154) {
+            break;
+          }
+
+          message.split = TrafficSplit.decode(reader, reader.uint32());
+          continue;
+        }
+        case 20: {
+          if (tag !== 162) {
+            break;
+          }
+
+          message.mirror = Mirror.decode(reader, reader.uint32());
+          continue;
+        }
+        case 21: {
+          if (tag !== 170) {
+            break;
+          }
+
+          message.identity = Identity.decode(reader, reader.uint32());
+          continue;
+        }
+        case 22: {
+          if (tag !== 178) {
+            break;
+          }
+
+          message.rateLimit = RateLimit.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -650,7 +4400,7 @@ export const CreateConfigRequest: MessageFns<CreateConfigRequest> = {
     return message;
   },
 
-  fromJSON(object: any): CreateConfigRequest {
+  fromJSON(object: any): Route {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
       pathPrefix: isSet(object.pathPrefix)
@@ -673,10 +4423,49 @@ export const CreateConfigRequest: MessageFns<CreateConfigRequest> = {
         ? object.middleware.map((e: any) => globalThis.String(e))
         : [],
       timeout: isSet(object.timeout) ? globalThis.String(object.timeout) : "0",
+      updatedAt: isSet(object.updatedAt)
+        ? globalThis.String(object.updatedAt)
+        : isSet(object.updated_at)
+        ? globalThis.String(object.updated_at)
+        : "0",
+      targets: globalThis.Array.isArray(object?.targets) ? object.targets.map((e: any) => Target.fromJSON(e)) : [],
+      loadBalancer: isSet(object.loadBalancer)
+        ? LoadBalancer.fromJSON(object.loadBalancer)
+        : isSet(object.load_balancer)
+        ? LoadBalancer.fromJSON(object.load_balancer)
+        : undefined,
+      healthCheck: isSet(object.healthCheck)
+        ? HealthCheck.fromJSON(object.healthCheck)
+        : isSet(object.health_check)
+        ? HealthCheck.fromJSON(object.health_check)
+        : undefined,
+      circuitBreaker: isSet(object.circuitBreaker)
+        ? CircuitBreaker.fromJSON(object.circuitBreaker)
+        : isSet(object.circuit_breaker)
+        ? CircuitBreaker.fromJSON(object.circuit_breaker)
+        : undefined,
+      retryPolicy: isSet(object.retryPolicy)
+        ? RetryPolicy.fromJSON(object.retryPolicy)
+        : isSet(object.retry_policy)
+        ? RetryPolicy.fromJSON(object.retry_policy)
+        : undefined,
+      middlewareConfig: isObject(object.middlewareConfig) ? object.middlewareConfig : undefined,
+      hosts: globalThis.Array.isArray(object?.hosts) ? object.hosts.map((e: any) => globalThis.String(e)) : [],
+      match: isSet(object.match) ? RouteMatch.fromJSON(object.match) : undefined,
+      priority: isSet(object.priority) ? globalThis.Number(object.priority) : 0,
+      rewrite: isSet(object.rewrite) ? Rewrite.fromJSON(object.rewrite) : undefined,
+      split: isSet(object.split) ? TrafficSplit.fromJSON(object.split) : undefined,
+      mirror: isSet(object.mirror) ? Mirror.fromJSON(object.mirror) : undefined,
+      identity: isSet(object.identity) ? Identity.fromJSON(object.identity) : undefined,
+      rateLimit: isSet(object.rateLimit)
+        ? RateLimit.fromJSON(object.rateLimit)
+        : isSet(object.rate_limit)
+        ? RateLimit.fromJSON(object.rate_limit)
+        : undefined,
     };
   },
 
-  toJSON(message: CreateConfigRequest): unknown {
+  toJSON(message: Route): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
@@ -687,26 +4476,71 @@ export const CreateConfigRequest: MessageFns<CreateConfigRequest> = {
     if (message.targetUrl !== "") {
       obj.targetUrl = message.targetUrl;
     }
-    if (message.stripPrefix !== false) {
-      obj.stripPrefix = message.stripPrefix;
+    if (message.stripPrefix !== false) {
+      obj.stripPrefix = message.stripPrefix;
+    }
+    if (message.authentication !== undefined) {
+      obj.authentication = Authentication.toJSON(message.authentication);
+    }
+    if (message.middleware?.length) {
+      obj.middleware = message.middleware;
+    }
+    if (message.timeout !== "0") {
+      obj.timeout = message.timeout;
+    }
+    if (message.updatedAt !== "0") {
+      obj.updatedAt = message.updatedAt;
+    }
+    if (message.targets?.length) {
+      obj.targets = message.targets.map((e) => Target.toJSON(e));
+    }
+    if (message.loadBalancer !== undefined) {
+      obj.loadBalancer = LoadBalancer.toJSON(message.loadBalancer);
+    }
+    if (message.healthCheck !== undefined) {
+      obj.healthCheck = HealthCheck.toJSON(message.healthCheck);
+    }
+    if (message.circuitBreaker !== undefined) {
+      obj.circuitBreaker = CircuitBreaker.toJSON(message.circuitBreaker);
+    }
+    if (message.retryPolicy !== undefined) {
+      obj.retryPolicy = RetryPolicy.toJSON(message.retryPolicy);
+    }
+    if (message.middlewareConfig !== undefined) {
+      obj.middlewareConfig = message.middlewareConfig;
+    }
+    if (message.hosts?.length) {
+      obj.hosts = message.hosts;
+    }
+    if (message.match !== undefined) {
+      obj.match = RouteMatch.toJSON(message.match);
+    }
+    if (message.priority !== 0) {
+      obj.priority = Math.round(message.priority);
+    }
+    if (message.rewrite !== undefined) {
+      obj.rewrite = Rewrite.toJSON(message.rewrite);
     }
-    if (message.authentication !== undefined) {
-      obj.authentication = Authentication.toJSON(message.authentication);
+    if (message.split !== undefined) {
+      obj.split = TrafficSplit.toJSON(message.split);
     }
-    if (message.middleware?.length) {
-      obj.middleware = message.middleware;
+    if (message.mirror !== undefined) {
+      obj.mirror = Mirror.toJSON(message.mirror);
     }
-    if (message.timeout !== "0") {
-      obj.timeout = message.timeout;
+    if (message.identity !== undefined) {
+      obj.identity = Identity.toJSON(message.identity);
+    }
+    if (message.rateLimit !== undefined) {
+      obj.rateLimit = RateLimit.toJSON(message.rateLimit);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<CreateConfigRequest>, I>>(base?: I): CreateConfigRequest {
-    return CreateConfigRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<Route>, I>>(base?: I): Route {
+    return Route.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<CreateConfigRequest>, I>>(object: I): CreateConfigRequest {
-    const message = createBaseCreateConfigRequest();
+  fromPartial<I extends Exact<DeepPartial<Route>, I>>(object: I): Route {
+    const message = createBaseRoute();
     message.name = object.name ?? "";
     message.pathPrefix = object.pathPrefix ?? "";
     message.targetUrl = object.targetUrl ?? "";
@@ -716,18 +4550,53 @@ export const CreateConfigRequest: MessageFns<CreateConfigRequest> = {
       : undefined;
     message.middleware = object.middleware?.map((e) => e) || [];
     message.timeout = object.timeout ?? "0";
+    message.updatedAt = object.updatedAt ?? "0";
+    message.targets = object.targets?.map((e) => Target.fromPartial(e)) || [];
+    message.loadBalancer = (object.loadBalancer !== undefined && object.loadBalancer !== null)
+      ? LoadBalancer.fromPartial(object.loadBalancer)
+      : undefined;
+    message.healthCheck = (object.healthCheck !== undefined && object.healthCheck !== null)
+      ? HealthCheck.fromPartial(object.healthCheck)
+      : undefined;
+    message.circuitBreaker = (object.circuitBreaker !== undefined && object.circuitBreaker !== null)
+      ? CircuitBreaker.fromPartial(object.circuitBreaker)
+      : undefined;
+    message.retryPolicy = (object.retryPolicy !== undefined && object.retryPolicy !== null)
+      ? RetryPolicy.fromPartial(object.retryPolicy)
+      : undefined;
+    message.middlewareConfig = object.middlewareConfig ?? undefined;
+    message.hosts = object.hosts?.map((e) => e) || [];
+    message.match = (object.match !== undefined && object.match !== null)
+      ? RouteMatch.fromPartial(object.match)
+      : undefined;
+    message.priority = object.priority ?? 0;
+    message.rewrite = (object.rewrite !== undefined && object.rewrite !== null)
+      ? Rewrite.fromPartial(object.rewrite)
+      : undefined;
+    message.split = (object.split !== undefined && object.split !== null)
+      ? TrafficSplit.fromPartial(object.split)
+      : undefined;
+    message.mirror = (object.mirror !== undefined && object.mirror !== null)
+      ? Mirror.fromPartial(object.mirror)
+      : undefined;
+    message.identity = (object.identity !== undefined && object.identity !== null)
+      ? Identity.fromPartial(object.identity)
+      : undefined;
+    message.rateLimit = (object.rateLimit !== undefined && object.rateLimit !== null)
+      ? RateLimit.fromPartial(object.rateLimit)
+      : undefined;
     return message;
   },
 };
 
-function createBaseCreateConfigResponse(): CreateConfigResponse {
-  return { config: undefined, message: "" };
+function createBaseGetRoutesResponse(): GetRoutesResponse {
+  return { routes: [], message: "" };
 }
 
-export const CreateConfigResponse: MessageFns<CreateConfigResponse> = {
-  encode(message: CreateConfigResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.config !== undefined) {
-      Config.encode(message.config, writer.uint32(10).fork()).join();
+export const GetRoutesResponse: MessageFns<GetRoutesResponse> = {
+  encode(message: GetRoutesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.routes) {
+      Route.encode(v!, writer.uint32(10).fork()).join();
     }
     if (message.message !== "") {
       writer.uint32(18).string(message.message);
@@ -735,10 +4604,10 @@ export const CreateConfigResponse: MessageFns<CreateConfigResponse> = {
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): CreateConfigResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): GetRoutesResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCreateConfigResponse();
+    const message = createBaseGetRoutesResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -747,7 +4616,7 @@ export const CreateConfigResponse: MessageFns<CreateConfigResponse> = {
             break;
           }
 
-          message.config = Config.decode(reader, reader.uint32());
+          message.routes.push(Route.decode(reader, reader.uint32()));
           continue;
         }
         case 2: {
@@ -767,17 +4636,17 @@ export const CreateConfigResponse: MessageFns<CreateConfigResponse> = {
     return message;
   },
 
-  fromJSON(object: any): CreateConfigResponse {
+  fromJSON(object: any): GetRoutesResponse {
     return {
-      config: isSet(object.config) ? Config.fromJSON(object.config) : undefined,
+      routes: globalThis.Array.isArray(object?.routes) ? object.routes.map((e: any) => Route.fromJSON(e)) : [],
       message: isSet(object.message) ? globalThis.String(object.message) : "",
     };
   },
 
-  toJSON(message: CreateConfigResponse): unknown {
+  toJSON(message: GetRoutesResponse): unknown {
     const obj: any = {};
-    if (message.config !== undefined) {
-      obj.config = Config.toJSON(message.config);
+    if (message.routes?.length) {
+      obj.routes = message.routes.map((e) => Route.toJSON(e));
     }
     if (message.message !== "") {
       obj.message = message.message;
@@ -785,96 +4654,36 @@ export const CreateConfigResponse: MessageFns<CreateConfigResponse> = {
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<CreateConfigResponse>, I>>(base?: I): CreateConfigResponse {
-    return CreateConfigResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<GetRoutesResponse>, I>>(base?: I): GetRoutesResponse {
+    return GetRoutesResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<CreateConfigResponse>, I>>(object: I): CreateConfigResponse {
-    const message = createBaseCreateConfigResponse();
-    message.config = (object.config !== undefined && object.config !== null)
-      ? Config.fromPartial(object.config)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<GetRoutesResponse>, I>>(object: I): GetRoutesResponse {
+    const message = createBaseGetRoutesResponse();
+    message.routes = object.routes?.map((e) => Route.fromPartial(e)) || [];
     message.message = object.message ?? "";
     return message;
   },
 };
 
-function createBaseGetConfigRequest(): GetConfigRequest {
-  return { id: "0" };
-}
-
-export const GetConfigRequest: MessageFns<GetConfigRequest> = {
-  encode(message: GetConfigRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== "0") {
-      writer.uint32(8).int64(message.id);
-    }
-    return writer;
-  },
-
-  decode(input: BinaryReader | Uint8Array, length?: number): GetConfigRequest {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetConfigRequest();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 8) {
-            break;
-          }
-
-          message.id = reader.int64().toString();
-          continue;
-        }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): GetConfigRequest {
-    return { id: isSet(object.id) ? globalThis.String(object.id) : "0" };
-  },
-
-  toJSON(message: GetConfigRequest): unknown {
-    const obj: any = {};
-    if (message.id !== "0") {
-      obj.id = message.id;
-    }
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<GetConfigRequest>, I>>(base?: I): GetConfigRequest {
-    return GetConfigRequest.fromPartial(base ?? ({} as any));
-  },
-  fromPartial<I extends Exact<DeepPartial<GetConfigRequest>, I>>(object: I): GetConfigRequest {
-    const message = createBaseGetConfigRequest();
-    message.id = object.id ?? "0";
-    return message;
-  },
-};
-
-function createBaseGetConfigResponse(): GetConfigResponse {
-  return { config: undefined, message: "" };
+function createBaseKeyValue(): KeyValue {
+  return { key: "", value: "" };
 }
 
-export const GetConfigResponse: MessageFns<GetConfigResponse> = {
-  encode(message: GetConfigResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.config !== undefined) {
-      Config.encode(message.config, writer.uint32(10).fork()).join();
+export const KeyValue: MessageFns<KeyValue> = {
+  encode(message: KeyValue, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    if (message.message !== "") {
-      writer.uint32(18).string(message.message);
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetConfigResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): KeyValue {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetConfigResponse();
+    const message = createBaseKeyValue();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -883,7 +4692,7 @@ export const GetConfigResponse: MessageFns<GetConfigResponse> = {
             break;
           }
 
-          message.config = Config.decode(reader, reader.uint32());
+          message.key = reader.string();
           continue;
         }
         case 2: {
@@ -891,7 +4700,7 @@ export const GetConfigResponse: MessageFns<GetConfigResponse> = {
             break;
           }
 
-          message.message = reader.string();
+          message.value = reader.string();
           continue;
         }
       }
@@ -903,76 +4712,77 @@ export const GetConfigResponse: MessageFns<GetConfigResponse> = {
     return message;
   },
 
-  fromJSON(object: any): GetConfigResponse {
+  fromJSON(object: any): KeyValue {
     return {
-      config: isSet(object.config) ? Config.fromJSON(object.config) : undefined,
-      message: isSet(object.message) ? globalThis.String(object.message) : "",
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
     };
   },
 
-  toJSON(message: GetConfigResponse): unknown {
+  toJSON(message: KeyValue): unknown {
     const obj: any = {};
-    if (message.config !== undefined) {
-      obj.config = Config.toJSON(message.config);
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.message !== "") {
-      obj.message = message.message;
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<GetConfigResponse>, I>>(base?: I): GetConfigResponse {
-    return GetConfigResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<KeyValue>, I>>(base?: I): KeyValue {
+    return KeyValue.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<GetConfigResponse>, I>>(object: I): GetConfigResponse {
-    const message = createBaseGetConfigResponse();
-    message.config = (object.config !== undefined && object.config !== null)
-      ? Config.fromPartial(object.config)
-      : undefined;
-    message.message = object.message ?? "";
+  fromPartial<I extends Exact<DeepPartial<KeyValue>, I>>(object: I): KeyValue {
+    const message = createBaseKeyValue();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBaseListConfigsRequest(): ListConfigsRequest {
-  return { limit: 0, offset: 0, search: "" };
+function createBaseTestRouteRequest(): TestRouteRequest {
+  return { method: "", host: "", path: "", headers: [] };
 }
 
-export const ListConfigsRequest: MessageFns<ListConfigsRequest> = {
-  encode(message: ListConfigsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.limit !== 0) {
-      writer.uint32(8).int32(message.limit);
+export const TestRouteRequest: MessageFns<TestRouteRequest> = {
+  encode(message: TestRouteRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.method !== "") {
+      writer.uint32(10).string(message.method);
     }
-    if (message.offset !== 0) {
-      writer.uint32(16).int32(message.offset);
+    if (message.host !== "") {
+      writer.uint32(18).string(message.host);
     }
-    if (message.search !== "") {
-      writer.uint32(26).string(message.search);
+    if (message.path !== "") {
+      writer.uint32(26).string(message.path);
+    }
+    for (const v of message.headers) {
+      KeyValue.encode(v!, writer.uint32(34).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ListConfigsRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): TestRouteRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListConfigsRequest();
+    const message = createBaseTestRouteRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.limit = reader.int32();
+          message.method = reader.string();
           continue;
         }
         case 2: {
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.offset = reader.int32();
+          message.host = reader.string();
           continue;
         }
         case 3: {
@@ -980,7 +4790,15 @@ export const ListConfigsRequest: MessageFns<ListConfigsRequest> = {
             break;
           }
 
-          message.search = reader.string();
+          message.path = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.headers.push(KeyValue.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -992,83 +4810,152 @@ export const ListConfigsRequest: MessageFns<ListConfigsRequest> = {
     return message;
   },
 
-  fromJSON(object: any): ListConfigsRequest {
+  fromJSON(object: any): TestRouteRequest {
     return {
-      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
-      offset: isSet(object.offset) ? globalThis.Number(object.offset) : 0,
-      search: isSet(object.search) ? globalThis.String(object.search) : "",
+      method: isSet(object.method) ? globalThis.String(object.method) : "",
+      host: isSet(object.host) ? globalThis.String(object.host) : "",
+      path: isSet(object.path) ? globalThis.String(object.path) : "",
+      headers: globalThis.Array.isArray(object?.headers) ? object.headers.map((e: any) => KeyValue.fromJSON(e)) : [],
     };
   },
 
-  toJSON(message: ListConfigsRequest): unknown {
+  toJSON(message: TestRouteRequest): unknown {
     const obj: any = {};
-    if (message.limit !== 0) {
-      obj.limit = Math.round(message.limit);
+    if (message.method !== "") {
+      obj.method = message.method;
     }
-    if (message.offset !== 0) {
-      obj.offset = Math.round(message.offset);
+    if (message.host !== "") {
+      obj.host = message.host;
     }
-    if (message.search !== "") {
-      obj.search = message.search;
+    if (message.path !== "") {
+      obj.path = message.path;
+    }
+    if (message.headers?.length) {
+      obj.headers = message.headers.map((e) => KeyValue.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ListConfigsRequest>, I>>(base?: I): ListConfigsRequest {
-    return ListConfigsRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<TestRouteRequest>, I>>(base?: I): TestRouteRequest {
+    return TestRouteRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ListConfigsRequest>, I>>(object: I): ListConfigsRequest {
-    const message = createBaseListConfigsRequest();
-    message.limit = object.limit ?? 0;
-    message.offset = object.offset ?? 0;
-    message.search = object.search ?? "";
+  fromPartial<I extends Exact<DeepPartial<TestRouteRequest>, I>>(object: I): TestRouteRequest {
+    const message = createBaseTestRouteRequest();
+    message.method = object.method ?? "";
+    message.host = object.host ?? "";
+    message.path = object.path ?? "";
+    message.headers = object.headers?.map((e) => KeyValue.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseListConfigsResponse(): ListConfigsResponse {
-  return { configs: [], total: 0, message: "" };
+function createBaseTestRouteResponse(): TestRouteResponse {
+  return {
+    matched: false,
+    route: "",
+    pathParams: [],
+    matchedPrefix: "",
+    upstreamPath: "",
+    upstreamHost: "",
+    targets: [],
+    message: "",
+  };
 }
 
-export const ListConfigsResponse: MessageFns<ListConfigsResponse> = {
-  encode(message: ListConfigsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.configs) {
-      Config.encode(v!, writer.uint32(10).fork()).join();
+export const TestRouteResponse: MessageFns<TestRouteResponse> = {
+  encode(message: TestRouteResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.matched !== false) {
+      writer.uint32(8).bool(message.matched);
     }
-    if (message.total !== 0) {
-      writer.uint32(16).int32(message.total);
+    if (message.route !== "") {
+      writer.uint32(18).string(message.route);
+    }
+    for (const v of message.pathParams) {
+      KeyValue.encode(v!, writer.uint32(26).fork()).join();
+    }
+    if (message.matchedPrefix !== "") {
+      writer.uint32(34).string(message.matchedPrefix);
+    }
+    if (message.upstreamPath !== "") {
+      writer.uint32(42).string(message.upstreamPath);
+    }
+    if (message.upstreamHost !== "") {
+      writer.uint32(50).string(message.upstreamHost);
+    }
+    for (const v of message.targets) {
+      writer.uint32(58).string(v!);
     }
     if (message.message !== "") {
-      writer.uint32(26).string(message.message);
+      writer.uint32(66).string(message.message);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ListConfigsResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): TestRouteResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListConfigsResponse();
+    const message = createBaseTestRouteResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.matched = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.route = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pathParams.push(KeyValue.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.matchedPrefix = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.upstreamPath = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
             break;
           }
 
-          message.configs.push(Config.decode(reader, reader.uint32()));
+          message.upstreamHost = reader.string();
           continue;
         }
-        case 2: {
-          if (tag !== 16) {
+        case 7: {
+          if (tag !== 58) {
             break;
           }
 
-          message.total = reader.int32();
+          message.targets.push(reader.string());
           continue;
         }
-        case 3: {
-          if (tag !== 26) {
+        case 8: {
+          if (tag !== 66) {
             break;
           }
 
@@ -1084,21 +4971,57 @@ export const ListConfigsResponse: MessageFns<ListConfigsResponse> = {
     return message;
   },
 
-  fromJSON(object: any): ListConfigsResponse {
+  fromJSON(object: any): TestRouteResponse {
     return {
-      configs: globalThis.Array.isArray(object?.configs) ? object.configs.map((e: any) => Config.fromJSON(e)) : [],
-      total: isSet(object.total) ? globalThis.Number(object.total) : 0,
+      matched: isSet(object.matched) ? globalThis.Boolean(object.matched) : false,
+      route: isSet(object.route) ? globalThis.String(object.route) : "",
+      pathParams: globalThis.Array.isArray(object?.pathParams)
+        ? object.pathParams.map((e: any) => KeyValue.fromJSON(e))
+        : globalThis.Array.isArray(object?.path_params)
+        ? object.path_params.map((e: any) => KeyValue.fromJSON(e))
+        : [],
+      matchedPrefix: isSet(object.matchedPrefix)
+        ? globalThis.String(object.matchedPrefix)
+        : isSet(object.matched_prefix)
+        ? globalThis.String(object.matched_prefix)
+        : "",
+      upstreamPath: isSet(object.upstreamPath)
+        ? globalThis.String(object.upstreamPath)
+        : isSet(object.upstream_path)
+        ? globalThis.String(object.upstream_path)
+        : "",
+      upstreamHost: isSet(object.upstreamHost)
+        ? globalThis.String(object.upstreamHost)
+        : isSet(object.upstream_host)
+        ? globalThis.String(object.upstream_host)
+        : "",
+      targets: globalThis.Array.isArray(object?.targets) ? object.targets.map((e: any) => globalThis.String(e)) : [],
       message: isSet(object.message) ? globalThis.String(object.message) : "",
     };
   },
 
-  toJSON(message: ListConfigsResponse): unknown {
+  toJSON(message: TestRouteResponse): unknown {
     const obj: any = {};
-    if (message.configs?.length) {
-      obj.configs = message.configs.map((e) => Config.toJSON(e));
+    if (message.matched !== false) {
+      obj.matched = message.matched;
     }
-    if (message.total !== 0) {
-      obj.total = Math.round(message.total);
+    if (message.route !== "") {
+      obj.route = message.route;
+    }
+    if (message.pathParams?.length) {
+      obj.pathParams = message.pathParams.map((e) => KeyValue.toJSON(e));
+    }
+    if (message.matchedPrefix !== "") {
+      obj.matchedPrefix = message.matchedPrefix;
+    }
+    if (message.upstreamPath !== "") {
+      obj.upstreamPath = message.upstreamPath;
+    }
+    if (message.upstreamHost !== "") {
+      obj.upstreamHost = message.upstreamHost;
+    }
+    if (message.targets?.length) {
+      obj.targets = message.targets;
     }
     if (message.message !== "") {
       obj.message = message.message;
@@ -1106,63 +5029,26 @@ export const ListConfigsResponse: MessageFns<ListConfigsResponse> = {
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ListConfigsResponse>, I>>(base?: I): ListConfigsResponse {
-    return ListConfigsResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<TestRouteResponse>, I>>(base?: I): TestRouteResponse {
+    return TestRouteResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ListConfigsResponse>, I>>(object: I): ListConfigsResponse {
-    const message = createBaseListConfigsResponse();
-    message.configs = object.configs?.map((e) => Config.fromPartial(e)) || [];
-    message.total = object.total ?? 0;
+  fromPartial<I extends Exact<DeepPartial<TestRouteResponse>, I>>(object: I): TestRouteResponse {
+    const message = createBaseTestRouteResponse();
+    message.matched = object.matched ?? false;
+    message.route = object.route ?? "";
+    message.pathParams = object.pathParams?.map((e) => KeyValue.fromPartial(e)) || [];
+    message.matchedPrefix = object.matchedPrefix ?? "";
+    message.upstreamPath = object.upstreamPath ?? "";
+    message.upstreamHost = object.upstreamHost ?? "";
+    message.targets = object.targets?.map((e) => e) || [];
     message.message = object.message ?? "";
     return message;
   },
 };
 
-function createBaseGetRoutesRequest(): GetRoutesRequest {
-  return {};
-}
-
-export const GetRoutesRequest: MessageFns<GetRoutesRequest> = {
-  encode(_: GetRoutesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    return writer;
-  },
-
-  decode(input: BinaryReader | Uint8Array, length?: number): GetRoutesRequest {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetRoutesRequest();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(_: any): GetRoutesRequest {
-    return {};
-  },
-
-  toJSON(_: GetRoutesRequest): unknown {
-    const obj: any = {};
-    return obj;
-  },
-
-  create<I extends Exact<DeepPartial<GetRoutesRequest>, I>>(base?: I): GetRoutesRequest {
-    return GetRoutesRequest.fromPartial(base ?? ({} as any));
-  },
-  fromPartial<I extends Exact<DeepPartial<GetRoutesRequest>, I>>(_: I): GetRoutesRequest {
-    const message = createBaseGetRoutesRequest();
-    return message;
-  },
-};
-
-function createBaseRoute(): Route {
+function createBaseUpdateConfigRequest(): UpdateConfigRequest {
   return {
+    id: "0",
     name: "",
     pathPrefix: "",
     targetUrl: "",
@@ -1170,52 +5056,107 @@ function createBaseRoute(): Route {
     authentication: undefined,
     middleware: [],
     timeout: "0",
-    updatedAt: "0",
+    targets: [],
+    loadBalancer: undefined,
+    healthCheck: undefined,
+    circuitBreaker: undefined,
+    retryPolicy: undefined,
+    middlewareConfig: undefined,
+    hosts: [],
+    match: undefined,
+    priority: 0,
+    rewrite: undefined,
+    split: undefined,
+    mirror: undefined,
+    identity: undefined,
+    rateLimit: undefined,
   };
 }
 
-export const Route: MessageFns<Route> = {
-  encode(message: Route, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const UpdateConfigRequest: MessageFns<UpdateConfigRequest> = {
+  encode(message: UpdateConfigRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "0") {
+      writer.uint32(8).int64(message.id);
+    }
     if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+      writer.uint32(18).string(message.name);
     }
     if (message.pathPrefix !== "") {
-      writer.uint32(18).string(message.pathPrefix);
+      writer.uint32(26).string(message.pathPrefix);
     }
     if (message.targetUrl !== "") {
-      writer.uint32(26).string(message.targetUrl);
+      writer.uint32(34).string(message.targetUrl);
     }
     if (message.stripPrefix !== false) {
-      writer.uint32(32).bool(message.stripPrefix);
+      writer.uint32(40).bool(message.stripPrefix);
     }
     if (message.authentication !== undefined) {
-      Authentication.encode(message.authentication, writer.uint32(42).fork()).join();
+      Authentication.encode(message.authentication, writer.uint32(50).fork()).join();
     }
     for (const v of message.middleware) {
-      writer.uint32(50).string(v!);
+      writer.uint32(58).string(v!);
     }
     if (message.timeout !== "0") {
-      writer.uint32(56).int64(message.timeout);
+      writer.uint32(64).int64(message.timeout);
     }
-    if (message.updatedAt !== "0") {
-      writer.uint32(64).int64(message.updatedAt);
+    for (const v of message.targets) {
+      Target.encode(v!, writer.uint32(74).fork()).join();
+    }
+    if (message.loadBalancer !== undefined) {
+      LoadBalancer.encode(message.loadBalancer, writer.uint32(82).fork()).join();
+    }
+    if (message.healthCheck !== undefined) {
+      HealthCheck.encode(message.healthCheck, writer.uint32(90).fork()).join();
+    }
+    if (message.circuitBreaker !== undefined) {
+      CircuitBreaker.encode(message.circuitBreaker, writer.uint32(98).fork()).join();
+    }
+    if (message.retryPolicy !== undefined) {
+      RetryPolicy.encode(message.retryPolicy, writer.uint32(106).fork()).join();
+    }
+    if (message.middlewareConfig !== undefined) {
+      Struct.encode(Struct.wrap(message.middlewareConfig), writer.uint32(114).fork()).join();
+    }
+    for (const v of message.hosts) {
+      writer.uint32(122).string(v!);
+    }
+    if (message.match !== undefined) {
+      RouteMatch.encode(message.match, writer.uint32(130).fork()).join();
+    }
+    if (message.priority !== 0) {
+      writer.uint32(136).int32(message.priority);
+    }
+    if (message.rewrite !== undefined) {
+      Rewrite.encode(message.rewrite, writer.uint32(146).fork()).join();
+    }
+    if (message.split !== undefined) {
+      TrafficSplit.encode(message.split, writer.uint32(154).fork()).join();
+    }
+    if (message.mirror !== undefined) {
+      Mirror.encode(message.mirror, writer.uint32(162).fork()).join();
+    }
+    if (message.identity !== undefined) {
+      Identity.encode(message.identity, writer.uint32(170).fork()).join();
+    }
+    if (message.rateLimit !== undefined) {
+      RateLimit.encode(message.rateLimit, writer.uint32(178).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Route {
+  decode(input: BinaryReader | Uint8Array, length?: number): UpdateConfigRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseRoute();
+    const message = createBaseUpdateConfigRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.name = reader.string();
+          message.id = reader.int64().toString();
           continue;
         }
         case 2: {
@@ -1223,7 +5164,7 @@ export const Route: MessageFns<Route> = {
             break;
           }
 
-          message.pathPrefix = reader.string();
+          message.name = reader.string();
           continue;
         }
         case 3: {
@@ -1231,23 +5172,23 @@ export const Route: MessageFns<Route> = {
             break;
           }
 
-          message.targetUrl = reader.string();
+          message.pathPrefix = reader.string();
           continue;
         }
         case 4: {
-          if (tag !== 32) {
+          if (tag !== 34) {
             break;
           }
 
-          message.stripPrefix = reader.bool();
+          message.targetUrl = reader.string();
           continue;
         }
         case 5: {
-          if (tag !== 42) {
+          if (tag !== 40) {
             break;
           }
 
-          message.authentication = Authentication.decode(reader, reader.uint32());
+          message.stripPrefix = reader.bool();
           continue;
         }
         case 6: {
@@ -1255,15 +5196,15 @@ export const Route: MessageFns<Route> = {
             break;
           }
 
-          message.middleware.push(reader.string());
+          message.authentication = Authentication.decode(reader, reader.uint32());
           continue;
         }
         case 7: {
-          if (tag !== 56) {
+          if (tag !== 58) {
             break;
           }
 
-          message.timeout = reader.int64().toString();
+          message.middleware.push(reader.string());
           continue;
         }
         case 8: {
@@ -1271,7 +5212,119 @@ export const Route: MessageFns<Route> = {
             break;
           }
 
-          message.updatedAt = reader.int64().toString();
+          message.timeout = reader.int64().toString();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.targets.push(Target.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.loadBalancer = LoadBalancer.decode(reader, reader.uint32());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.healthCheck = HealthCheck.decode(reader, reader.uint32());
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.circuitBreaker = CircuitBreaker.decode(reader, reader.uint32());
+          continue;
+        }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.retryPolicy = RetryPolicy.decode(reader, reader.uint32());
+          continue;
+        }
+        case 14: {
+          if (tag !== 114) {
+            break;
+          }
+
+          message.middlewareConfig = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 15: {
+          if (tag !== 122) {
+            break;
+          }
+
+          message.hosts.push(reader.string());
+          continue;
+        }
+        case 16: {
+          if (tag !== 130) {
+            break;
+          }
+
+          message.match = RouteMatch.decode(reader, reader.uint32());
+          continue;
+        }
+        case 17: {
+          if (tag !== 136) {
+            break;
+          }
+
+          message.priority = reader.int32();
+          continue;
+        }
+        case 18: {
+          if (tag !== 146) {
+            break;
+          }
+
+          message.rewrite = Rewrite.decode(reader, reader.uint32());
+          continue;
+        }
+        case 19: {
+          if (tag !== 154) {
+            break;
+          }
+
+          message.split = TrafficSplit.decode(reader, reader.uint32());
+          continue;
+        }
+        case 20: {
+          if (tag !== 162) {
+            break;
+          }
+
+          message.mirror = Mirror.decode(reader, reader.uint32());
+          continue;
+        }
+        case 21: {
+          if (tag !== 170) {
+            break;
+          }
+
+          message.identity = Identity.decode(reader, reader.uint32());
+          continue;
+        }
+        case 22: {
+          if (tag !== 178) {
+            break;
+          }
+
+          message.rateLimit = RateLimit.decode(reader, reader.uint32());
           continue;
         }
       }
@@ -1283,8 +5336,9 @@ export const Route: MessageFns<Route> = {
     return message;
   },
 
-  fromJSON(object: any): Route {
+  fromJSON(object: any): UpdateConfigRequest {
     return {
+      id: isSet(object.id) ? globalThis.String(object.id) : "0",
       name: isSet(object.name) ? globalThis.String(object.name) : "",
       pathPrefix: isSet(object.pathPrefix)
         ? globalThis.String(object.pathPrefix)
@@ -1306,16 +5360,48 @@ export const Route: MessageFns<Route> = {
         ? object.middleware.map((e: any) => globalThis.String(e))
         : [],
       timeout: isSet(object.timeout) ? globalThis.String(object.timeout) : "0",
-      updatedAt: isSet(object.updatedAt)
-        ? globalThis.String(object.updatedAt)
-        : isSet(object.updated_at)
-        ? globalThis.String(object.updated_at)
-        : "0",
+      targets: globalThis.Array.isArray(object?.targets) ? object.targets.map((e: any) => Target.fromJSON(e)) : [],
+      loadBalancer: isSet(object.loadBalancer)
+        ? LoadBalancer.fromJSON(object.loadBalancer)
+        : isSet(object.load_balancer)
+        ? LoadBalancer.fromJSON(object.load_balancer)
+        : undefined,
+      healthCheck: isSet(object.healthCheck)
+        ? HealthCheck.fromJSON(object.healthCheck)
+        : isSet(object.health_check)
+        ? HealthCheck.fromJSON(object.health_check)
+        : undefined,
+      circuitBreaker: isSet(object.circuitBreaker)
+        ? CircuitBreaker.fromJSON(object.circuitBreaker)
+        : isSet(object.circuit_breaker)
+        ? CircuitBreaker.fromJSON(object.circuit_breaker)
+        : undefined,
+      retryPolicy: isSet(object.retryPolicy)
+        ? RetryPolicy.fromJSON(object.retryPolicy)
+        : isSet(object.retry_policy)
+        ? RetryPolicy.fromJSON(object.retry_policy)
+        : undefined,
+      middlewareConfig: isObject(object.middlewareConfig) ? object.middlewareConfig : undefined,
+      hosts: globalThis.Array.isArray(object?.hosts) ? object.hosts.map((e: any) => globalThis.String(e)) : [],
+      match: isSet(object.match) ? RouteMatch.fromJSON(object.match) : undefined,
+      priority: isSet(object.priority) ? globalThis.Number(object.priority) : 0,
+      rewrite: isSet(object.rewrite) ? Rewrite.fromJSON(object.rewrite) : undefined,
+      split: isSet(object.split) ? TrafficSplit.fromJSON(object.split) : undefined,
+      mirror: isSet(object.mirror) ? Mirror.fromJSON(object.mirror) : undefined,
+      identity: isSet(object.identity) ? Identity.fromJSON(object.identity) : undefined,
+      rateLimit: isSet(object.rateLimit)
+        ? RateLimit.fromJSON(object.rateLimit)
+        : isSet(object.rate_limit)
+        ? RateLimit.fromJSON(object.rate_limit)
+        : undefined,
     };
   },
 
-  toJSON(message: Route): unknown {
+  toJSON(message: UpdateConfigRequest): unknown {
     const obj: any = {};
+    if (message.id !== "0") {
+      obj.id = message.id;
+    }
     if (message.name !== "") {
       obj.name = message.name;
     }
@@ -1337,17 +5423,57 @@ export const Route: MessageFns<Route> = {
     if (message.timeout !== "0") {
       obj.timeout = message.timeout;
     }
-    if (message.updatedAt !== "0") {
-      obj.updatedAt = message.updatedAt;
+    if (message.targets?.length) {
+      obj.targets = message.targets.map((e) => Target.toJSON(e));
+    }
+    if (message.loadBalancer !== undefined) {
+      obj.loadBalancer = LoadBalancer.toJSON(message.loadBalancer);
+    }
+    if (message.healthCheck !== undefined) {
+      obj.healthCheck = HealthCheck.toJSON(message.healthCheck);
+    }
+    if (message.circuitBreaker !== undefined) {
+      obj.circuitBreaker = CircuitBreaker.toJSON(message.circuitBreaker);
+    }
+    if (message.retryPolicy !== undefined) {
+      obj.retryPolicy = RetryPolicy.toJSON(message.retryPolicy);
+    }
+    if (message.middlewareConfig !== undefined) {
+      obj.middlewareConfig = message.middlewareConfig;
+    }
+    if (message.hosts?.length) {
+      obj.hosts = message.hosts;
+    }
+    if (message.match !== undefined) {
+      obj.match = RouteMatch.toJSON(message.match);
+    }
+    if (message.priority !== 0) {
+      obj.priority = Math.round(message.priority);
+    }
+    if (message.rewrite !== undefined) {
+      obj.rewrite = Rewrite.toJSON(message.rewrite);
+    }
+    if (message.split !== undefined) {
+      obj.split = TrafficSplit.toJSON(message.split);
+    }
+    if (message.mirror !== undefined) {
+      obj.mirror = Mirror.toJSON(message.mirror);
+    }
+    if (message.identity !== undefined) {
+      obj.identity = Identity.toJSON(message.identity);
+    }
+    if (message.rateLimit !== undefined) {
+      obj.rateLimit = RateLimit.toJSON(message.rateLimit);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Route>, I>>(base?: I): Route {
-    return Route.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<UpdateConfigRequest>, I>>(base?: I): UpdateConfigRequest {
+    return UpdateConfigRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Route>, I>>(object: I): Route {
-    const message = createBaseRoute();
+  fromPartial<I extends Exact<DeepPartial<UpdateConfigRequest>, I>>(object: I): UpdateConfigRequest {
+    const message = createBaseUpdateConfigRequest();
+    message.id = object.id ?? "0";
     message.name = object.name ?? "";
     message.pathPrefix = object.pathPrefix ?? "";
     message.targetUrl = object.targetUrl ?? "";
@@ -1357,19 +5483,52 @@ export const Route: MessageFns<Route> = {
       : undefined;
     message.middleware = object.middleware?.map((e) => e) || [];
     message.timeout = object.timeout ?? "0";
-    message.updatedAt = object.updatedAt ?? "0";
+    message.targets = object.targets?.map((e) => Target.fromPartial(e)) || [];
+    message.loadBalancer = (object.loadBalancer !== undefined && object.loadBalancer !== null)
+      ? LoadBalancer.fromPartial(object.loadBalancer)
+      : undefined;
+    message.healthCheck = (object.healthCheck !== undefined && object.healthCheck !== null)
+      ? HealthCheck.fromPartial(object.healthCheck)
+      : undefined;
+    message.circuitBreaker = (object.circuitBreaker !== undefined && object.circuitBreaker !== null)
+      ? CircuitBreaker.fromPartial(object.circuitBreaker)
+      : undefined;
+    message.retryPolicy = (object.retryPolicy !== undefined && object.retryPolicy !== null)
+      ? RetryPolicy.fromPartial(object.retryPolicy)
+      : undefined;
+    message.middlewareConfig = object.middlewareConfig ?? undefined;
+    message.hosts = object.hosts?.map((e) => e) || [];
+    message.match = (object.match !== undefined && object.match !== null)
+      ? RouteMatch.fromPartial(object.match)
+      : undefined;
+    message.priority = object.priority ?? 0;
+    message.rewrite = (object.rewrite !== undefined && object.rewrite !== null)
+      ? Rewrite.fromPartial(object.rewrite)
+      : undefined;
+    message.split = (object.split !== undefined && object.split !== null)
+      ? TrafficSplit.fromPartial(object.split)
+      : undefined;
+    message.mirror = (object.mirror !== undefined && object.mirror !== null)
+      ? Mirror.fromPartial(object.mirror)
+      : undefined;
+    message.identity = (object.identity !== undefined && object.identity !== null)
+      ? Identity.fromPartial(object.identity)
+      : undefined;
+    message.rateLimit = (object.rateLimit !== undefined && object.rateLimit !== null)
+      ? RateLimit.fromPartial(object.rateLimit)
+      : undefined;
     return message;
   },
 };
 
-function createBaseGetRoutesResponse(): GetRoutesResponse {
-  return { routes: [], message: "" };
+function createBaseUpdateConfigResponse(): UpdateConfigResponse {
+  return { config: undefined, message: "" };
 }
 
-export const GetRoutesResponse: MessageFns<GetRoutesResponse> = {
-  encode(message: GetRoutesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.routes) {
-      Route.encode(v!, writer.uint32(10).fork()).join();
+export const UpdateConfigResponse: MessageFns<UpdateConfigResponse> = {
+  encode(message: UpdateConfigResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.config !== undefined) {
+      Config.encode(message.config, writer.uint32(10).fork()).join();
     }
     if (message.message !== "") {
       writer.uint32(18).string(message.message);
@@ -1377,10 +5536,10 @@ export const GetRoutesResponse: MessageFns<GetRoutesResponse> = {
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetRoutesResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): UpdateConfigResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetRoutesResponse();
+    const message = createBaseUpdateConfigResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1389,7 +5548,7 @@ export const GetRoutesResponse: MessageFns<GetRoutesResponse> = {
             break;
           }
 
-          message.routes.push(Route.decode(reader, reader.uint32()));
+          message.config = Config.decode(reader, reader.uint32());
           continue;
         }
         case 2: {
@@ -1409,17 +5568,17 @@ export const GetRoutesResponse: MessageFns<GetRoutesResponse> = {
     return message;
   },
 
-  fromJSON(object: any): GetRoutesResponse {
+  fromJSON(object: any): UpdateConfigResponse {
     return {
-      routes: globalThis.Array.isArray(object?.routes) ? object.routes.map((e: any) => Route.fromJSON(e)) : [],
+      config: isSet(object.config) ? Config.fromJSON(object.config) : undefined,
       message: isSet(object.message) ? globalThis.String(object.message) : "",
     };
   },
 
-  toJSON(message: GetRoutesResponse): unknown {
+  toJSON(message: UpdateConfigResponse): unknown {
     const obj: any = {};
-    if (message.routes?.length) {
-      obj.routes = message.routes.map((e) => Route.toJSON(e));
+    if (message.config !== undefined) {
+      obj.config = Config.toJSON(message.config);
     }
     if (message.message !== "") {
       obj.message = message.message;
@@ -1427,128 +5586,131 @@ export const GetRoutesResponse: MessageFns<GetRoutesResponse> = {
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<GetRoutesResponse>, I>>(base?: I): GetRoutesResponse {
-    return GetRoutesResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<UpdateConfigResponse>, I>>(base?: I): UpdateConfigResponse {
+    return UpdateConfigResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<GetRoutesResponse>, I>>(object: I): GetRoutesResponse {
-    const message = createBaseGetRoutesResponse();
-    message.routes = object.routes?.map((e) => Route.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<UpdateConfigResponse>, I>>(object: I): UpdateConfigResponse {
+    const message = createBaseUpdateConfigResponse();
+    message.config = (object.config !== undefined && object.config !== null)
+      ? Config.fromPartial(object.config)
+      : undefined;
     message.message = object.message ?? "";
     return message;
   },
 };
 
-function createBaseUpdateConfigRequest(): UpdateConfigRequest {
-  return {
-    id: "0",
-    name: "",
-    pathPrefix: "",
-    targetUrl: "",
-    stripPrefix: false,
-    authentication: undefined,
-    middleware: [],
-    timeout: "0",
-  };
+function createBaseVersionWeight(): VersionWeight {
+  return { version: "", weight: 0 };
 }
 
-export const UpdateConfigRequest: MessageFns<UpdateConfigRequest> = {
-  encode(message: UpdateConfigRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== "0") {
-      writer.uint32(8).int64(message.id);
-    }
-    if (message.name !== "") {
-      writer.uint32(18).string(message.name);
-    }
-    if (message.pathPrefix !== "") {
-      writer.uint32(26).string(message.pathPrefix);
-    }
-    if (message.targetUrl !== "") {
-      writer.uint32(34).string(message.targetUrl);
-    }
-    if (message.stripPrefix !== false) {
-      writer.uint32(40).bool(message.stripPrefix);
+export const VersionWeight: MessageFns<VersionWeight> = {
+  encode(message: VersionWeight, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.version !== "") {
+      writer.uint32(10).string(message.version);
     }
-    if (message.authentication !== undefined) {
-      Authentication.encode(message.authentication, writer.uint32(50).fork()).join();
-    }
-    for (const v of message.middleware) {
-      writer.uint32(58).string(v!);
-    }
-    if (message.timeout !== "0") {
-      writer.uint32(64).int64(message.timeout);
+    if (message.weight !== 0) {
+      writer.uint32(16).int32(message.weight);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): UpdateConfigRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): VersionWeight {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdateConfigRequest();
+    const message = createBaseVersionWeight();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = reader.int64().toString();
+          message.version = reader.string();
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.name = reader.string();
+          message.weight = reader.int32();
           continue;
         }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
 
-          message.pathPrefix = reader.string();
-          continue;
-        }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
+  fromJSON(object: any): VersionWeight {
+    return {
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      weight: isSet(object.weight) ? globalThis.Number(object.weight) : 0,
+    };
+  },
 
-          message.targetUrl = reader.string();
-          continue;
-        }
-        case 5: {
-          if (tag !== 40) {
-            break;
-          }
+  toJSON(message: VersionWeight): unknown {
+    const obj: any = {};
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.weight !== 0) {
+      obj.weight = Math.round(message.weight);
+    }
+    return obj;
+  },
 
-          message.stripPrefix = reader.bool();
-          continue;
-        }
-        case 6: {
-          if (tag !== 50) {
-            break;
-          }
+  create<I extends Exact<DeepPartial<VersionWeight>, I>>(base?: I): VersionWeight {
+    return VersionWeight.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<VersionWeight>, I>>(object: I): VersionWeight {
+    const message = createBaseVersionWeight();
+    message.version = object.version ?? "";
+    message.weight = object.weight ?? 0;
+    return message;
+  },
+};
 
-          message.authentication = Authentication.decode(reader, reader.uint32());
-          continue;
-        }
-        case 7: {
-          if (tag !== 58) {
+function createBaseSetRouteWeightsRequest(): SetRouteWeightsRequest {
+  return { id: "0", weights: [] };
+}
+
+export const SetRouteWeightsRequest: MessageFns<SetRouteWeightsRequest> = {
+  encode(message: SetRouteWeightsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "0") {
+      writer.uint32(8).int64(message.id);
+    }
+    for (const v of message.weights) {
+      VersionWeight.encode(v!, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SetRouteWeightsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSetRouteWeightsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
             break;
           }
 
-          message.middleware.push(reader.string());
+          message.id = reader.int64().toString();
           continue;
         }
-        case 8: {
-          if (tag !== 64) {
+        case 2: {
+          if (tag !== 18) {
             break;
           }
 
-          message.timeout = reader.int64().toString();
+          message.weights.push(VersionWeight.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -1560,87 +5722,43 @@ export const UpdateConfigRequest: MessageFns<UpdateConfigRequest> = {
     return message;
   },
 
-  fromJSON(object: any): UpdateConfigRequest {
+  fromJSON(object: any): SetRouteWeightsRequest {
     return {
       id: isSet(object.id) ? globalThis.String(object.id) : "0",
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      pathPrefix: isSet(object.pathPrefix)
-        ? globalThis.String(object.pathPrefix)
-        : isSet(object.path_prefix)
-        ? globalThis.String(object.path_prefix)
-        : "",
-      targetUrl: isSet(object.targetUrl)
-        ? globalThis.String(object.targetUrl)
-        : isSet(object.target_url)
-        ? globalThis.String(object.target_url)
-        : "",
-      stripPrefix: isSet(object.stripPrefix)
-        ? globalThis.Boolean(object.stripPrefix)
-        : isSet(object.strip_prefix)
-        ? globalThis.Boolean(object.strip_prefix)
-        : false,
-      authentication: isSet(object.authentication) ? Authentication.fromJSON(object.authentication) : undefined,
-      middleware: globalThis.Array.isArray(object?.middleware)
-        ? object.middleware.map((e: any) => globalThis.String(e))
+      weights: globalThis.Array.isArray(object?.weights)
+        ? object.weights.map((e: any) => VersionWeight.fromJSON(e))
         : [],
-      timeout: isSet(object.timeout) ? globalThis.String(object.timeout) : "0",
     };
   },
 
-  toJSON(message: UpdateConfigRequest): unknown {
+  toJSON(message: SetRouteWeightsRequest): unknown {
     const obj: any = {};
     if (message.id !== "0") {
       obj.id = message.id;
     }
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.pathPrefix !== "") {
-      obj.pathPrefix = message.pathPrefix;
-    }
-    if (message.targetUrl !== "") {
-      obj.targetUrl = message.targetUrl;
-    }
-    if (message.stripPrefix !== false) {
-      obj.stripPrefix = message.stripPrefix;
-    }
-    if (message.authentication !== undefined) {
-      obj.authentication = Authentication.toJSON(message.authentication);
-    }
-    if (message.middleware?.length) {
-      obj.middleware = message.middleware;
-    }
-    if (message.timeout !== "0") {
-      obj.timeout = message.timeout;
+    if (message.weights?.length) {
+      obj.weights = message.weights.map((e) => VersionWeight.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<UpdateConfigRequest>, I>>(base?: I): UpdateConfigRequest {
-    return UpdateConfigRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<SetRouteWeightsRequest>, I>>(base?: I): SetRouteWeightsRequest {
+    return SetRouteWeightsRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<UpdateConfigRequest>, I>>(object: I): UpdateConfigRequest {
-    const message = createBaseUpdateConfigRequest();
+  fromPartial<I extends Exact<DeepPartial<SetRouteWeightsRequest>, I>>(object: I): SetRouteWeightsRequest {
+    const message = createBaseSetRouteWeightsRequest();
     message.id = object.id ?? "0";
-    message.name = object.name ?? "";
-    message.pathPrefix = object.pathPrefix ?? "";
-    message.targetUrl = object.targetUrl ?? "";
-    message.stripPrefix = object.stripPrefix ?? false;
-    message.authentication = (object.authentication !== undefined && object.authentication !== null)
-      ? Authentication.fromPartial(object.authentication)
-      : undefined;
-    message.middleware = object.middleware?.map((e) => e) || [];
-    message.timeout = object.timeout ?? "0";
+    message.weights = object.weights?.map((e) => VersionWeight.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseUpdateConfigResponse(): UpdateConfigResponse {
+function createBaseSetRouteWeightsResponse(): SetRouteWeightsResponse {
   return { config: undefined, message: "" };
 }
 
-export const UpdateConfigResponse: MessageFns<UpdateConfigResponse> = {
-  encode(message: UpdateConfigResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const SetRouteWeightsResponse: MessageFns<SetRouteWeightsResponse> = {
+  encode(message: SetRouteWeightsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.config !== undefined) {
       Config.encode(message.config, writer.uint32(10).fork()).join();
     }
@@ -1650,10 +5768,10 @@ export const UpdateConfigResponse: MessageFns<UpdateConfigResponse> = {
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): UpdateConfigResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): SetRouteWeightsResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdateConfigResponse();
+    const message = createBaseSetRouteWeightsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1682,14 +5800,14 @@ export const UpdateConfigResponse: MessageFns<UpdateConfigResponse> = {
     return message;
   },
 
-  fromJSON(object: any): UpdateConfigResponse {
+  fromJSON(object: any): SetRouteWeightsResponse {
     return {
       config: isSet(object.config) ? Config.fromJSON(object.config) : undefined,
       message: isSet(object.message) ? globalThis.String(object.message) : "",
     };
   },
 
-  toJSON(message: UpdateConfigResponse): unknown {
+  toJSON(message: SetRouteWeightsResponse): unknown {
     const obj: any = {};
     if (message.config !== undefined) {
       obj.config = Config.toJSON(message.config);
@@ -1700,11 +5818,11 @@ export const UpdateConfigResponse: MessageFns<UpdateConfigResponse> = {
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<UpdateConfigResponse>, I>>(base?: I): UpdateConfigResponse {
-    return UpdateConfigResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<SetRouteWeightsResponse>, I>>(base?: I): SetRouteWeightsResponse {
+    return SetRouteWeightsResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<UpdateConfigResponse>, I>>(object: I): UpdateConfigResponse {
-    const message = createBaseUpdateConfigResponse();
+  fromPartial<I extends Exact<DeepPartial<SetRouteWeightsResponse>, I>>(object: I): SetRouteWeightsResponse {
+    const message = createBaseSetRouteWeightsResponse();
     message.config = (object.config !== undefined && object.config !== null)
       ? Config.fromPartial(object.config)
       : undefined;
@@ -1873,7 +5991,7 @@ export const GetStatsRequest: MessageFns<GetStatsRequest> = {
 };
 
 function createBaseGetStatsResponse(): GetStatsResponse {
-  return { totalRoutes: 0, message: "" };
+  return { totalRoutes: 0, message: "", healthyTargets: 0, unhealthyTargets: 0 };
 }
 
 export const GetStatsResponse: MessageFns<GetStatsResponse> = {
@@ -1884,6 +6002,12 @@ export const GetStatsResponse: MessageFns<GetStatsResponse> = {
     if (message.message !== "") {
       writer.uint32(18).string(message.message);
     }
+    if (message.healthyTargets !== 0) {
+      writer.uint32(24).int32(message.healthyTargets);
+    }
+    if (message.unhealthyTargets !== 0) {
+      writer.uint32(32).int32(message.unhealthyTargets);
+    }
     return writer;
   },
 
@@ -1910,6 +6034,22 @@ export const GetStatsResponse: MessageFns<GetStatsResponse> = {
           message.message = reader.string();
           continue;
         }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.healthyTargets = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.unhealthyTargets = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1927,6 +6067,16 @@ export const GetStatsResponse: MessageFns<GetStatsResponse> = {
         ? globalThis.Number(object.total_routes)
         : 0,
       message: isSet(object.message) ? globalThis.String(object.message) : "",
+      healthyTargets: isSet(object.healthyTargets)
+        ? globalThis.Number(object.healthyTargets)
+        : isSet(object.healthy_targets)
+        ? globalThis.Number(object.healthy_targets)
+        : 0,
+      unhealthyTargets: isSet(object.unhealthyTargets)
+        ? globalThis.Number(object.unhealthyTargets)
+        : isSet(object.unhealthy_targets)
+        ? globalThis.Number(object.unhealthy_targets)
+        : 0,
     };
   },
 
@@ -1938,6 +6088,12 @@ export const GetStatsResponse: MessageFns<GetStatsResponse> = {
     if (message.message !== "") {
       obj.message = message.message;
     }
+    if (message.healthyTargets !== 0) {
+      obj.healthyTargets = Math.round(message.healthyTargets);
+    }
+    if (message.unhealthyTargets !== 0) {
+      obj.unhealthyTargets = Math.round(message.unhealthyTargets);
+    }
     return obj;
   },
 
@@ -1948,6 +6104,8 @@ export const GetStatsResponse: MessageFns<GetStatsResponse> = {
     const message = createBaseGetStatsResponse();
     message.totalRoutes = object.totalRoutes ?? 0;
     message.message = object.message ?? "";
+    message.healthyTargets = object.healthyTargets ?? 0;
+    message.unhealthyTargets = object.unhealthyTargets ?? 0;
     return message;
   },
 };
@@ -1964,6 +6122,10 @@ type KeysOfUnion<T> = T extends T ? keyof T : never;
 export type Exact<P, I extends P> = P extends Builtin ? P
   : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
 
+function isObject(value: any): boolean {
+  return typeof value === "object" && value !== null;
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
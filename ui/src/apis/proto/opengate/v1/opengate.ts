@@ -18,6 +18,16 @@ import {
   type UntypedServiceImplementation,
 } from "@grpc/grpc-js";
 import { PingRequest, PingResponse } from "../common/ping";
+import {
+  CreateAPIKeyRequest,
+  CreateAPIKeyResponse,
+  ListAPIKeysRequest,
+  ListAPIKeysResponse,
+  RevokeAPIKeyRequest,
+  RevokeAPIKeyResponse,
+  RotateAPIKeyRequest,
+  RotateAPIKeyResponse,
+} from "./api_keys";
 import {
   GetAppSettingsRequest,
   GetAppSettingsResponse,
@@ -37,9 +47,14 @@ import {
   GetStatsResponse,
   ListConfigsRequest,
   ListConfigsResponse,
+  SetRouteWeightsRequest,
+  SetRouteWeightsResponse,
+  TestRouteRequest,
+  TestRouteResponse,
   UpdateConfigRequest,
   UpdateConfigResponse,
 } from "./config";
+import { GetHealthRequest, GetHealthResponse } from "./health";
 
 export const protobufPackage = "opengate.v1";
 
@@ -97,6 +112,18 @@ export const OpenGateServiceService = {
       Buffer.from(UpdateConfigResponse.encode(value).finish()),
     responseDeserialize: (value: Buffer): UpdateConfigResponse => UpdateConfigResponse.decode(value),
   },
+  /** SetRouteWeights shifts the traffic of a split route between its versions */
+  setRouteWeights: {
+    path: "/opengate.v1.OpenGateService/SetRouteWeights" as const,
+    requestStream: false as const,
+    responseStream: false as const,
+    requestSerialize: (value: SetRouteWeightsRequest): Buffer =>
+      Buffer.from(SetRouteWeightsRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer): SetRouteWeightsRequest => SetRouteWeightsRequest.decode(value),
+    responseSerialize: (value: SetRouteWeightsResponse): Buffer =>
+      Buffer.from(SetRouteWeightsResponse.encode(value).finish()),
+    responseDeserialize: (value: Buffer): SetRouteWeightsResponse => SetRouteWeightsResponse.decode(value),
+  },
   /** DeleteConfig deletes a config by ID */
   deleteConfig: {
     path: "/opengate.v1.OpenGateService/DeleteConfig" as const,
@@ -118,6 +145,16 @@ export const OpenGateServiceService = {
     responseSerialize: (value: GetRoutesResponse): Buffer => Buffer.from(GetRoutesResponse.encode(value).finish()),
     responseDeserialize: (value: Buffer): GetRoutesResponse => GetRoutesResponse.decode(value),
   },
+  /** TestRoute runs a request through the routing table without proxying it */
+  testRoute: {
+    path: "/opengate.v1.OpenGateService/TestRoute" as const,
+    requestStream: false as const,
+    responseStream: false as const,
+    requestSerialize: (value: TestRouteRequest): Buffer => Buffer.from(TestRouteRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer): TestRouteRequest => TestRouteRequest.decode(value),
+    responseSerialize: (value: TestRouteResponse): Buffer => Buffer.from(TestRouteResponse.encode(value).finish()),
+    responseDeserialize: (value: Buffer): TestRouteResponse => TestRouteResponse.decode(value),
+  },
   /** GetStats retrieves dashboard statistics */
   getStats: {
     path: "/opengate.v1.OpenGateService/GetStats" as const,
@@ -152,6 +189,59 @@ export const OpenGateServiceService = {
       Buffer.from(UpsertAppSettingResponse.encode(value).finish()),
     responseDeserialize: (value: Buffer): UpsertAppSettingResponse => UpsertAppSettingResponse.decode(value),
   },
+  /** GetHealth retrieves the health of the upstream targets of every route */
+  getHealth: {
+    path: "/opengate.v1.OpenGateService/GetHealth" as const,
+    requestStream: false as const,
+    responseStream: false as const,
+    requestSerialize: (value: GetHealthRequest): Buffer => Buffer.from(GetHealthRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer): GetHealthRequest => GetHealthRequest.decode(value),
+    responseSerialize: (value: GetHealthResponse): Buffer => Buffer.from(GetHealthResponse.encode(value).finish()),
+    responseDeserialize: (value: Buffer): GetHealthResponse => GetHealthResponse.decode(value),
+  },
+  /** CreateAPIKey creates an API key for a machine client */
+  createAPIKey: {
+    path: "/opengate.v1.OpenGateService/CreateAPIKey" as const,
+    requestStream: false as const,
+    responseStream: false as const,
+    requestSerialize: (value: CreateAPIKeyRequest): Buffer => Buffer.from(CreateAPIKeyRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer): CreateAPIKeyRequest => CreateAPIKeyRequest.decode(value),
+    responseSerialize: (value: CreateAPIKeyResponse): Buffer =>
+      Buffer.from(CreateAPIKeyResponse.encode(value).finish()),
+    responseDeserialize: (value: Buffer): CreateAPIKeyResponse => CreateAPIKeyResponse.decode(value),
+  },
+  /** ListAPIKeys lists the API keys */
+  listAPIKeys: {
+    path: "/opengate.v1.OpenGateService/ListAPIKeys" as const,
+    requestStream: false as const,
+    responseStream: false as const,
+    requestSerialize: (value: ListAPIKeysRequest): Buffer => Buffer.from(ListAPIKeysRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer): ListAPIKeysRequest => ListAPIKeysRequest.decode(value),
+    responseSerialize: (value: ListAPIKeysResponse): Buffer => Buffer.from(ListAPIKeysResponse.encode(value).finish()),
+    responseDeserialize: (value: Buffer): ListAPIKeysResponse => ListAPIKeysResponse.decode(value),
+  },
+  /** RotateAPIKey replaces the key of an API key */
+  rotateAPIKey: {
+    path: "/opengate.v1.OpenGateService/RotateAPIKey" as const,
+    requestStream: false as const,
+    responseStream: false as const,
+    requestSerialize: (value: RotateAPIKeyRequest): Buffer => Buffer.from(RotateAPIKeyRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer): RotateAPIKeyRequest => RotateAPIKeyRequest.decode(value),
+    responseSerialize: (value: RotateAPIKeyResponse): Buffer =>
+      Buffer.from(RotateAPIKeyResponse.encode(value).finish()),
+    responseDeserialize: (value: Buffer): RotateAPIKeyResponse => RotateAPIKeyResponse.decode(value),
+  },
+  /** RevokeAPIKey revokes an API key */
+  revokeAPIKey: {
+    path: "/opengate.v1.OpenGateService/RevokeAPIKey" as const,
+    requestStream: false as const,
+    responseStream: false as const,
+    requestSerialize: (value: RevokeAPIKeyRequest): Buffer => Buffer.from(RevokeAPIKeyRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer): RevokeAPIKeyRequest => RevokeAPIKeyRequest.decode(value),
+    responseSerialize: (value: RevokeAPIKeyResponse): Buffer =>
+      Buffer.from(RevokeAPIKeyResponse.encode(value).finish()),
+    responseDeserialize: (value: Buffer): RevokeAPIKeyResponse => RevokeAPIKeyResponse.decode(value),
+  },
 } as const;
 
 export interface OpenGateServiceServer extends UntypedServiceImplementation {
@@ -165,16 +255,30 @@ export interface OpenGateServiceServer extends UntypedServiceImplementation {
   listConfigs: handleUnaryCall<ListConfigsRequest, ListConfigsResponse>;
   /** UpdateConfig updates an existing config */
   updateConfig: handleUnaryCall<UpdateConfigRequest, UpdateConfigResponse>;
+  /** SetRouteWeights shifts the traffic of a split route between its versions */
+  setRouteWeights: handleUnaryCall<SetRouteWeightsRequest, SetRouteWeightsResponse>;
   /** DeleteConfig deletes a config by ID */
   deleteConfig: handleUnaryCall<DeleteConfigRequest, DeleteConfigResponse>;
   /** GetRoutes retrieves all routes for routing purposes */
   getRoutes: handleUnaryCall<GetRoutesRequest, GetRoutesResponse>;
+  /** TestRoute runs a request through the routing table without proxying it */
+  testRoute: handleUnaryCall<TestRouteRequest, TestRouteResponse>;
   /** GetStats retrieves dashboard statistics */
   getStats: handleUnaryCall<GetStatsRequest, GetStatsResponse>;
   /** GetAppSettings retrieves all application settings */
   getAppSettings: handleUnaryCall<GetAppSettingsRequest, GetAppSettingsResponse>;
   /** UpsertAppSetting creates or updates a single application setting */
   upsertAppSetting: handleUnaryCall<UpsertAppSettingRequest, UpsertAppSettingResponse>;
+  /** GetHealth retrieves the health of the upstream targets of every route */
+  getHealth: handleUnaryCall<GetHealthRequest, GetHealthResponse>;
+  /** CreateAPIKey creates an API key for a machine client */
+  createAPIKey: handleUnaryCall<CreateAPIKeyRequest, CreateAPIKeyResponse>;
+  /** ListAPIKeys lists the API keys */
+  listAPIKeys: handleUnaryCall<ListAPIKeysRequest, ListAPIKeysResponse>;
+  /** RotateAPIKey replaces the key of an API key */
+  rotateAPIKey: handleUnaryCall<RotateAPIKeyRequest, RotateAPIKeyResponse>;
+  /** RevokeAPIKey revokes an API key */
+  revokeAPIKey: handleUnaryCall<RevokeAPIKeyRequest, RevokeAPIKeyResponse>;
 }
 
 export interface OpenGateServiceClient extends Client {
@@ -255,6 +359,22 @@ export interface OpenGateServiceClient extends Client {
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: UpdateConfigResponse) => void,
   ): ClientUnaryCall;
+  /** SetRouteWeights shifts the traffic of a split route between its versions */
+  setRouteWeights(
+    request: SetRouteWeightsRequest,
+    callback: (error: ServiceError | null, response: SetRouteWeightsResponse) => void,
+  ): ClientUnaryCall;
+  setRouteWeights(
+    request: SetRouteWeightsRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: SetRouteWeightsResponse) => void,
+  ): ClientUnaryCall;
+  setRouteWeights(
+    request: SetRouteWeightsRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: SetRouteWeightsResponse) => void,
+  ): ClientUnaryCall;
   /** DeleteConfig deletes a config by ID */
   deleteConfig(
     request: DeleteConfigRequest,
@@ -287,6 +407,22 @@ export interface OpenGateServiceClient extends Client {
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: GetRoutesResponse) => void,
   ): ClientUnaryCall;
+  /** TestRoute runs a request through the routing table without proxying it */
+  testRoute(
+    request: TestRouteRequest,
+    callback: (error: ServiceError | null, response: TestRouteResponse) => void,
+  ): ClientUnaryCall;
+  testRoute(
+    request: TestRouteRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: TestRouteResponse) => void,
+  ): ClientUnaryCall;
+  testRoute(
+    request: TestRouteRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: TestRouteResponse) => void,
+  ): ClientUnaryCall;
   /** GetStats retrieves dashboard statistics */
   getStats(
     request: GetStatsRequest,
@@ -335,6 +471,86 @@ export interface OpenGateServiceClient extends Client {
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: UpsertAppSettingResponse) => void,
   ): ClientUnaryCall;
+  /** GetHealth retrieves the health of the upstream targets of every route */
+  getHealth(
+    request: GetHealthRequest,
+    callback: (error: ServiceError | null, response: GetHealthResponse) => void,
+  ): ClientUnaryCall;
+  getHealth(
+    request: GetHealthRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: GetHealthResponse) => void,
+  ): ClientUnaryCall;
+  getHealth(
+    request: GetHealthRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: GetHealthResponse) => void,
+  ): ClientUnaryCall;
+  /** CreateAPIKey creates an API key for a machine client */
+  createAPIKey(
+    request: CreateAPIKeyRequest,
+    callback: (error: ServiceError | null, response: CreateAPIKeyResponse) => void,
+  ): ClientUnaryCall;
+  createAPIKey(
+    request: CreateAPIKeyRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: CreateAPIKeyResponse) => void,
+  ): ClientUnaryCall;
+  createAPIKey(
+    request: CreateAPIKeyRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: CreateAPIKeyResponse) => void,
+  ): ClientUnaryCall;
+  /** ListAPIKeys lists the API keys */
+  listAPIKeys(
+    request: ListAPIKeysRequest,
+    callback: (error: ServiceError | null, response: ListAPIKeysResponse) => void,
+  ): ClientUnaryCall;
+  listAPIKeys(
+    request: ListAPIKeysRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: ListAPIKeysResponse) => void,
+  ): ClientUnaryCall;
+  listAPIKeys(
+    request: ListAPIKeysRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: ListAPIKeysResponse) => void,
+  ): ClientUnaryCall;
+  /** RotateAPIKey replaces the key of an API key */
+  rotateAPIKey(
+    request: RotateAPIKeyRequest,
+    callback: (error: ServiceError | null, response: RotateAPIKeyResponse) => void,
+  ): ClientUnaryCall;
+  rotateAPIKey(
+    request: RotateAPIKeyRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: RotateAPIKeyResponse) => void,
+  ): ClientUnaryCall;
+  rotateAPIKey(
+    request: RotateAPIKeyRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: RotateAPIKeyResponse) => void,
+  ): ClientUnaryCall;
+  /** RevokeAPIKey revokes an API key */
+  revokeAPIKey(
+    request: RevokeAPIKeyRequest,
+    callback: (error: ServiceError | null, response: RevokeAPIKeyResponse) => void,
+  ): ClientUnaryCall;
+  revokeAPIKey(
+    request: RevokeAPIKeyRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: RevokeAPIKeyResponse) => void,
+  ): ClientUnaryCall;
+  revokeAPIKey(
+    request: RevokeAPIKeyRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: RevokeAPIKeyResponse) => void,
+  ): ClientUnaryCall;
 }
 
 export const OpenGateServiceClient = makeGenericClientConstructor(
@@ -1,2 +1,3 @@
 export { useConfigs } from './useConfigs'
 export { useStats } from './useStats'
+export { useAPIKeys } from './useAPIKeys'
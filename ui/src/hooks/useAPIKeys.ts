@@ -0,0 +1,92 @@
+import { useState, useCallback } from 'react'
+import { useNotification } from '@gofreego/tsutils'
+import { apiKeyService } from '../services/apiKeyService'
+import type { APIKey, CreateAPIKeyRequest } from '../apis/proto/opengate/v1/api_keys'
+
+// IssuedAPIKey is a created or rotated key along with the key itself, which is only shown once
+export interface IssuedAPIKey {
+  apiKey: APIKey
+  key: string
+}
+
+export const useAPIKeys = () => {
+  const [apiKeys, setAPIKeys] = useState<APIKey[]>([])
+  const [loading, setLoading] = useState(false)
+  const [error, setError] = useState<string | null>(null)
+  const { showNotification } = useNotification()
+
+  // replaceKey swaps the updated key into the list
+  const replaceKey = (updated: APIKey | undefined) => {
+    if (updated) setAPIKeys(prev => prev.map(k => (k.id === updated.id ? updated : k)))
+  }
+
+  const loadAPIKeys = useCallback(async () => {
+    setLoading(true)
+    setError(null)
+
+    try {
+      const response = await apiKeyService.list()
+      setAPIKeys(response.apiKeys || [])
+    } catch (err) {
+      const message = 'Failed to load API keys'
+      setError(message)
+      showNotification(message, 'error')
+    } finally {
+      setLoading(false)
+    }
+  }, [showNotification])
+
+  const createAPIKey = useCallback(async (data: CreateAPIKeyRequest): Promise<IssuedAPIKey | null> => {
+    try {
+      const response = await apiKeyService.create(data)
+      if (!response.apiKey) return null
+      setAPIKeys(prev => [response.apiKey as APIKey, ...prev])
+      showNotification('API key created successfully', 'success')
+      return { apiKey: response.apiKey, key: response.key }
+    } catch (err) {
+      const message = 'Failed to create API key'
+      setError(message)
+      showNotification(message, 'error')
+      return null
+    }
+  }, [showNotification])
+
+  const rotateAPIKey = useCallback(async (id: string): Promise<IssuedAPIKey | null> => {
+    try {
+      const response = await apiKeyService.rotate(id)
+      if (!response.apiKey) return null
+      replaceKey(response.apiKey)
+      showNotification('API key rotated successfully', 'success')
+      return { apiKey: response.apiKey, key: response.key }
+    } catch (err) {
+      const message = 'Failed to rotate API key'
+      setError(message)
+      showNotification(message, 'error')
+      return null
+    }
+  }, [showNotification])
+
+  const revokeAPIKey = useCallback(async (id: string): Promise<boolean> => {
+    try {
+      const response = await apiKeyService.revoke(id)
+      replaceKey(response.apiKey)
+      showNotification('API key revoked successfully', 'success')
+      return true
+    } catch (err) {
+      const message = 'Failed to revoke API key'
+      setError(message)
+      showNotification(message, 'error')
+      return false
+    }
+  }, [showNotification])
+
+  return {
+    apiKeys,
+    loading,
+    error,
+    loadAPIKeys,
+    createAPIKey,
+    rotateAPIKey,
+    revokeAPIKey,
+  }
+}
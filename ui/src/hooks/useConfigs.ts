@@ -1,7 +1,7 @@
 import { useState, useCallback } from 'react'
 import { useNotification } from '@gofreego/tsutils'
 import { configService } from '../services/configService'
-import type { Config, CreateConfigRequest, UpdateConfigRequest } from '../apis/proto/opengate/v1/config'
+import type { Config, CreateConfigRequest, UpdateConfigRequest, VersionWeight } from '../apis/proto/opengate/v1/config'
 
 interface UseConfigsOptions {
   limit?: number
@@ -101,6 +101,20 @@ export const useConfigs = () => {
     }
   }, [showNotification])
 
+  const setRouteWeights = useCallback(async (id: string, weights: VersionWeight[]): Promise<Config | null> => {
+    try {
+      const response = await configService.setWeights(id, weights)
+      setSelectedConfig(response.config || null)
+      showNotification('Route weights updated successfully', 'success')
+      return response.config || null
+    } catch (err) {
+      const message = 'Failed to update route weights'
+      setError(message)
+      showNotification(message, 'error')
+      return null
+    }
+  }, [showNotification])
+
   const deleteConfig = useCallback(async (id: string): Promise<boolean> => {
     setLoading(true)
     setError(null)
@@ -136,6 +150,7 @@ export const useConfigs = () => {
     getConfigById,
     createConfig,
     updateConfig,
+    setRouteWeights,
     deleteConfig,
     clearSelectedConfig,
   }
@@ -0,0 +1,78 @@
+import { useState, useEffect } from 'react'
+import { Container, Button } from '@mui/material'
+import { Add as AddIcon } from '@mui/icons-material'
+import { ConfirmDialog } from '@gofreego/tsutils'
+import { useAPIKeys, type IssuedAPIKey } from '../../hooks/useAPIKeys'
+import { APIKeyTable, APIKeyFormDialog, APIKeyRevealDialog } from './components'
+import { PageHeader } from '../../components'
+import type { APIKey, CreateAPIKeyRequest } from '../../apis/proto/opengate/v1/api_keys'
+
+type PendingAction = { kind: 'rotate' | 'revoke'; apiKey: APIKey }
+
+export const APIKeysPage = () => {
+  const { apiKeys, loading, loadAPIKeys, createAPIKey, rotateAPIKey, revokeAPIKey } = useAPIKeys()
+
+  const [openFormDialog, setOpenFormDialog] = useState(false)
+  const [issued, setIssued] = useState<IssuedAPIKey | null>(null)
+  const [pending, setPending] = useState<PendingAction | null>(null)
+
+  useEffect(() => {
+    loadAPIKeys()
+  }, [loadAPIKeys])
+
+  const handleSave = async (data: CreateAPIKeyRequest) => {
+    const created = await createAPIKey(data)
+    if (created) {
+      setOpenFormDialog(false)
+      setIssued(created)
+    }
+  }
+
+  const handleConfirm = async () => {
+    if (!pending) return
+    if (pending.kind === 'rotate') {
+      const rotated = await rotateAPIKey(pending.apiKey.id)
+      if (rotated) setIssued(rotated)
+    } else {
+      await revokeAPIKey(pending.apiKey.id)
+    }
+    setPending(null)
+  }
+
+  return (
+    <Container maxWidth="lg" sx={{ py: 4 }}>
+      <PageHeader
+        title="API Keys"
+        subtitle="Manage the keys machine clients authenticate with"
+        action={
+          <Button variant="contained" startIcon={<AddIcon />} onClick={() => setOpenFormDialog(true)}>
+            Create API Key
+          </Button>
+        }
+      />
+
+      <APIKeyTable
+        apiKeys={apiKeys}
+        loading={loading}
+        onRotate={(apiKey) => setPending({ kind: 'rotate', apiKey })}
+        onRevoke={(apiKey) => setPending({ kind: 'revoke', apiKey })}
+      />
+
+      <APIKeyFormDialog open={openFormDialog} onClose={() => setOpenFormDialog(false)} onSave={handleSave} />
+
+      <APIKeyRevealDialog issued={issued} onClose={() => setIssued(null)} />
+
+      <ConfirmDialog
+        open={!!pending}
+        title={pending?.kind === 'rotate' ? 'Rotate API Key' : 'Revoke API Key'}
+        message={
+          pending?.kind === 'rotate'
+            ? `Generate a new key for ${pending.apiKey.name}? The current key stops working.`
+            : `Revoke ${pending?.apiKey.name}? Requests using it will be rejected. This action cannot be undone.`
+        }
+        onConfirm={handleConfirm}
+        onCancel={() => setPending(null)}
+      />
+    </Container>
+  )
+}
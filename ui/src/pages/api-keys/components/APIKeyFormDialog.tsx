@@ -0,0 +1,94 @@
+import { useEffect, useState } from 'react'
+import {
+  Dialog,
+  DialogTitle,
+  DialogContent,
+  DialogActions,
+  Button,
+  TextField,
+  Box,
+} from '@mui/material'
+import type { CreateAPIKeyRequest } from '../../../apis/proto/opengate/v1/api_keys'
+
+interface APIKeyFormDialogProps {
+  open: boolean
+  onClose: () => void
+  onSave: (data: CreateAPIKeyRequest) => Promise<void>
+}
+
+export const APIKeyFormDialog = ({ open, onClose, onSave }: APIKeyFormDialogProps) => {
+  const [name, setName] = useState('')
+  const [owner, setOwner] = useState('')
+  const [scopes, setScopes] = useState('')
+  const [expiresAt, setExpiresAt] = useState('')
+  const [saving, setSaving] = useState(false)
+
+  useEffect(() => {
+    if (open) {
+      setName('')
+      setOwner('')
+      setScopes('')
+      setExpiresAt('')
+    }
+  }, [open])
+
+  const handleSave = async () => {
+    setSaving(true)
+    try {
+      await onSave({
+        name: name.trim(),
+        owner: owner.trim(),
+        scopes: scopes.split(',').map((s) => s.trim()).filter(Boolean),
+        expiresAt: expiresAt ? Math.floor(new Date(expiresAt).getTime() / 1000).toString() : '0',
+      })
+    } finally {
+      setSaving(false)
+    }
+  }
+
+  return (
+    <Dialog open={open} onClose={onClose} maxWidth="sm" fullWidth>
+      <DialogTitle>Create API Key</DialogTitle>
+      <DialogContent>
+        <Box sx={{ display: 'flex', flexDirection: 'column', gap: 2, mt: 1 }}>
+          <TextField
+            label="Name"
+            value={name}
+            onChange={(e) => setName(e.target.value)}
+            required
+            fullWidth
+          />
+          <TextField
+            label="Owner"
+            value={owner}
+            onChange={(e) => setOwner(e.target.value)}
+            helperText="Team or client the key is issued to"
+            fullWidth
+          />
+          <TextField
+            label="Scopes"
+            value={scopes}
+            onChange={(e) => setScopes(e.target.value)}
+            helperText="Comma-separated, sent upstream as the permissions of the client"
+            fullWidth
+          />
+          <TextField
+            label="Expires At"
+            type="datetime-local"
+            value={expiresAt}
+            onChange={(e) => setExpiresAt(e.target.value)}
+            helperText="Leave empty for a key that never expires"
+            InputLabelProps={{ shrink: true }}
+            fullWidth
+          />
+        </Box>
+      </DialogContent>
+      <DialogActions>
+        <Button onClick={onClose}>Cancel</Button>
+        <Button variant="contained" onClick={handleSave} disabled={saving || !name.trim()}>
+          Create
+        </Button>
+      </DialogActions>
+    </Dialog>
+  )
+}
@@ -0,0 +1,62 @@
+import {
+  Dialog,
+  DialogTitle,
+  DialogContent,
+  DialogActions,
+  Button,
+  TextField,
+  Box,
+  Alert,
+  IconButton,
+  InputAdornment,
+} from '@mui/material'
+import { ContentCopy as CopyIcon } from '@mui/icons-material'
+import { useNotification } from '@gofreego/tsutils'
+import type { IssuedAPIKey } from '../../../hooks/useAPIKeys'
+
+interface APIKeyRevealDialogProps {
+  issued: IssuedAPIKey | null
+  onClose: () => void
+}
+
+// APIKeyRevealDialog shows a created or rotated key, the only time it can be seen
+export const APIKeyRevealDialog = ({ issued, onClose }: APIKeyRevealDialogProps) => {
+  const { showNotification } = useNotification()
+
+  const handleCopy = async () => {
+    if (!issued) return
+    await navigator.clipboard.writeText(issued.key)
+    showNotification('API key copied', 'success')
+  }
+
+  return (
+    <Dialog open={!!issued} onClose={onClose} maxWidth="sm" fullWidth>
+      <DialogTitle>API Key {issued?.apiKey.name}</DialogTitle>
+      <DialogContent>
+        <Box sx={{ display: 'flex', flexDirection: 'column', gap: 2, mt: 1 }}>
+          <Alert severity="warning">
+            Copy the key now. Only its hash is stored, so it can't be shown again.
+          </Alert>
+          <TextField
+            value={issued?.key || ''}
+            fullWidth
+            InputProps={{
+              readOnly: true,
+              sx: { fontFamily: 'monospace' },
+              endAdornment: (
+                <InputAdornment position="end">
+                  <IconButton onClick={handleCopy} edge="end">
+                    <CopyIcon fontSize="small" />
+                  </IconButton>
+                </InputAdornment>
+              ),
+            }}
+          />
+        </Box>
+      </DialogContent>
+      <DialogActions>
+        <Button variant="contained" onClick={onClose}>Done</Button>
+      </DialogActions>
+    </Dialog>
+  )
+}
@@ -0,0 +1,144 @@
+import {
+  Table,
+  TableBody,
+  TableCell,
+  TableContainer,
+  TableHead,
+  TableRow,
+  Paper,
+  IconButton,
+  Skeleton,
+  Box,
+  Typography,
+  Chip,
+  Tooltip,
+} from '@mui/material'
+import {
+  Autorenew as RotateIcon,
+  Block as RevokeIcon,
+} from '@mui/icons-material'
+import type { APIKey } from '../../../apis/proto/opengate/v1/api_keys'
+
+interface APIKeyTableProps {
+  apiKeys: APIKey[]
+  loading: boolean
+  onRotate: (apiKey: APIKey) => void
+  onRevoke: (apiKey: APIKey) => void
+}
+
+const formatTimestamp = (timestamp: string | undefined): string => {
+  if (!timestamp || timestamp === '0') return '-'
+  const date = new Date(parseInt(timestamp, 10) * 1000)
+  return date.toLocaleString()
+}
+
+const KeyStatus = ({ apiKey }: { apiKey: APIKey }) => {
+  if (apiKey.revokedAt && apiKey.revokedAt !== '0') {
+    return <Chip label="Revoked" size="small" color="error" />
+  }
+  if (apiKey.expiresAt && apiKey.expiresAt !== '0' && parseInt(apiKey.expiresAt, 10) * 1000 <= Date.now()) {
+    return <Chip label="Expired" size="small" color="warning" />
+  }
+  return <Chip label="Active" size="small" color="success" />
+}
+
+const HEADERS = ['Name', 'Key', 'Owner', 'Scopes', 'Expires', 'Status']
+
+export const APIKeyTable = ({ apiKeys, loading, onRotate, onRevoke }: APIKeyTableProps) => {
+  if (loading && apiKeys.length === 0) {
+    return (
+      <TableContainer component={Paper} sx={{ borderRadius: 2 }}>
+        <Table>
+          <TableHead>
+            <TableRow>
+              {HEADERS.map((header) => (
+                <TableCell key={header}>{header}</TableCell>
+              ))}
+              <TableCell align="right">Actions</TableCell>
+            </TableRow>
+          </TableHead>
+          <TableBody>
+            {Array.from({ length: 5 }).map((_, idx) => (
+              <TableRow key={idx}>
+                {HEADERS.map((header) => (
+                  <TableCell key={header}><Skeleton /></TableCell>
+                ))}
+                <TableCell><Skeleton width={80} /></TableCell>
+              </TableRow>
+            ))}
+          </TableBody>
+        </Table>
+      </TableContainer>
+    )
+  }
+
+  if (!loading && apiKeys.length === 0) {
+    return (
+      <Paper sx={{ p: 4, textAlign: 'center', borderRadius: 2 }}>
+        <Typography color="text.secondary">
+          No API keys found. Create a key for your first machine client.
+        </Typography>
+      </Paper>
+    )
+  }
+
+  return (
+    <TableContainer component={Paper} sx={{ borderRadius: 2 }}>
+      <Table>
+        <TableHead>
+          <TableRow>
+            {HEADERS.map((header) => (
+              <TableCell key={header}>{header}</TableCell>
+            ))}
+            <TableCell align="right">Actions</TableCell>
+          </TableRow>
+        </TableHead>
+        <TableBody>
+          {apiKeys.map((apiKey) => {
+            const revoked = !!apiKey.revokedAt && apiKey.revokedAt !== '0'
+            return (
+              <TableRow key={apiKey.id} hover>
+                <TableCell>
+                  <Typography fontWeight={500}>{apiKey.name}</Typography>
+                </TableCell>
+                <TableCell>
+                  <Typography variant="body2" sx={{ fontFamily: 'monospace' }}>
+                    {apiKey.prefix}…
+                  </Typography>
+                </TableCell>
+                <TableCell>{apiKey.owner || '-'}</TableCell>
+                <TableCell>
+                  <Box sx={{ display: 'flex', gap: 0.5, flexWrap: 'wrap' }}>
+                    {apiKey.scopes?.length > 0
+                      ? apiKey.scopes.map((scope) => <Chip key={scope} label={scope} size="small" variant="outlined" />)
+                      : '-'}
+                  </Box>
+                </TableCell>
+                <TableCell>{formatTimestamp(apiKey.expiresAt)}</TableCell>
+                <TableCell>
+                  <KeyStatus apiKey={apiKey} />
+                </TableCell>
+                <TableCell align="right">
+                  <Tooltip title="Rotate">
+                    <span>
+                      <IconButton size="small" disabled={revoked} onClick={() => onRotate(apiKey)}>
+                        <RotateIcon fontSize="small" />
+                      </IconButton>
+                    </span>
+                  </Tooltip>
+                  <Tooltip title="Revoke">
+                    <span>
+                      <IconButton size="small" color="error" disabled={revoked} onClick={() => onRevoke(apiKey)}>
+                        <RevokeIcon fontSize="small" />
+                      </IconButton>
+                    </span>
+                  </Tooltip>
+                </TableCell>
+              </TableRow>
+            )
+          })}
+        </TableBody>
+      </Table>
+    </TableContainer>
+  )
+}
@@ -0,0 +1,3 @@
+export { APIKeyTable } from './APIKeyTable'
+export { APIKeyFormDialog } from './APIKeyFormDialog'
+export { APIKeyRevealDialog } from './APIKeyRevealDialog'
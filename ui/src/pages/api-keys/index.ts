@@ -0,0 +1 @@
+export { APIKeysPage } from './APIKeysPage'
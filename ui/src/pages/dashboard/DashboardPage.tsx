@@ -1,5 +1,7 @@
 import { Container, Box, Typography, Card, CardContent, Skeleton, Alert } from '@mui/material'
 import RouteIcon from '@mui/icons-material/AltRoute'
+import HealthyIcon from '@mui/icons-material/CheckCircleOutline'
+import UnhealthyIcon from '@mui/icons-material/ErrorOutline'
 import { useStats } from '../../hooks/useStats'
 import { useEffect } from 'react'
 import { PageHeader } from '../../components'
@@ -78,6 +80,18 @@ export const DashboardPage = () => {
       icon: <RouteIcon sx={{ fontSize: 28 }} />,
       color: '#2196f3',
     },
+    {
+      title: 'Healthy Targets',
+      count: stats?.healthyTargets ?? 0,
+      icon: <HealthyIcon sx={{ fontSize: 28 }} />,
+      color: '#4caf50',
+    },
+    {
+      title: 'Unhealthy Targets',
+      count: stats?.unhealthyTargets ?? 0,
+      icon: <UnhealthyIcon sx={{ fontSize: 28 }} />,
+      color: '#f44336',
+    },
   ]
 
   return (
@@ -105,7 +119,7 @@ export const DashboardPage = () => {
         }}
       >
         {loading
-          ? Array.from({ length: 1 }).map((_, idx) => (
+          ? Array.from({ length: statCards.length }).map((_, idx) => (
               <StatCardSkeleton key={idx} />
             ))
           : statCards.map((stat) => (
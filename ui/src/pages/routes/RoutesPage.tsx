@@ -1,12 +1,13 @@
 import { useState, useEffect } from 'react'
 import { useSearchParams } from 'react-router-dom'
 import { Box, Container, Button, TextField, InputAdornment } from '@mui/material'
-import { Add as AddIcon, Search as SearchIcon } from '@mui/icons-material'
+import { Add as AddIcon, Search as SearchIcon, PlayArrow as PlayArrowIcon } from '@mui/icons-material'
 import { ConfirmDialog } from '@gofreego/tsutils'
 import { useConfigs } from '../../hooks/useConfigs'
 import { RouteTable } from './components/RouteTable'
 import { RouteFormDialog } from './components/RouteFormDialog'
 import { RouteViewDialog } from './components/RouteViewDialog'
+import { RouteTestDialog } from './components/RouteTestDialog'
 import { PageHeader } from '../../components'
 import type { Config, CreateConfigRequest, UpdateConfigRequest } from '../../apis/proto/opengate/v1/config'
 
@@ -22,6 +23,7 @@ export const RoutesPage = () => {
     loadConfigs,
     createConfig,
     updateConfig,
+    setRouteWeights,
     deleteConfig,
     getConfigById,
     clearSelectedConfig,
@@ -30,6 +32,7 @@ export const RoutesPage = () => {
   const [openFormDialog, setOpenFormDialog] = useState(false)
   const [openViewDialog, setOpenViewDialog] = useState(false)
   const [openConfirmDialog, setOpenConfirmDialog] = useState(false)
+  const [openTestDialog, setOpenTestDialog] = useState(false)
   const [deleteId, setDeleteId] = useState<string>('')
   const [editData, setEditData] = useState<Config | null>(null)
   const [searchInput, setSearchInput] = useState<string>(searchParams.get('search') || '')
@@ -125,13 +128,22 @@ export const RoutesPage = () => {
         title="Routes"
         subtitle="Manage your API gateway route configurations"
         action={
-          <Button
-            variant="contained"
-            startIcon={<AddIcon />}
-            onClick={handleCreate}
-          >
-            Add Route
-          </Button>
+          <Box sx={{ display: 'flex', gap: 1 }}>
+            <Button
+              variant="outlined"
+              startIcon={<PlayArrowIcon />}
+              onClick={() => setOpenTestDialog(true)}
+            >
+              Test Route
+            </Button>
+            <Button
+              variant="contained"
+              startIcon={<AddIcon />}
+              onClick={handleCreate}
+            >
+              Add Route
+            </Button>
+          </Box>
         }
       />
 
@@ -183,12 +195,16 @@ export const RoutesPage = () => {
         open={openViewDialog}
         onClose={handleCloseViewDialog}
         config={selectedConfig}
+        onSetWeights={(weights) => selectedConfig && setRouteWeights(selectedConfig.id, weights)}
         onEdit={() => {
           handleCloseViewDialog()
           if (selectedConfig) handleEdit(selectedConfig)
         }}
       />
 
+      {/* Test Dialog */}
+      <RouteTestDialog open={openTestDialog} onClose={() => setOpenTestDialog(false)} />
+
       {/* Confirm Delete Dialog */}
       <ConfirmDialog
         open={openConfirmDialog}
@@ -19,12 +19,186 @@ import {
   Select,
   MenuItem,
   OutlinedInput,
+  FormHelperText,
 } from '@mui/material'
 import { Add as AddIcon, Close as CloseIcon, Delete as DeleteIcon } from '@mui/icons-material'
-import type { Config, CreateConfigRequest, UpdateConfigRequest, Authentication, AuthenticationException } from '../../../apis/proto/opengate/v1/config'
+import type { Config, CreateConfigRequest, UpdateConfigRequest, Authentication, AuthenticationException, ClaimRequirement, LoadBalancer, HealthCheck, CircuitBreaker, RetryPolicy, Identity, Mirror, RateLimit, Requirements, Rewrite, RouteMatch, Target, TrafficSplit } from '../../../apis/proto/opengate/v1/config'
 
 const HTTP_METHODS = ['GET', 'POST', 'PUT', 'PATCH', 'DELETE', 'OPTIONS', 'HEAD']
 
+const LB_POLICIES = [
+  { value: 'round_robin', label: 'Round Robin' },
+  { value: 'weighted_round_robin', label: 'Weighted Round Robin' },
+  { value: 'least_connections', label: 'Least Connections' },
+  { value: 'random_two_choices', label: 'Random Two Choices' },
+  { value: 'consistent_hash', label: 'Consistent Hash' },
+]
+
+// MatchCondition is a header or query param condition as edited in the form
+interface MatchCondition {
+  kind: 'header' | 'query'
+  name: string
+  op: 'exact' | 'regex' | 'present'
+  value: string
+}
+
+// IdentityHeader is a header of the identity as edited in the form, set from a claim or to a static value
+interface IdentityHeader {
+  kind: 'claim' | 'static'
+  header: string
+  value: string
+}
+
+const identityHeadersFromModel = (identity?: Identity): IdentityHeader[] => [
+  ...(identity?.claimHeaders || []).map((h) => ({ kind: 'claim' as const, header: h.header, value: h.claim })),
+  ...(identity?.staticHeaders || []).map((h) => ({ kind: 'static' as const, header: h.key, value: h.value })),
+]
+
+const RATE_LIMIT_KEYS = [
+  { value: 'client_ip', label: 'Client IP' },
+  { value: 'user_id', label: 'User ID' },
+  { value: 'api_key', label: 'API Key' },
+  { value: 'header', label: 'Header' },
+]
+
+// SplitVersion is a version of a split route as edited in the form, targets being comma separated URLs
+interface SplitVersion {
+  name: string
+  weight: string
+  targets: string
+}
+
+const STICKY_ON_OPTIONS = [
+  { value: '', label: 'None (random)' },
+  { value: 'header', label: 'Header' },
+  { value: 'cookie', label: 'Cookie' },
+  { value: 'client_ip', label: 'Client IP' },
+]
+
+// RewriteMode is the path rewrite of a route as edited in the form
+type RewriteMode = 'none' | 'prefix' | 'regex' | 'path'
+
+const rewriteModeFromModel = (rewrite?: Rewrite): RewriteMode =>
+  rewrite?.regex ? 'regex' : rewrite?.path ? 'path' : rewrite?.prefix ? 'prefix' : 'none'
+
+const MIDDLEWARES = ['cors', 'logging', 'request_id', 'headers']
+
+// AUTH_STRATEGIES are the auth strategies the gateway may enable, see Auth.Strategies in its config
+const AUTH_STRATEGIES = ['OpenAuth', 'JWT', 'Basic', 'APIKey', 'ForwardAuth', 'Introspection', 'ClientCert', 'None']
+
+// RequirementsForm holds requirements as edited in the form: comma separated permissions and profile IDs,
+// and one "name operator value1,value2" claim requirement per line
+interface RequirementsForm {
+  permissions: string
+  profiles: string
+  claims: string
+}
+
+const EMPTY_REQUIREMENTS: RequirementsForm = { permissions: '', profiles: '', claims: '' }
+
+const splitList = (text: string): string[] => text.split(',').map((s) => s.trim()).filter(Boolean)
+
+const requirementsFromModel = (require?: Requirements): RequirementsForm => ({
+  permissions: (require?.permissions || []).join(', '),
+  profiles: (require?.profiles || []).join(', '),
+  claims: (require?.claims || [])
+    .map((c) => [c.name, c.operator || 'equals', c.values.join(',')].filter(Boolean).join(' '))
+    .join('\n'),
+})
+
+// requirementsToModel parses the requirements form, undefined when nothing is required
+const requirementsToModel = (form: RequirementsForm): Requirements | undefined => {
+  const claims = form.claims
+    .split('\n')
+    .map((line) => line.trim().split(/\s+/))
+    .filter((parts) => parts[0])
+    .map(([name, operator = '', values = '']): ClaimRequirement => ({ name, operator, values: splitList(values) }))
+  const require: Requirements = {
+    permissions: splitList(form.permissions),
+    profiles: splitList(form.profiles).filter((p) => /^\d+$/.test(p)),
+    claims,
+  }
+  if (!require.permissions.length && !require.profiles.length && !require.claims.length) {
+    return undefined
+  }
+  return require
+}
+
+// RequirementsFields edits the permissions, profiles and claims a client must have
+const RequirementsFields = ({ value, onChange }: { value: RequirementsForm; onChange: (value: RequirementsForm) => void }) => (
+  <Box sx={{ display: 'flex', flexDirection: 'column', gap: 1 }}>
+    <Box sx={{ display: 'flex', gap: 1 }}>
+      <TextField
+        size="small"
+        label="Required Permissions"
+        value={value.permissions}
+        onChange={(e) => onChange({ ...value, permissions: e.target.value })}
+        placeholder="orders.read, orders.write"
+        helperText="All of them, comma separated"
+        sx={{ flex: 2 }}
+      />
+      <TextField
+        size="small"
+        label="Profiles"
+        value={value.profiles}
+        onChange={(e) => onChange({ ...value, profiles: e.target.value })}
+        placeholder="1, 2"
+        helperText="One of these profile IDs"
+        sx={{ flex: 1 }}
+      />
+    </Box>
+    <TextField
+      size="small"
+      label="Claims"
+      value={value.claims}
+      onChange={(e) => onChange({ ...value, claims: e.target.value })}
+      placeholder={'org.tier equals gold,platinum\nroles contains support'}
+      helperText="One per line: claim, operator (exists, equals, not_equals, contains) and comma separated values"
+      multiline
+      minRows={2}
+      fullWidth
+      InputProps={{ sx: { fontFamily: 'monospace' } }}
+    />
+  </Box>
+)
+
+const RETRY_ON_OPTIONS = ['connect-failure', 'reset', 'timeout', '5xx', 'gateway-error']
+
+const HASH_ON_OPTIONS = [
+  { value: 'header', label: 'Header' },
+  { value: 'cookie', label: 'Cookie' },
+  { value: 'client_ip', label: 'Client IP' },
+]
+
+// parseMiddlewareConfig parses the middleware config editor, returning null when it isn't a JSON object
+const parseMiddlewareConfig = (text: string): { [key: string]: any } | undefined | null => {
+  if (!text.trim()) {
+    return undefined
+  }
+  try {
+    const parsed = JSON.parse(text)
+    return parsed && typeof parsed === 'object' && !Array.isArray(parsed) ? parsed : null
+  } catch {
+    return null
+  }
+}
+
+// matchConditionsFromModel flattens the header and query param conditions of a route for the form
+const matchConditionsFromModel = (match?: RouteMatch): MatchCondition[] => [
+  ...(match?.headers || []).map((h): MatchCondition => ({
+    kind: 'header',
+    name: h.name,
+    op: h.regex ? 'regex' : h.exact ? 'exact' : 'present',
+    value: h.regex || h.exact || '',
+  })),
+  ...(match?.queryParams || []).map((q): MatchCondition => ({
+    kind: 'query',
+    name: q.name,
+    op: q.exact ? 'exact' : 'present',
+    value: q.exact || '',
+  })),
+]
+
 interface ConfigFormDialogProps {
   open: boolean
   onClose: () => void
@@ -40,29 +214,142 @@ export const RouteFormDialog = ({
 }: ConfigFormDialogProps) => {
   const [name, setName] = useState('')
   const [pathPrefix, setPathPrefix] = useState('')
+  const [hosts, setHosts] = useState('')
+  const [matchMethods, setMatchMethods] = useState<string[]>([])
+  const [matchConditions, setMatchConditions] = useState<MatchCondition[]>([])
+  const [priority, setPriority] = useState('0')
   const [targetUrl, setTargetUrl] = useState('')
+  const [targets, setTargets] = useState<Target[]>([])
+  const [lbPolicy, setLbPolicy] = useState('round_robin')
+  const [hashOn, setHashOn] = useState('header')
+  const [hashKey, setHashKey] = useState('')
+  const [healthCheckEnabled, setHealthCheckEnabled] = useState(false)
+  const [hcPath, setHcPath] = useState('/health')
+  const [hcInterval, setHcInterval] = useState('10000000000') // 10s in nanoseconds
+  const [hcTimeout, setHcTimeout] = useState('2000000000') // 2s in nanoseconds
+  const [hcHealthyThreshold, setHcHealthyThreshold] = useState('2')
+  const [hcUnhealthyThreshold, setHcUnhealthyThreshold] = useState('3')
+  const [hcExpectedStatus, setHcExpectedStatus] = useState('')
+  const [circuitBreakerEnabled, setCircuitBreakerEnabled] = useState(false)
+  const [cbConsecutiveFailures, setCbConsecutiveFailures] = useState('5')
+  const [cbErrorRateThreshold, setCbErrorRateThreshold] = useState('')
+  const [cbEjectionDuration, setCbEjectionDuration] = useState('30000000000') // 30s in nanoseconds
+  const [retryEnabled, setRetryEnabled] = useState(false)
+  const [retryAttempts, setRetryAttempts] = useState('2')
+  const [retryOn, setRetryOn] = useState<string[]>(['connect-failure', 'reset'])
+  const [retryPerTryTimeout, setRetryPerTryTimeout] = useState('')
+  const [retryPost, setRetryPost] = useState(false)
+  const [identityEnabled, setIdentityEnabled] = useState(false)
+  const [identityHeaders, setIdentityHeaders] = useState<IdentityHeader[]>([])
+  const [omitDefaultHeaders, setOmitDefaultHeaders] = useState(false)
+  const [forwardToken, setForwardToken] = useState(false)
+  const [internalToken, setInternalToken] = useState(false)
+  const [rateLimitEnabled, setRateLimitEnabled] = useState(false)
+  const [rlAlgorithm, setRlAlgorithm] = useState('token_bucket')
+  const [rlRequests, setRlRequests] = useState('100')
+  const [rlWindow, setRlWindow] = useState('1000000000') // 1s in nanoseconds
+  const [rlBurst, setRlBurst] = useState('')
+  const [rlKey, setRlKey] = useState('client_ip')
+  const [rlHeader, setRlHeader] = useState('')
+  const [mirrorEnabled, setMirrorEnabled] = useState(false)
+  const [mirrorTargetUrl, setMirrorTargetUrl] = useState('')
+  const [mirrorPercentage, setMirrorPercentage] = useState('100')
+  const [splitEnabled, setSplitEnabled] = useState(false)
+  const [splitVersions, setSplitVersions] = useState<SplitVersion[]>([])
+  const [stickyOn, setStickyOn] = useState('')
+  const [stickyKey, setStickyKey] = useState('')
   const [stripPrefix, setStripPrefix] = useState(false)
+  const [rewriteMode, setRewriteMode] = useState<RewriteMode>('none')
+  const [rewriteValue, setRewriteValue] = useState('')
+  const [rewriteReplacement, setRewriteReplacement] = useState('')
+  const [rewriteHost, setRewriteHost] = useState('')
   const [authRequired, setAuthRequired] = useState(false)
   const [authExcept, setAuthExcept] = useState<AuthenticationException[]>([])
+  const [authStrategies, setAuthStrategies] = useState<string[]>([])
+  const [authMode, setAuthMode] = useState('any')
+  const [authRequire, setAuthRequire] = useState<RequirementsForm>(EMPTY_REQUIREMENTS)
   const [middleware, setMiddleware] = useState<string[]>([])
   const [newMiddleware, setNewMiddleware] = useState('')
+  const [middlewareConfig, setMiddlewareConfig] = useState('')
   const [timeout, setTimeout] = useState('30000000000') // 30s in nanoseconds
   const [saving, setSaving] = useState(false)
 
   // New exception form state
   const [newExceptPath, setNewExceptPath] = useState('')
   const [newExceptMethods, setNewExceptMethods] = useState<string[]>([])
+  const [newExceptRequire, setNewExceptRequire] = useState<RequirementsForm>(EMPTY_REQUIREMENTS)
   const [showAddException, setShowAddException] = useState(false)
 
+  // New target form state
+  const [newTargetUrl, setNewTargetUrl] = useState('')
+  const [newTargetWeight, setNewTargetWeight] = useState('1')
+
   useEffect(() => {
     if (editData) {
       setName(editData.name)
       setPathPrefix(editData.pathPrefix)
+      setHosts((editData.hosts || []).join(', '))
+      setMatchMethods(editData.match?.methods || [])
+      setMatchConditions(matchConditionsFromModel(editData.match))
+      setPriority(String(editData.priority || 0))
       setTargetUrl(editData.targetUrl)
+      setTargets(editData.targets || [])
+      setLbPolicy(editData.loadBalancer?.policy || 'round_robin')
+      setHashOn(editData.loadBalancer?.hashOn || 'header')
+      setHashKey(editData.loadBalancer?.hashKey || '')
+      setHealthCheckEnabled(!!editData.healthCheck)
+      setHcPath(editData.healthCheck?.path || '/health')
+      setHcInterval(editData.healthCheck?.interval || '10000000000')
+      setHcTimeout(editData.healthCheck?.timeout || '2000000000')
+      setHcHealthyThreshold(String(editData.healthCheck?.healthyThreshold || 2))
+      setHcUnhealthyThreshold(String(editData.healthCheck?.unhealthyThreshold || 3))
+      setHcExpectedStatus(editData.healthCheck?.expectedStatus ? String(editData.healthCheck.expectedStatus) : '')
+      setCircuitBreakerEnabled(!!editData.circuitBreaker)
+      setCbConsecutiveFailures(editData.circuitBreaker ? String(editData.circuitBreaker.consecutiveFailures || '') : '5')
+      setCbErrorRateThreshold(editData.circuitBreaker?.errorRateThreshold ? String(editData.circuitBreaker.errorRateThreshold) : '')
+      setCbEjectionDuration(editData.circuitBreaker?.ejectionDuration || '30000000000')
+      setRetryEnabled(!!editData.retryPolicy)
+      setRetryAttempts(String(editData.retryPolicy?.attempts || 2))
+      setRetryOn(editData.retryPolicy?.retryOn?.length ? editData.retryPolicy.retryOn : ['connect-failure', 'reset'])
+      setRetryPerTryTimeout(editData.retryPolicy?.perTryTimeout && editData.retryPolicy.perTryTimeout !== '0' ? editData.retryPolicy.perTryTimeout : '')
+      setRetryPost(editData.retryPolicy?.idempotentMethods?.includes('POST') || false)
+      setMirrorEnabled(!!editData.mirror)
+      setMirrorTargetUrl(editData.mirror?.targetUrl || '')
+      setMirrorPercentage(String(editData.mirror?.percentage || 100))
+      setSplitEnabled(!!editData.split)
+      setSplitVersions(
+        (editData.split?.versions || []).map((v) => ({
+          name: v.name,
+          weight: String(v.weight || 0),
+          targets: v.targets.map((t) => t.url).join(', '),
+        }))
+      )
+      setStickyOn(editData.split?.stickyOn || '')
+      setStickyKey(editData.split?.stickyKey || '')
       setStripPrefix(editData.stripPrefix)
+      setRewriteMode(rewriteModeFromModel(editData.rewrite))
+      setRewriteValue(editData.rewrite?.regex || editData.rewrite?.path || editData.rewrite?.prefix || '')
+      setRewriteReplacement(editData.rewrite?.replacement || '')
+      setRewriteHost(editData.rewrite?.host || '')
       setAuthRequired(editData.authentication?.required || false)
       setAuthExcept(editData.authentication?.except || [])
+      setAuthStrategies(editData.authentication?.strategies || [])
+      setAuthMode(editData.authentication?.mode || 'any')
+      setAuthRequire(requirementsFromModel(editData.authentication?.require))
+      setIdentityEnabled(!!editData.identity)
+      setIdentityHeaders(identityHeadersFromModel(editData.identity))
+      setOmitDefaultHeaders(editData.identity?.omitDefaultHeaders || false)
+      setForwardToken(editData.identity?.forwardToken || false)
+      setInternalToken(editData.identity?.internalToken || false)
+      setRateLimitEnabled(!!editData.rateLimit)
+      setRlAlgorithm(editData.rateLimit?.algorithm || 'token_bucket')
+      setRlRequests(editData.rateLimit?.requests || '100')
+      setRlWindow(editData.rateLimit?.window && editData.rateLimit.window !== '0' ? editData.rateLimit.window : '1000000000')
+      setRlBurst(editData.rateLimit?.burst && editData.rateLimit.burst !== '0' ? editData.rateLimit.burst : '')
+      setRlKey(editData.rateLimit?.key || 'client_ip')
+      setRlHeader(editData.rateLimit?.header || '')
       setMiddleware(editData.middleware || [])
+      setMiddlewareConfig(editData.middlewareConfig ? JSON.stringify(editData.middlewareConfig, null, 2) : '')
       setTimeout(editData.timeout || '30000000000')
     } else {
       resetForm()
@@ -72,14 +359,68 @@ export const RouteFormDialog = ({
   const resetForm = () => {
     setName('')
     setPathPrefix('')
+    setHosts('')
+    setMatchMethods([])
+    setMatchConditions([])
+    setPriority('0')
     setTargetUrl('')
+    setTargets([])
+    setLbPolicy('round_robin')
+    setHashOn('header')
+    setHashKey('')
+    setNewTargetUrl('')
+    setNewTargetWeight('1')
+    setHealthCheckEnabled(false)
+    setHcPath('/health')
+    setHcInterval('10000000000')
+    setHcTimeout('2000000000')
+    setHcHealthyThreshold('2')
+    setHcUnhealthyThreshold('3')
+    setHcExpectedStatus('')
+    setCircuitBreakerEnabled(false)
+    setCbConsecutiveFailures('5')
+    setCbErrorRateThreshold('')
+    setCbEjectionDuration('30000000000')
+    setRetryEnabled(false)
+    setRetryAttempts('2')
+    setRetryOn(['connect-failure', 'reset'])
+    setRetryPerTryTimeout('')
+    setRetryPost(false)
+    setMirrorEnabled(false)
+    setMirrorTargetUrl('')
+    setMirrorPercentage('100')
+    setSplitEnabled(false)
+    setSplitVersions([])
+    setStickyOn('')
+    setStickyKey('')
     setStripPrefix(false)
+    setRewriteMode('none')
+    setRewriteValue('')
+    setRewriteReplacement('')
+    setRewriteHost('')
     setAuthRequired(false)
     setAuthExcept([])
+    setAuthStrategies([])
+    setAuthMode('any')
+    setAuthRequire(EMPTY_REQUIREMENTS)
+    setIdentityEnabled(false)
+    setIdentityHeaders([])
+    setOmitDefaultHeaders(false)
+    setForwardToken(false)
+    setInternalToken(false)
+    setRateLimitEnabled(false)
+    setRlAlgorithm('token_bucket')
+    setRlRequests('100')
+    setRlWindow('1000000000')
+    setRlBurst('')
+    setRlKey('client_ip')
+    setRlHeader('')
     setMiddleware([])
     setNewMiddleware('')
+    setMiddlewareConfig('')
     setNewExceptPath('')
     setNewExceptMethods([])
+    setNewExceptRequire(EMPTY_REQUIREMENTS)
     setShowAddException(false)
     setTimeout('30000000000')
   }
@@ -95,11 +436,25 @@ export const RouteFormDialog = ({
     setMiddleware(middleware.filter((m) => m !== item))
   }
 
+  const handleAddTarget = () => {
+    const url = newTargetUrl.trim()
+    if (url && !targets.some((t) => t.url === url)) {
+      setTargets([...targets, { url, weight: Math.max(parseInt(newTargetWeight, 10) || 1, 1) }])
+      setNewTargetUrl('')
+      setNewTargetWeight('1')
+    }
+  }
+
+  const handleRemoveTarget = (index: number) => {
+    setTargets(targets.filter((_, i) => i !== index))
+  }
+
   const handleAddException = () => {
     if (newExceptPath.trim()) {
-      setAuthExcept([...authExcept, { path: newExceptPath.trim(), methods: newExceptMethods }])
+      setAuthExcept([...authExcept, { path: newExceptPath.trim(), methods: newExceptMethods, require: requirementsToModel(newExceptRequire) }])
       setNewExceptPath('')
       setNewExceptMethods([])
+      setNewExceptRequire(EMPTY_REQUIREMENTS)
       setShowAddException(false)
     }
   }
@@ -114,15 +469,161 @@ export const RouteFormDialog = ({
       const authentication: Authentication = {
         required: authRequired,
         except: authExcept,
+        strategies: authStrategies,
+        mode: authStrategies.length > 1 ? authMode : '',
+        require: requirementsToModel(authRequire),
       }
 
+      const identity: Identity | undefined = identityEnabled
+        ? {
+            claimHeaders: identityHeaders
+              .filter((h) => h.kind === 'claim')
+              .map((h) => ({ claim: h.value.trim(), header: h.header.trim() })),
+            omitDefaultHeaders,
+            forwardToken,
+            internalToken,
+            staticHeaders: identityHeaders
+              .filter((h) => h.kind === 'static')
+              .map((h) => ({ key: h.header.trim(), value: h.value })),
+          }
+        : undefined
+
+      const rateLimit: RateLimit | undefined = rateLimitEnabled
+        ? {
+            algorithm: rlAlgorithm,
+            requests: rlRequests,
+            window: rlWindow || '0',
+            burst: rlAlgorithm === 'token_bucket' && rlBurst ? rlBurst : '0',
+            key: rlKey,
+            header: rlKey === 'header' ? rlHeader.trim() : '',
+          }
+        : undefined
+
+      const loadBalancer: LoadBalancer = {
+        policy: lbPolicy,
+        hashOn: lbPolicy === 'consistent_hash' ? hashOn : '',
+        hashKey: lbPolicy === 'consistent_hash' && hashOn !== 'client_ip' ? hashKey : '',
+      }
+
+      const healthCheck: HealthCheck | undefined = healthCheckEnabled
+        ? {
+            path: hcPath.trim(),
+            interval: hcInterval,
+            timeout: hcTimeout,
+            healthyThreshold: parseInt(hcHealthyThreshold, 10) || 0,
+            unhealthyThreshold: parseInt(hcUnhealthyThreshold, 10) || 0,
+            expectedStatus: parseInt(hcExpectedStatus, 10) || 0,
+          }
+        : undefined
+
+      // settings without a field in the form are kept as they were
+      const circuitBreaker: CircuitBreaker | undefined = circuitBreakerEnabled
+        ? {
+            minRequests: editData?.circuitBreaker?.minRequests || 0,
+            window: editData?.circuitBreaker?.window || '0',
+            halfOpenRequests: editData?.circuitBreaker?.halfOpenRequests || 0,
+            consecutiveFailures: parseInt(cbConsecutiveFailures, 10) || 0,
+            errorRateThreshold: parseInt(cbErrorRateThreshold, 10) || 0,
+            ejectionDuration: cbEjectionDuration,
+          }
+        : undefined
+
+      const otherIdempotentMethods = (editData?.retryPolicy?.idempotentMethods || []).filter((m) => m !== 'POST')
+      const retryPolicy: RetryPolicy | undefined = retryEnabled
+        ? {
+            backoffBase: editData?.retryPolicy?.backoffBase || '0',
+            backoffMax: editData?.retryPolicy?.backoffMax || '0',
+            budget: editData?.retryPolicy?.budget || 0,
+            maxBodyBytes: editData?.retryPolicy?.maxBodyBytes || '0',
+            attempts: parseInt(retryAttempts, 10) || 0,
+            retryOn,
+            perTryTimeout: retryPerTryTimeout || '0',
+            idempotentMethods: retryPost ? [...otherIdempotentMethods, 'POST'] : otherIdempotentMethods,
+          }
+        : undefined
+
+      const mirror: Mirror | undefined = mirrorEnabled
+        ? {
+            maxBodyBytes: editData?.mirror?.maxBodyBytes || '0',
+            timeout: editData?.mirror?.timeout || '0',
+            targetUrl: mirrorTargetUrl.trim(),
+            percentage: parseFloat(mirrorPercentage) || 0,
+          }
+        : undefined
+
+      const match: RouteMatch | undefined =
+        matchMethods.length > 0 || matchConditions.length > 0
+          ? {
+              methods: matchMethods,
+              headers: matchConditions
+                .filter((c) => c.kind === 'header')
+                .map((c) => ({
+                  name: c.name.trim(),
+                  exact: c.op === 'exact' ? c.value : '',
+                  regex: c.op === 'regex' ? c.value : '',
+                  present: c.op === 'present',
+                })),
+              queryParams: matchConditions
+                .filter((c) => c.kind === 'query')
+                .map((c) => ({
+                  name: c.name.trim(),
+                  exact: c.op === 'exact' ? c.value : '',
+                  present: c.op === 'present',
+                })),
+            }
+          : undefined
+
+      const split: TrafficSplit | undefined = splitEnabled
+        ? {
+            versions: splitVersions.map((v) => ({
+              name: v.name.trim(),
+              weight: parseInt(v.weight, 10) || 0,
+              targets: v.targets
+                .split(',')
+                .map((url) => url.trim())
+                .filter(Boolean)
+                .map((url) => ({ url, weight: 1 })),
+            })),
+            stickyOn,
+            stickyKey: stickyOn === 'header' || stickyOn === 'cookie' ? stickyKey.trim() : '',
+          }
+        : undefined
+
+      const rewrite: Rewrite | undefined =
+        rewriteMode !== 'none' || rewriteHost.trim()
+          ? {
+              prefix: rewriteMode === 'prefix' ? rewriteValue : '',
+              regex: rewriteMode === 'regex' ? rewriteValue : '',
+              replacement: rewriteMode === 'regex' ? rewriteReplacement : '',
+              path: rewriteMode === 'path' ? rewriteValue : '',
+              host: rewriteHost.trim(),
+            }
+          : undefined
+
       const data: CreateConfigRequest | UpdateConfigRequest = {
         name,
         pathPrefix,
+        hosts: hosts
+          .split(',')
+          .map((h) => h.trim())
+          .filter(Boolean),
+        match,
+        priority: parseInt(priority, 10) || 0,
         targetUrl,
+        targets,
+        split,
+        loadBalancer,
+        healthCheck,
+        circuitBreaker,
+        retryPolicy,
+        mirror,
         stripPrefix,
+        rewrite,
         authentication,
+        identity,
+        rateLimit,
         middleware,
+        middlewareConfig: parseMiddlewareConfig(middlewareConfig) || undefined,
         timeout,
         ...(editData && { id: editData.id }),
       }
@@ -134,7 +635,38 @@ export const RouteFormDialog = ({
     }
   }
 
-  const isValid = name.trim() && pathPrefix.trim() && targetUrl.trim()
+  const updateSplitVersion = (index: number, changes: Partial<SplitVersion>) => {
+    setSplitVersions(splitVersions.map((v, i) => (i === index ? { ...v, ...changes } : v)))
+  }
+
+  const updateIdentityHeader = (index: number, changes: Partial<IdentityHeader>) => {
+    setIdentityHeaders(identityHeaders.map((h, i) => (i === index ? { ...h, ...changes } : h)))
+  }
+
+  const updateMatchCondition = (index: number, changes: Partial<MatchCondition>) => {
+    setMatchConditions(matchConditions.map((c, i) => (i === index ? { ...c, ...changes } : c)))
+  }
+
+  const middlewareConfigValid = parseMiddlewareConfig(middlewareConfig) !== null
+  const needsHashKey = lbPolicy === 'consistent_hash' && hashOn !== 'client_ip'
+  const isValid =
+    name.trim() &&
+    pathPrefix.trim() &&
+    (targetUrl.trim() || targets.length > 0 || splitEnabled) &&
+    (!splitEnabled ||
+      (splitVersions.length > 0 &&
+        splitVersions.every((v) => v.name.trim() && v.targets.trim()) &&
+        splitVersions.some((v) => parseInt(v.weight, 10) > 0) &&
+        ((stickyOn !== 'header' && stickyOn !== 'cookie') || stickyKey.trim()))) &&
+    (!needsHashKey || hashKey.trim()) &&
+    (!healthCheckEnabled || hcPath.trim().startsWith('/')) &&
+    (!mirrorEnabled || /^https?:\/\/.+/.test(mirrorTargetUrl.trim())) &&
+    (rewriteMode === 'none' || (rewriteValue.trim() && !stripPrefix)) &&
+    middlewareConfigValid &&
+    matchConditions.every((c) => c.name.trim() && (c.op === 'present' || c.value)) &&
+    (!rateLimitEnabled || (parseInt(rlRequests, 10) > 0 && (rlKey !== 'header' || rlHeader.trim()))) &&
+    (!identityEnabled || identityHeaders.every((h) => h.header.trim() && (h.kind === 'static' || h.value.trim()))) &&
+    (!circuitBreakerEnabled || parseInt(cbConsecutiveFailures, 10) > 0 || parseInt(cbErrorRateThreshold, 10) > 0)
 
   return (
     <Dialog open={open} onClose={onClose} maxWidth="md" fullWidth>
@@ -158,15 +690,296 @@ export const RouteFormDialog = ({
             fullWidth
             required
             placeholder="e.g., /api/users"
+            helperText="Supports {name} parameters, * single segment and trailing ** wildcards"
           />
+          <TextField
+            label="Hosts"
+            value={hosts}
+            onChange={(e) => setHosts(e.target.value)}
+            fullWidth
+            placeholder="e.g., api.example.com, *.example.com"
+            helperText="Comma separated, the route is served on any host when empty"
+          />
+
+          {/* Match Conditions */}
+          <Box>
+            <Box sx={{ display: 'flex', justifyContent: 'space-between', alignItems: 'center', mb: 1 }}>
+              <Typography variant="subtitle2">Match Conditions</Typography>
+              <Button
+                variant="outlined"
+                size="small"
+                startIcon={<AddIcon />}
+                onClick={() => setMatchConditions([...matchConditions, { kind: 'header', name: '', op: 'exact', value: '' }])}
+              >
+                Add
+              </Button>
+            </Box>
+            <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap', alignItems: 'center', mb: 1 }}>
+              <FormControl size="small" sx={{ minWidth: 220 }}>
+                <InputLabel>Methods</InputLabel>
+                <Select<string[]>
+                  multiple
+                  value={matchMethods}
+                  onChange={(e) => {
+                    const value = e.target.value
+                    setMatchMethods(typeof value === 'string' ? value.split(',') : value)
+                  }}
+                  input={<OutlinedInput label="Methods" />}
+                  renderValue={(selected) => (selected.length === 0 ? 'All methods' : selected.join(', '))}
+                >
+                  {HTTP_METHODS.map((method) => (
+                    <MenuItem key={method} value={method}>
+                      {method}
+                    </MenuItem>
+                  ))}
+                </Select>
+              </FormControl>
+              <TextField
+                size="small"
+                type="number"
+                label="Priority"
+                value={priority}
+                onChange={(e) => setPriority(e.target.value)}
+                helperText="Higher priority routes of the same prefix are tried first"
+                sx={{ width: 220 }}
+              />
+            </Box>
+            {matchConditions.map((condition, index) => (
+              <Box key={index} sx={{ display: 'flex', gap: 1, alignItems: 'center', mb: 1 }}>
+                <Select
+                  size="small"
+                  value={condition.kind}
+                  onChange={(e) =>
+                    updateMatchCondition(index, {
+                      kind: e.target.value as MatchCondition['kind'],
+                      op: condition.op === 'regex' ? 'exact' : condition.op,
+                    })
+                  }
+                  sx={{ width: 110 }}
+                >
+                  <MenuItem value="header">Header</MenuItem>
+                  <MenuItem value="query">Query</MenuItem>
+                </Select>
+                <TextField
+                  size="small"
+                  label="Name"
+                  value={condition.name}
+                  onChange={(e) => updateMatchCondition(index, { name: e.target.value })}
+                  placeholder={condition.kind === 'header' ? 'X-Api-Version' : 'version'}
+                  sx={{ flex: 1 }}
+                />
+                <Select
+                  size="small"
+                  value={condition.op}
+                  onChange={(e) => updateMatchCondition(index, { op: e.target.value as MatchCondition['op'] })}
+                  sx={{ width: 120 }}
+                >
+                  <MenuItem value="exact">Equals</MenuItem>
+                  {condition.kind === 'header' && <MenuItem value="regex">Regex</MenuItem>}
+                  <MenuItem value="present">Present</MenuItem>
+                </Select>
+                <TextField
+                  size="small"
+                  label="Value"
+                  value={condition.value}
+                  onChange={(e) => updateMatchCondition(index, { value: e.target.value })}
+                  disabled={condition.op === 'present'}
+                  sx={{ flex: 1 }}
+                />
+                <IconButton size="small" onClick={() => setMatchConditions(matchConditions.filter((_, i) => i !== index))}>
+                  <DeleteIcon fontSize="small" />
+                </IconButton>
+              </Box>
+            ))}
+          </Box>
           <TextField
             label="Target URL"
             value={targetUrl}
             onChange={(e) => setTargetUrl(e.target.value)}
             fullWidth
-            required
+            required={targets.length === 0 && !splitEnabled}
             placeholder="e.g., http://user-service:8080"
+            helperText={
+              splitEnabled
+                ? 'Ignored while the traffic split is enabled'
+                : targets.length > 0
+                  ? 'Ignored while upstream targets are configured'
+                  : undefined
+            }
+          />
+
+          {/* Upstream Targets */}
+          <Box>
+            <Typography variant="subtitle2" sx={{ mb: 1 }}>
+              Upstream Targets
+            </Typography>
+            <Box sx={{ display: 'flex', gap: 1, mb: 1 }}>
+              <TextField
+                size="small"
+                label="URL"
+                value={newTargetUrl}
+                onChange={(e) => setNewTargetUrl(e.target.value)}
+                placeholder="http://user-service-1:8080"
+                sx={{ flex: 1 }}
+                onKeyPress={(e) => e.key === 'Enter' && handleAddTarget()}
+              />
+              <TextField
+                size="small"
+                label="Weight"
+                type="number"
+                value={newTargetWeight}
+                onChange={(e) => setNewTargetWeight(e.target.value)}
+                sx={{ width: 100 }}
+              />
+              <IconButton onClick={handleAddTarget} size="small" disabled={!newTargetUrl.trim()}>
+                <AddIcon />
+              </IconButton>
+            </Box>
+            {targets.length > 0 && (
+              <Box sx={{ display: 'flex', flexDirection: 'column', gap: 1, mb: 1 }}>
+                {targets.map((target, index) => (
+                  <Paper
+                    key={target.url}
+                    variant="outlined"
+                    sx={{
+                      p: 1,
+                      display: 'flex',
+                      alignItems: 'center',
+                      justifyContent: 'space-between',
+                      bgcolor: 'action.hover',
+                    }}
+                  >
+                    <Typography variant="body2" sx={{ fontFamily: 'monospace' }}>
+                      {target.url}
+                    </Typography>
+                    <Box sx={{ display: 'flex', alignItems: 'center', gap: 1 }}>
+                      <Chip label={`weight ${target.weight || 1}`} size="small" variant="outlined" />
+                      <IconButton size="small" color="error" onClick={() => handleRemoveTarget(index)}>
+                        <DeleteIcon fontSize="small" />
+                      </IconButton>
+                    </Box>
+                  </Paper>
+                ))}
+              </Box>
+            )}
+            <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap' }}>
+              <FormControl size="small" sx={{ minWidth: 220 }}>
+                <InputLabel>Load Balancing</InputLabel>
+                <Select
+                  value={lbPolicy}
+                  label="Load Balancing"
+                  onChange={(e) => setLbPolicy(e.target.value)}
+                >
+                  {LB_POLICIES.map((policy) => (
+                    <MenuItem key={policy.value} value={policy.value}>
+                      {policy.label}
+                    </MenuItem>
+                  ))}
+                </Select>
+              </FormControl>
+              {lbPolicy === 'consistent_hash' && (
+                <FormControl size="small" sx={{ minWidth: 140 }}>
+                  <InputLabel>Hash On</InputLabel>
+                  <Select value={hashOn} label="Hash On" onChange={(e) => setHashOn(e.target.value)}>
+                    {HASH_ON_OPTIONS.map((option) => (
+                      <MenuItem key={option.value} value={option.value}>
+                        {option.label}
+                      </MenuItem>
+                    ))}
+                  </Select>
+                </FormControl>
+              )}
+              {needsHashKey && (
+                <TextField
+                  size="small"
+                  label={hashOn === 'cookie' ? 'Cookie Name' : 'Header Name'}
+                  value={hashKey}
+                  onChange={(e) => setHashKey(e.target.value)}
+                  required
+                />
+              )}
+            </Box>
+          </Box>
+          <FormControlLabel
+            control={
+              <Switch
+                checked={splitEnabled}
+                onChange={(e) => {
+                  setSplitEnabled(e.target.checked)
+                  if (e.target.checked && splitVersions.length === 0) {
+                    setSplitVersions([
+                      { name: 'stable', weight: '100', targets: targetUrl.trim() || targets.map((t) => t.url).join(', ') },
+                      { name: 'canary', weight: '0', targets: '' },
+                    ])
+                  }
+                }}
+              />
+            }
+            label="Traffic Split (canary)"
           />
+          {splitEnabled && (
+            <Box>
+              {splitVersions.map((version, index) => (
+                <Box key={index} sx={{ display: 'flex', gap: 1, alignItems: 'center', mb: 1 }}>
+                  <TextField
+                    size="small"
+                    label="Version"
+                    value={version.name}
+                    onChange={(e) => updateSplitVersion(index, { name: e.target.value })}
+                    sx={{ width: 140 }}
+                  />
+                  <TextField
+                    size="small"
+                    type="number"
+                    label="Weight"
+                    value={version.weight}
+                    onChange={(e) => updateSplitVersion(index, { weight: e.target.value })}
+                    sx={{ width: 100 }}
+                  />
+                  <TextField
+                    size="small"
+                    label="Target URLs"
+                    value={version.targets}
+                    onChange={(e) => updateSplitVersion(index, { targets: e.target.value })}
+                    placeholder="http://checkout-v2:8080, http://checkout-v2-b:8080"
+                    sx={{ flex: 1 }}
+                  />
+                  <IconButton size="small" onClick={() => setSplitVersions(splitVersions.filter((_, i) => i !== index))}>
+                    <DeleteIcon fontSize="small" />
+                  </IconButton>
+                </Box>
+              ))}
+              <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap', alignItems: 'center' }}>
+                <Button
+                  variant="outlined"
+                  size="small"
+                  startIcon={<AddIcon />}
+                  onClick={() => setSplitVersions([...splitVersions, { name: '', weight: '0', targets: '' }])}
+                >
+                  Add Version
+                </Button>
+                <FormControl size="small" sx={{ minWidth: 160 }}>
+                  <InputLabel>Sticky On</InputLabel>
+                  <Select value={stickyOn} label="Sticky On" onChange={(e) => setStickyOn(e.target.value)}>
+                    {STICKY_ON_OPTIONS.map((option) => (
+                      <MenuItem key={option.value} value={option.value}>
+                        {option.label}
+                      </MenuItem>
+                    ))}
+                  </Select>
+                </FormControl>
+                {(stickyOn === 'header' || stickyOn === 'cookie') && (
+                  <TextField
+                    size="small"
+                    label={stickyOn === 'cookie' ? 'Cookie Name' : 'Header Name'}
+                    value={stickyKey}
+                    onChange={(e) => setStickyKey(e.target.value)}
+                    required
+                  />
+                )}
+              </Box>
+            </Box>
+          )}
           <FormControlLabel
             control={
               <Switch
@@ -176,6 +989,232 @@ export const RouteFormDialog = ({
             }
             label="Strip Path Prefix"
           />
+
+          {/* Rewrite */}
+          <Box>
+            <Typography variant="subtitle2" sx={{ mb: 1 }}>
+              Rewrite
+            </Typography>
+            <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap', alignItems: 'flex-start' }}>
+              <FormControl size="small" sx={{ minWidth: 160 }}>
+                <InputLabel>Path Rewrite</InputLabel>
+                <Select
+                  value={rewriteMode}
+                  label="Path Rewrite"
+                  onChange={(e) => setRewriteMode(e.target.value as RewriteMode)}
+                >
+                  <MenuItem value="none">None</MenuItem>
+                  <MenuItem value="prefix">Replace Prefix</MenuItem>
+                  <MenuItem value="regex">Regex</MenuItem>
+                  <MenuItem value="path">Path Template</MenuItem>
+                </Select>
+              </FormControl>
+              {rewriteMode !== 'none' && (
+                <TextField
+                  size="small"
+                  label={rewriteMode === 'regex' ? 'Regex' : rewriteMode === 'path' ? 'Template' : 'New Prefix'}
+                  value={rewriteValue}
+                  onChange={(e) => setRewriteValue(e.target.value)}
+                  placeholder={rewriteMode === 'regex' ? '^/v1/(.*)$' : rewriteMode === 'path' ? '/users/{id}/profile' : '/internal/users'}
+                  required
+                  error={stripPrefix}
+                  helperText={stripPrefix ? "Can't be combined with Strip Path Prefix" : undefined}
+                  sx={{ flex: 1 }}
+                />
+              )}
+              {rewriteMode === 'regex' && (
+                <TextField
+                  size="small"
+                  label="Replacement"
+                  value={rewriteReplacement}
+                  onChange={(e) => setRewriteReplacement(e.target.value)}
+                  placeholder="/v2/$1"
+                  sx={{ flex: 1 }}
+                />
+              )}
+              <TextField
+                size="small"
+                label="Upstream Host"
+                value={rewriteHost}
+                onChange={(e) => setRewriteHost(e.target.value)}
+                placeholder="keep client host"
+                sx={{ width: 220 }}
+              />
+            </Box>
+          </Box>
+          <FormControlLabel
+            control={
+              <Switch
+                checked={healthCheckEnabled}
+                onChange={(e) => setHealthCheckEnabled(e.target.checked)}
+              />
+            }
+            label="Active Health Check"
+          />
+          {healthCheckEnabled && (
+            <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap' }}>
+              <TextField
+                size="small"
+                label="Health Check Path"
+                value={hcPath}
+                onChange={(e) => setHcPath(e.target.value)}
+                placeholder="/health"
+                required
+              />
+              <TextField
+                size="small"
+                label="Interval (nanoseconds)"
+                value={hcInterval}
+                onChange={(e) => setHcInterval(e.target.value)}
+              />
+              <TextField
+                size="small"
+                label="Timeout (nanoseconds)"
+                value={hcTimeout}
+                onChange={(e) => setHcTimeout(e.target.value)}
+              />
+              <TextField
+                size="small"
+                type="number"
+                label="Healthy Threshold"
+                value={hcHealthyThreshold}
+                onChange={(e) => setHcHealthyThreshold(e.target.value)}
+                sx={{ width: 150 }}
+              />
+              <TextField
+                size="small"
+                type="number"
+                label="Unhealthy Threshold"
+                value={hcUnhealthyThreshold}
+                onChange={(e) => setHcUnhealthyThreshold(e.target.value)}
+                sx={{ width: 150 }}
+              />
+              <TextField
+                size="small"
+                type="number"
+                label="Expected Status"
+                value={hcExpectedStatus}
+                onChange={(e) => setHcExpectedStatus(e.target.value)}
+                placeholder="any 2xx"
+                sx={{ width: 150 }}
+              />
+            </Box>
+          )}
+          <FormControlLabel
+            control={
+              <Switch
+                checked={circuitBreakerEnabled}
+                onChange={(e) => setCircuitBreakerEnabled(e.target.checked)}
+              />
+            }
+            label="Circuit Breaker"
+          />
+          {circuitBreakerEnabled && (
+            <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap' }}>
+              <TextField
+                size="small"
+                type="number"
+                label="Consecutive Failures"
+                value={cbConsecutiveFailures}
+                onChange={(e) => setCbConsecutiveFailures(e.target.value)}
+                sx={{ width: 170 }}
+              />
+              <TextField
+                size="small"
+                type="number"
+                label="Error Rate (%)"
+                value={cbErrorRateThreshold}
+                onChange={(e) => setCbErrorRateThreshold(e.target.value)}
+                placeholder="disabled"
+                sx={{ width: 150 }}
+              />
+              <TextField
+                size="small"
+                label="Ejection Duration (nanoseconds)"
+                value={cbEjectionDuration}
+                onChange={(e) => setCbEjectionDuration(e.target.value)}
+              />
+            </Box>
+          )}
+          <FormControlLabel
+            control={
+              <Switch
+                checked={retryEnabled}
+                onChange={(e) => setRetryEnabled(e.target.checked)}
+              />
+            }
+            label="Retry Failed Requests"
+          />
+          {retryEnabled && (
+            <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap', alignItems: 'center' }}>
+              <TextField
+                size="small"
+                type="number"
+                label="Attempts"
+                value={retryAttempts}
+                onChange={(e) => setRetryAttempts(e.target.value)}
+                sx={{ width: 110 }}
+              />
+              <FormControl size="small" sx={{ minWidth: 260 }}>
+                <InputLabel>Retry On</InputLabel>
+                <Select
+                  multiple
+                  value={retryOn}
+                  onChange={(e) => setRetryOn(typeof e.target.value === 'string' ? e.target.value.split(',') : e.target.value)}
+                  input={<OutlinedInput label="Retry On" />}
+                  renderValue={(selected) => selected.join(', ')}
+                >
+                  {Array.from(new Set([...RETRY_ON_OPTIONS, ...retryOn])).map((condition) => (
+                    <MenuItem key={condition} value={condition}>
+                      {condition}
+                    </MenuItem>
+                  ))}
+                </Select>
+              </FormControl>
+              <TextField
+                size="small"
+                label="Per-Try Timeout (nanoseconds)"
+                value={retryPerTryTimeout}
+                onChange={(e) => setRetryPerTryTimeout(e.target.value)}
+                placeholder="none"
+              />
+              <FormControlLabel
+                control={<Switch checked={retryPost} onChange={(e) => setRetryPost(e.target.checked)} />}
+                label="Retry POST"
+              />
+            </Box>
+          )}
+          <FormControlLabel
+            control={
+              <Switch
+                checked={mirrorEnabled}
+                onChange={(e) => setMirrorEnabled(e.target.checked)}
+              />
+            }
+            label="Mirror Traffic"
+          />
+          {mirrorEnabled && (
+            <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap', alignItems: 'center' }}>
+              <TextField
+                size="small"
+                label="Mirror Target URL"
+                value={mirrorTargetUrl}
+                onChange={(e) => setMirrorTargetUrl(e.target.value)}
+                placeholder="http://users-shadow:8080"
+                required
+                sx={{ flex: 1, minWidth: 260 }}
+              />
+              <TextField
+                size="small"
+                type="number"
+                label="Percentage"
+                value={mirrorPercentage}
+                onChange={(e) => setMirrorPercentage(e.target.value)}
+                inputProps={{ min: 0, max: 100 }}
+                sx={{ width: 120 }}
+              />
+            </Box>
+          )}
           
           <Divider sx={{ my: 1 }} />
           
@@ -193,12 +1232,44 @@ export const RouteFormDialog = ({
             }
             label="Require Authentication"
           />
+          <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap', alignItems: 'flex-start' }}>
+            <FormControl size="small" sx={{ minWidth: 260 }}>
+              <InputLabel>Strategies</InputLabel>
+              <Select
+                multiple
+                value={authStrategies}
+                onChange={(e) => setAuthStrategies(typeof e.target.value === 'string' ? e.target.value.split(',') : e.target.value)}
+                input={<OutlinedInput label="Strategies" />}
+                renderValue={(selected) => selected.join(', ')}
+              >
+                {Array.from(new Set([...AUTH_STRATEGIES, ...authStrategies])).map((strategy) => (
+                  <MenuItem key={strategy} value={strategy}>
+                    {strategy}
+                  </MenuItem>
+                ))}
+              </Select>
+              <FormHelperText>Tried in order, the gateway default when empty</FormHelperText>
+            </FormControl>
+            {authStrategies.length > 1 && (
+              <FormControl size="small" sx={{ minWidth: 160 }}>
+                <InputLabel>Mode</InputLabel>
+                <Select value={authMode} label="Mode" onChange={(e) => setAuthMode(e.target.value)}>
+                  <MenuItem value="any">Any of</MenuItem>
+                  <MenuItem value="all">All of</MenuItem>
+                </Select>
+              </FormControl>
+            )}
+          </Box>
+          <Typography variant="subtitle2">
+            Requirements (authenticated clients without them get a 403)
+          </Typography>
+          <RequirementsFields value={authRequire} onChange={setAuthRequire} />
           
           {/* Authentication Exceptions */}
           <Box sx={{ ml: 2 }}>
             <Box sx={{ display: 'flex', justifyContent: 'space-between', alignItems: 'center', mb: 1 }}>
               <Typography variant="subtitle2">
-                Exceptions {authRequired ? '(paths that do NOT require auth)' : '(paths that DO require auth)'}
+                Exceptions {authRequired ? '(paths that do NOT require auth, unless they have requirements)' : '(paths that DO require auth)'}
               </Typography>
               <Button
                 variant="outlined"
@@ -269,12 +1340,17 @@ export const RouteFormDialog = ({
                       setShowAddException(false)
                       setNewExceptPath('')
                       setNewExceptMethods([])
+                      setNewExceptRequire(EMPTY_REQUIREMENTS)
                     }}
                     sx={{ mt: 0.5 }}
                   >
                     Cancel
                   </Button>
                 </Box>
+                <Box sx={{ mt: 1.5 }}>
+                  <RequirementsFields value={newExceptRequire} onChange={setNewExceptRequire} />
+                  <FormHelperText>Requirements in place of the route's, the paths then always require auth</FormHelperText>
+                </Box>
               </Paper>
             )}
             
@@ -311,6 +1387,7 @@ export const RouteFormDialog = ({
                         ) : (
                           <Chip label="ALL METHODS" size="small" variant="outlined" />
                         )}
+                        {exc.require && <Chip label="Has requirements" size="small" color="warning" variant="outlined" />}
                       </Box>
                     </Box>
                     <IconButton
@@ -325,6 +1402,146 @@ export const RouteFormDialog = ({
               </Box>
             )}
           </Box>
+
+          {/* Identity Propagation */}
+          <FormControlLabel
+            control={
+              <Switch
+                checked={identityEnabled}
+                onChange={(e) => setIdentityEnabled(e.target.checked)}
+              />
+            }
+            label="Identity Propagation"
+          />
+          {identityEnabled && (
+            <Box>
+              <Box sx={{ display: 'flex', justifyContent: 'space-between', alignItems: 'center', mb: 1 }}>
+                <Box sx={{ display: 'flex', gap: 2, flexWrap: 'wrap' }}>
+                  <FormControlLabel
+                    control={<Switch checked={omitDefaultHeaders} onChange={(e) => setOmitDefaultHeaders(e.target.checked)} />}
+                    label="Omit X-User-* headers"
+                  />
+                  <FormControlLabel
+                    control={<Switch checked={forwardToken} onChange={(e) => setForwardToken(e.target.checked)} />}
+                    label="Forward Authorization"
+                  />
+                  <FormControlLabel
+                    control={<Switch checked={internalToken} onChange={(e) => setInternalToken(e.target.checked)} />}
+                    label="Internal Token"
+                  />
+                </Box>
+                <Button
+                  variant="outlined"
+                  size="small"
+                  startIcon={<AddIcon />}
+                  onClick={() => setIdentityHeaders([...identityHeaders, { kind: 'claim', header: '', value: '' }])}
+                >
+                  Add Header
+                </Button>
+              </Box>
+              {identityHeaders.map((h, index) => (
+                <Box key={index} sx={{ display: 'flex', gap: 1, alignItems: 'center', mb: 1 }}>
+                  <TextField
+                    size="small"
+                    label="Header"
+                    value={h.header}
+                    onChange={(e) => updateIdentityHeader(index, { header: e.target.value })}
+                    placeholder="X-Tenant-Id"
+                    sx={{ flex: 1 }}
+                  />
+                  <Select
+                    size="small"
+                    value={h.kind}
+                    onChange={(e) => updateIdentityHeader(index, { kind: e.target.value as IdentityHeader['kind'] })}
+                    sx={{ width: 120 }}
+                  >
+                    <MenuItem value="claim">Claim</MenuItem>
+                    <MenuItem value="static">Static</MenuItem>
+                  </Select>
+                  <TextField
+                    size="small"
+                    label={h.kind === 'claim' ? 'Claim' : 'Value'}
+                    value={h.value}
+                    onChange={(e) => updateIdentityHeader(index, { value: e.target.value })}
+                    placeholder={h.kind === 'claim' ? 'org.tenant' : 'opengate'}
+                    sx={{ flex: 1 }}
+                  />
+                  <IconButton size="small" onClick={() => setIdentityHeaders(identityHeaders.filter((_, i) => i !== index))}>
+                    <DeleteIcon fontSize="small" />
+                  </IconButton>
+                </Box>
+              ))}
+            </Box>
+          )}
+
+          {/* Rate Limit */}
+          <FormControlLabel
+            control={
+              <Switch
+                checked={rateLimitEnabled}
+                onChange={(e) => setRateLimitEnabled(e.target.checked)}
+              />
+            }
+            label="Rate Limit"
+          />
+          {rateLimitEnabled && (
+            <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap', alignItems: 'center' }}>
+              <FormControl size="small" sx={{ minWidth: 170 }}>
+                <InputLabel>Algorithm</InputLabel>
+                <Select value={rlAlgorithm} label="Algorithm" onChange={(e) => setRlAlgorithm(e.target.value)}>
+                  <MenuItem value="token_bucket">Token Bucket</MenuItem>
+                  <MenuItem value="sliding_window">Sliding Window</MenuItem>
+                </Select>
+              </FormControl>
+              <TextField
+                size="small"
+                type="number"
+                label="Requests"
+                value={rlRequests}
+                onChange={(e) => setRlRequests(e.target.value)}
+                required
+                inputProps={{ min: 1 }}
+                sx={{ width: 120 }}
+              />
+              <TextField
+                size="small"
+                label="Window (nanoseconds)"
+                value={rlWindow}
+                onChange={(e) => setRlWindow(e.target.value)}
+              />
+              {rlAlgorithm === 'token_bucket' && (
+                <TextField
+                  size="small"
+                  type="number"
+                  label="Burst"
+                  value={rlBurst}
+                  onChange={(e) => setRlBurst(e.target.value)}
+                  placeholder="requests"
+                  sx={{ width: 120 }}
+                />
+              )}
+              <FormControl size="small" sx={{ minWidth: 140 }}>
+                <InputLabel>Key</InputLabel>
+                <Select value={rlKey} label="Key" onChange={(e) => setRlKey(e.target.value)}>
+                  {RATE_LIMIT_KEYS.map((k) => (
+                    <MenuItem key={k.value} value={k.value}>
+                      {k.label}
+                    </MenuItem>
+                  ))}
+                </Select>
+              </FormControl>
+              {rlKey === 'header' && (
+                <TextField
+                  size="small"
+                  label="Header"
+                  value={rlHeader}
+                  onChange={(e) => setRlHeader(e.target.value)}
+                  placeholder="X-Tenant-Id"
+                  required
+                />
+              )}
+            </Box>
+          )}
           
           <Divider sx={{ my: 1 }} />
           
@@ -343,13 +1560,20 @@ export const RouteFormDialog = ({
               Middleware
             </Typography>
             <Box sx={{ display: 'flex', gap: 1, mb: 1 }}>
-              <TextField
-                size="small"
-                value={newMiddleware}
-                onChange={(e) => setNewMiddleware(e.target.value)}
-                placeholder="Add middleware..."
-                onKeyPress={(e) => e.key === 'Enter' && handleAddMiddleware()}
-              />
+              <FormControl size="small" sx={{ minWidth: 200 }}>
+                <InputLabel>Add middleware</InputLabel>
+                <Select
+                  value={newMiddleware}
+                  label="Add middleware"
+                  onChange={(e) => setNewMiddleware(e.target.value)}
+                >
+                  {MIDDLEWARES.filter((m) => !middleware.includes(m)).map((m) => (
+                    <MenuItem key={m} value={m}>
+                      {m}
+                    </MenuItem>
+                  ))}
+                </Select>
+              </FormControl>
               <IconButton onClick={handleAddMiddleware} size="small">
                 <AddIcon />
               </IconButton>
@@ -365,6 +1589,20 @@ export const RouteFormDialog = ({
                 />
               ))}
             </Box>
+            {middleware.length > 0 && (
+              <TextField
+                label="Middleware Config (JSON)"
+                value={middlewareConfig}
+                onChange={(e) => setMiddlewareConfig(e.target.value)}
+                placeholder={'{ "headers": { "request": { "set": { "X-Gateway": "opengate" } } } }'}
+                error={!middlewareConfigValid}
+                helperText={middlewareConfigValid ? 'Optional config of each middleware by name' : 'Must be a JSON object keyed by middleware name'}
+                multiline
+                minRows={3}
+                fullWidth
+                sx={{ mt: 2 }}
+              />
+            )}
           </Box>
         </Box>
       </DialogContent>
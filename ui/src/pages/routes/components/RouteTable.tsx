@@ -110,7 +110,7 @@ export const RouteTable = ({
                 </TableCell>
                 <TableCell>
                   <Typography variant="body2" sx={{ fontFamily: 'monospace' }}>
-                    {config.targetUrl}
+                    {config.targets?.length > 0 ? `${config.targets.length} targets` : config.targetUrl}
                   </Typography>
                 </TableCell>
                 <TableCell>
@@ -0,0 +1,136 @@
+import { useState } from 'react'
+import {
+  Dialog,
+  DialogTitle,
+  DialogContent,
+  DialogActions,
+  Button,
+  TextField,
+  Box,
+  Typography,
+  Chip,
+  Paper,
+  Select,
+  MenuItem,
+  Alert,
+} from '@mui/material'
+import { useNotification } from '@gofreego/tsutils'
+import { configService } from '../../../services/configService'
+import type { KeyValue, TestRouteResponse } from '../../../apis/proto/opengate/v1/config'
+
+const HTTP_METHODS = ['GET', 'POST', 'PUT', 'PATCH', 'DELETE', 'OPTIONS', 'HEAD']
+
+interface RouteTestDialogProps {
+  open: boolean
+  onClose: () => void
+}
+
+// parseHeaders parses one "Name: value" header per line
+const parseHeaders = (text: string): KeyValue[] =>
+  text
+    .split('\n')
+    .map((line) => line.split(':'))
+    .filter((parts) => parts.length > 1 && parts[0].trim())
+    .map(([key, ...value]) => ({ key: key.trim(), value: value.join(':').trim() }))
+
+export const RouteTestDialog = ({ open, onClose }: RouteTestDialogProps) => {
+  const [method, setMethod] = useState('GET')
+  const [host, setHost] = useState('')
+  const [path, setPath] = useState('/')
+  const [headers, setHeaders] = useState('')
+  const [result, setResult] = useState<TestRouteResponse | null>(null)
+  const [testing, setTesting] = useState(false)
+  const { showNotification } = useNotification()
+
+  const handleTest = async () => {
+    setTesting(true)
+    try {
+      setResult(await configService.testRoute({ method, host: host.trim(), path: path.trim(), headers: parseHeaders(headers) }))
+    } catch (err) {
+      setResult(null)
+      showNotification('Failed to test route', 'error')
+    } finally {
+      setTesting(false)
+    }
+  }
+
+  return (
+    <Dialog open={open} onClose={onClose} maxWidth="md" fullWidth>
+      <DialogTitle>Test Route</DialogTitle>
+      <DialogContent>
+        <Box sx={{ display: 'flex', flexDirection: 'column', gap: 2, mt: 1 }}>
+          <Box sx={{ display: 'flex', gap: 1 }}>
+            <Select size="small" value={method} onChange={(e) => setMethod(e.target.value)} sx={{ width: 120 }}>
+              {HTTP_METHODS.map((m) => (
+                <MenuItem key={m} value={m}>
+                  {m}
+                </MenuItem>
+              ))}
+            </Select>
+            <TextField
+              size="small"
+              label="Host"
+              value={host}
+              onChange={(e) => setHost(e.target.value)}
+              placeholder="api.example.com"
+              sx={{ width: 220 }}
+            />
+            <TextField
+              size="small"
+              label="Path"
+              value={path}
+              onChange={(e) => setPath(e.target.value)}
+              placeholder="/api/users/42?verbose=true"
+              required
+              sx={{ flex: 1 }}
+              onKeyPress={(e) => e.key === 'Enter' && path.startsWith('/') && handleTest()}
+            />
+          </Box>
+          <TextField
+            size="small"
+            label="Headers"
+            value={headers}
+            onChange={(e) => setHeaders(e.target.value)}
+            placeholder="X-Api-Version: 2"
+            helperText="One Name: value per line"
+            multiline
+            minRows={2}
+          />
+
+          {result && !result.matched && <Alert severity="warning">{result.message}</Alert>}
+          {result?.matched && (
+            <Paper variant="outlined" sx={{ p: 2, display: 'flex', flexDirection: 'column', gap: 1 }}>
+              <Typography variant="body2">
+                Route: <strong>{result.route}</strong> (matched <code>{result.matchedPrefix || '/'}</code>)
+              </Typography>
+              {result.pathParams.length > 0 && (
+                <Box sx={{ display: 'flex', gap: 1, flexWrap: 'wrap' }}>
+                  {result.pathParams.map((param) => (
+                    <Chip key={param.key} label={`${param.key} = ${param.value}`} size="small" variant="outlined" />
+                  ))}
+                </Box>
+              )}
+              <Typography variant="body2" sx={{ fontFamily: 'monospace' }}>
+                Upstream path: {result.upstreamPath || '/'}
+              </Typography>
+              <Typography variant="body2" sx={{ fontFamily: 'monospace' }}>
+                Upstream host: {result.upstreamHost || host || '(client host)'}
+              </Typography>
+              {result.targets.length > 0 && (
+                <Typography variant="body2" sx={{ fontFamily: 'monospace' }}>
+                  Targets: {result.targets.join(', ')}
+                </Typography>
+              )}
+            </Paper>
+          )}
+        </Box>
+      </DialogContent>
+      <DialogActions>
+        <Button onClick={onClose}>Close</Button>
+        <Button variant="contained" onClick={handleTest} disabled={testing || !path.startsWith('/')}>
+          {testing ? 'Testing...' : 'Test'}
+        </Button>
+      </DialogActions>
+    </Dialog>
+  )
+}
@@ -1,3 +1,4 @@
+import { useState, useEffect } from 'react'
 import {
   Dialog,
   DialogTitle,
@@ -9,14 +10,97 @@ import {
   Chip,
   Divider,
   Paper,
+  TextField,
 } from '@mui/material'
-import type { Config } from '../../../apis/proto/opengate/v1/config'
+import type { Config, Requirements, TrafficSplit, VersionWeight } from '../../../apis/proto/opengate/v1/config'
 
 interface ConfigViewDialogProps {
   open: boolean
   onClose: () => void
   config: Config | null
   onEdit: () => void
+  onSetWeights: (weights: VersionWeight[]) => Promise<unknown> | void
+}
+
+// SplitWeights shows the versions of a split route and shifts the traffic between them
+const SplitWeights = ({
+  split,
+  onSetWeights,
+}: {
+  split: TrafficSplit
+  onSetWeights: ConfigViewDialogProps['onSetWeights']
+}) => {
+  const [weights, setWeights] = useState<string[]>([])
+  const [saving, setSaving] = useState(false)
+
+  useEffect(() => {
+    setWeights(split.versions.map((v) => String(v.weight || 0)))
+  }, [split])
+
+  const total = weights.reduce((sum, w) => sum + (parseInt(w, 10) || 0), 0)
+  const changed = split.versions.some((v, i) => String(v.weight || 0) !== weights[i])
+
+  const handleApply = async () => {
+    setSaving(true)
+    try {
+      await onSetWeights(split.versions.map((v, i) => ({ version: v.name, weight: parseInt(weights[i], 10) || 0 })))
+    } finally {
+      setSaving(false)
+    }
+  }
+
+  return (
+    <Box>
+      <Typography variant="caption" color="text.secondary">
+        Traffic Split{split.stickyOn && ` (sticky on ${split.stickyOn}${split.stickyKey ? ` ${split.stickyKey}` : ''})`}
+      </Typography>
+      <Box sx={{ display: 'flex', flexDirection: 'column', gap: 1, mt: 0.5 }}>
+        {split.versions.map((version, index) => (
+          <Paper key={version.name} variant="outlined" sx={{ p: 1, display: 'flex', alignItems: 'center', gap: 1 }}>
+            <Box sx={{ flex: 1 }}>
+              <Typography variant="body2" fontWeight={500}>
+                {version.name}
+              </Typography>
+              <Typography variant="body2" color="text.secondary" sx={{ fontFamily: 'monospace' }}>
+                {version.targets.map((t) => t.url).join(', ')}
+              </Typography>
+            </Box>
+            <Chip
+              label={total > 0 ? `${Math.round(((parseInt(weights[index], 10) || 0) * 100) / total)}%` : '0%'}
+              size="small"
+              color="primary"
+              variant="outlined"
+            />
+            <TextField
+              size="small"
+              type="number"
+              label="Weight"
+              value={weights[index] ?? ''}
+              onChange={(e) => setWeights(weights.map((w, i) => (i === index ? e.target.value : w)))}
+              sx={{ width: 100 }}
+            />
+          </Paper>
+        ))}
+        <Box>
+          <Button variant="contained" size="small" onClick={handleApply} disabled={!changed || total <= 0 || saving}>
+            {saving ? 'Applying...' : 'Apply Weights'}
+          </Button>
+        </Box>
+      </Box>
+    </Box>
+  )
+}
+
+// formatRequirements summarizes the permissions, profiles and claims a client must have
+const formatRequirements = (require?: Requirements): string => {
+  if (!require) return ''
+  return [
+    require.permissions?.length ? `permissions ${require.permissions.join(', ')}` : '',
+    require.profiles?.length ? `one of profiles ${require.profiles.join(', ')}` : '',
+    ...(require.claims || []).map((c) => `${c.name} ${c.operator || 'equals'} ${c.values.join(', ')}`.trim()),
+  ]
+    .filter(Boolean)
+    .join('; ')
 }
 
 const formatTimestamp = (timestamp: string | undefined): string => {
@@ -37,6 +121,7 @@ export const RouteViewDialog = ({
   onClose,
   config,
   onEdit,
+  onSetWeights,
 }: ConfigViewDialogProps) => {
   if (!config) return null
 
@@ -72,14 +157,78 @@ export const RouteViewDialog = ({
             </Typography>
           </Box>
 
-          <Box>
-            <Typography variant="caption" color="text.secondary">
-              Target URL
-            </Typography>
-            <Typography variant="body1" sx={{ fontFamily: 'monospace' }}>
-              {config.targetUrl}
-            </Typography>
-          </Box>
+          {config.hosts?.length > 0 && (
+            <Box>
+              <Typography variant="caption" color="text.secondary">
+                Hosts
+              </Typography>
+              <Box sx={{ display: 'flex', gap: 0.5, flexWrap: 'wrap' }}>
+                {config.hosts?.map((host) => (
+                  <Chip key={host} label={host} size="small" variant="outlined" />
+                ))}
+              </Box>
+            </Box>
+          )}
+
+          {!config.split && (
+            <Box>
+              <Typography variant="caption" color="text.secondary">
+                {config.targets?.length > 0 ? 'Upstream Targets' : 'Target URL'}
+              </Typography>
+              {config.targets?.length > 0 ? (
+                <Box sx={{ display: 'flex', flexDirection: 'column', gap: 0.5 }}>
+                  {config.targets?.map((target) => (
+                    <Box key={target.url} sx={{ display: 'flex', alignItems: 'center', gap: 1 }}>
+                      <Typography variant="body1" sx={{ fontFamily: 'monospace' }}>
+                        {target.url}
+                      </Typography>
+                      <Chip label={`weight ${target.weight || 1}`} size="small" variant="outlined" />
+                    </Box>
+                  ))}
+                  <Typography variant="body2" color="text.secondary">
+                    Load balancing: {config.loadBalancer?.policy || 'round_robin'}
+                    {config.loadBalancer?.policy === 'consistent_hash' &&
+                      ` on ${config.loadBalancer.hashOn}${config.loadBalancer.hashKey ? ` (${config.loadBalancer.hashKey})` : ''}`}
+                  </Typography>
+                </Box>
+              ) : (
+                <Typography variant="body1" sx={{ fontFamily: 'monospace' }}>
+                  {config.targetUrl}
+                </Typography>
+              )}
+            </Box>
+          )}
+
+          {config.split && <SplitWeights split={config.split} onSetWeights={onSetWeights} />}
+
+          {config.mirror && (
+            <Box>
+              <Typography variant="caption" color="text.secondary">
+                Mirror
+              </Typography>
+              <Typography variant="body1" sx={{ fontFamily: 'monospace' }}>
+                {config.mirror.targetUrl} ({config.mirror.percentage || 100}% of requests)
+              </Typography>
+            </Box>
+          )}
+
+          {config.rewrite && (
+            <Box>
+              <Typography variant="caption" color="text.secondary">
+                Rewrite
+              </Typography>
+              <Typography variant="body1" sx={{ fontFamily: 'monospace' }}>
+                {config.rewrite.regex
+                  ? `${config.rewrite.regex} -> ${config.rewrite.replacement}`
+                  : config.rewrite.path || (config.rewrite.prefix && `prefix -> ${config.rewrite.prefix}`)}
+              </Typography>
+              {config.rewrite.host && (
+                <Typography variant="body2" color="text.secondary">
+                  Upstream host: {config.rewrite.host}
+                </Typography>
+              )}
+            </Box>
+          )}
 
           <Box sx={{ display: 'flex', gap: 2 }}>
             <Box>
@@ -105,6 +254,17 @@ export const RouteViewDialog = ({
                   size="small"
                   color={config.authentication?.required ? 'warning' : 'default'}
                 />
+                {config.authentication?.strategies && config.authentication.strategies.length > 0 && (
+                  <Typography variant="body2" component="span" sx={{ ml: 1 }}>
+                    {config.authentication.strategies.length > 1 && `${config.authentication.mode === 'all' ? 'all' : 'any'} of `}
+                    {config.authentication.strategies.join(', ')}
+                  </Typography>
+                )}
+                {config.authentication?.require && (
+                  <Typography variant="body2" color="text.secondary" sx={{ mt: 0.5 }}>
+                    Requires {formatRequirements(config.authentication.require)}
+                  </Typography>
+                )}
               </Box>
             </Box>
 
@@ -149,12 +309,61 @@ export const RouteViewDialog = ({
                         <Chip label="ALL METHODS" size="small" variant="outlined" />
                       )}
                     </Box>
+                    {exc.require && (
+                      <Typography variant="body2" color="text.secondary" sx={{ mt: 0.5 }}>
+                        Auth required, requires {formatRequirements(exc.require)}
+                      </Typography>
+                    )}
                   </Paper>
                 ))}
               </Box>
             </Box>
           )}
 
+          {config.rateLimit && (
+            <Box>
+              <Typography variant="caption" color="text.secondary">
+                Rate Limit
+              </Typography>
+              <Typography variant="body1" sx={{ fontFamily: 'monospace' }}>
+                {config.rateLimit.requests} requests per {formatTimeout(config.rateLimit.window === '0' ? '1000000000' : config.rateLimit.window)} by{' '}
+                {config.rateLimit.key === 'header' ? config.rateLimit.header : (config.rateLimit.key || 'client_ip')}
+              </Typography>
+              <Typography variant="body2" color="text.secondary">
+                {config.rateLimit.algorithm === 'sliding_window' ? 'Sliding window' : `Token bucket, burst ${config.rateLimit.burst !== '0' ? config.rateLimit.burst : config.rateLimit.requests}`}
+              </Typography>
+            </Box>
+          )}
+
+          {config.identity && (
+            <Box>
+              <Typography variant="caption" color="text.secondary">
+                Identity Propagation
+              </Typography>
+              <Box sx={{ display: 'flex', flexDirection: 'column', gap: 0.5, mt: 0.5 }}>
+                {config.identity.claimHeaders.map((h) => (
+                  <Typography key={h.header} variant="body2" sx={{ fontFamily: 'monospace' }}>
+                    {h.header}: claim {h.claim}
+                  </Typography>
+                ))}
+                {config.identity.staticHeaders.map((h) => (
+                  <Typography key={h.key} variant="body2" sx={{ fontFamily: 'monospace' }}>
+                    {h.key}: {h.value}
+                  </Typography>
+                ))}
+                <Typography variant="body2" color="text.secondary">
+                  {config.identity.omitDefaultHeaders ? 'Without' : 'With'} the X-User-* headers,{' '}
+                  {config.identity.forwardToken ? 'forwarding' : 'stripping'} the Authorization header
+                </Typography>
+                {config.identity.internalToken && (
+                  <Typography variant="body2" color="text.secondary">
+                    With an internal token minted by the gateway
+                  </Typography>
+                )}
+              </Box>
+            </Box>
+          )}
+
           {config.middleware && config.middleware.length > 0 && (
             <Box>
               <Typography variant="caption" color="text.secondary">
@@ -1,3 +1,4 @@
 export { RouteTable } from './RouteTable'
 export { RouteFormDialog } from './RouteFormDialog'
 export { RouteViewDialog } from './RouteViewDialog'
+export { RouteTestDialog } from './RouteTestDialog'
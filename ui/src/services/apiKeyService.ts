@@ -0,0 +1,32 @@
+import { httpClient } from '../utils/httpClient'
+import type {
+  CreateAPIKeyRequest,
+  CreateAPIKeyResponse,
+  ListAPIKeysResponse,
+  RevokeAPIKeyResponse,
+  RotateAPIKeyResponse,
+} from '../apis/proto/opengate/v1/api_keys'
+
+const BASE_URL = '/opengate/v1'
+
+export const apiKeyService = {
+  async list(): Promise<ListAPIKeysResponse> {
+    const response = await httpClient.get<ListAPIKeysResponse>(`${BASE_URL}/api-keys`)
+    return response.data
+  },
+
+  async create(data: CreateAPIKeyRequest): Promise<CreateAPIKeyResponse> {
+    const response = await httpClient.post<CreateAPIKeyResponse>(`${BASE_URL}/api-keys`, data)
+    return response.data
+  },
+
+  async rotate(id: string): Promise<RotateAPIKeyResponse> {
+    const response = await httpClient.post<RotateAPIKeyResponse>(`${BASE_URL}/api-keys/${id}/rotate`, {})
+    return response.data
+  },
+
+  async revoke(id: string): Promise<RevokeAPIKeyResponse> {
+    const response = await httpClient.post<RevokeAPIKeyResponse>(`${BASE_URL}/api-keys/${id}/revoke`, {})
+    return response.data
+  },
+}
@@ -11,6 +11,10 @@ import type {
   DeleteConfigResponse,
   GetRoutesResponse,
   GetStatsResponse,
+  SetRouteWeightsResponse,
+  TestRouteRequest,
+  TestRouteResponse,
+  VersionWeight,
 } from '../apis/proto/opengate/v1/config'
 
 const BASE_URL = '/opengate/v1'
@@ -44,6 +48,11 @@ export const configService = {
     return response.data
   },
 
+  async setWeights(id: string, weights: VersionWeight[]): Promise<SetRouteWeightsResponse> {
+    const response = await httpClient.put<SetRouteWeightsResponse>(`${BASE_URL}/configs/${id}/weights`, { weights })
+    return response.data
+  },
+
   async delete(id: string): Promise<DeleteConfigResponse> {
     const response = await httpClient.delete<DeleteConfigResponse>(`${BASE_URL}/configs/${id}`)
     return response.data
@@ -58,16 +67,35 @@ export const configService = {
     const response = await httpClient.get<GetStatsResponse>(`${BASE_URL}/stats`)
     return response.data
   },
+
+  async testRoute(data: TestRouteRequest): Promise<TestRouteResponse> {
+    const response = await httpClient.post<TestRouteResponse>(`${BASE_URL}/routes/test`, data)
+    return response.data
+  },
 }
 
 // Helper to convert form data to API request
 export const toCreateConfigRequest = (data: Partial<Config>): CreateConfigRequest => ({
   name: data.name || '',
   pathPrefix: data.pathPrefix || '',
+  hosts: data.hosts || [],
+  match: data.match,
+  priority: data.priority || 0,
   targetUrl: data.targetUrl || '',
+  targets: data.targets || [],
+  split: data.split,
+  loadBalancer: data.loadBalancer,
+  healthCheck: data.healthCheck,
+  circuitBreaker: data.circuitBreaker,
+  retryPolicy: data.retryPolicy,
+  mirror: data.mirror,
   stripPrefix: data.stripPrefix || false,
+  rewrite: data.rewrite,
   authentication: data.authentication,
+  identity: data.identity,
+  rateLimit: data.rateLimit,
   middleware: data.middleware || [],
+  middlewareConfig: data.middlewareConfig,
   timeout: data.timeout || '30000000000',
 })
 
@@ -75,9 +103,23 @@ export const toUpdateConfigRequest = (data: Config): UpdateConfigRequest => ({
   id: data.id,
   name: data.name,
   pathPrefix: data.pathPrefix,
+  hosts: data.hosts || [],
+  match: data.match,
+  priority: data.priority || 0,
   targetUrl: data.targetUrl,
+  targets: data.targets || [],
+  split: data.split,
+  loadBalancer: data.loadBalancer,
+  healthCheck: data.healthCheck,
+  circuitBreaker: data.circuitBreaker,
+  retryPolicy: data.retryPolicy,
+  mirror: data.mirror,
   stripPrefix: data.stripPrefix,
+  rewrite: data.rewrite,
   authentication: data.authentication,
+  identity: data.identity,
+  rateLimit: data.rateLimit,
   middleware: data.middleware || [],
+  middlewareConfig: data.middlewareConfig,
   timeout: data.timeout || '30000000000',
 })
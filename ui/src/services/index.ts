@@ -4,5 +4,6 @@ import { AuthService, SessionManager } from '@gofreego/tsutils'
 export const sessionManager = SessionManager.getInstance(httpClient)
 export const authService = AuthService.getInstance(httpClient)
 export { configService, toCreateConfigRequest, toUpdateConfigRequest } from './configService'
+export { apiKeyService } from './apiKeyService'
 export { settingsService } from './settingsService'
 export type { CORSConfig } from './settingsService'